@@ -0,0 +1,28 @@
+package group
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker records the tally totals of the submitted proposals for the tally-not-decreasing invariant. Errors are
+// logged and do not halt the chain.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	if err := k.RecordTallyTotals(ctx); err != nil {
+		ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName)).Error("recording tally totals failed", "cause", err)
+	}
+}
+
+// EndBlocker runs the final tally for proposals with an expired voting period and executes the accepted ones of
+// group accounts with auto exec enabled. Finalized proposals are pruned with their votes after the retention period.
+// Errors are logged and do not halt the chain. Unprocessed proposals are handled in the next blocks.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+	if err := k.ProcessExpiredProposals(ctx); err != nil {
+		logger.Error("processing expired proposals failed", "cause", err)
+	}
+	if err := k.PruneProposals(ctx); err != nil {
+		logger.Error("pruning proposals failed", "cause", err)
+	}
+}
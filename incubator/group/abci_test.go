@@ -0,0 +1,466 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/group/testdata"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndBlocker(t *testing.T) {
+	amino := codec.New()
+	pKey, pTKey := sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)
+	paramSpace := subspace.NewSubspace(amino, pKey, pTKey, group.DefaultParamspace)
+
+	router := baseapp.NewRouter()
+	groupKey := sdk.NewKVStoreKey(group.StoreKeyName)
+	k := group.NewGroupKeeper(groupKey, paramSpace, router, &testdata.MyAppProposal{})
+	testdataKey := sdk.NewKVStoreKey(testdata.ModuleName)
+	testdataKeeper := testdata.NewKeeper(testdataKey, k)
+	router.AddRoute(testdata.ModuleName, testdata.NewHandler(testdataKeeper))
+
+	blockTime := time.Now().UTC()
+	parentCtx := group.NewContext(pKey, pTKey, groupKey, testdataKey).WithBlockTime(blockTime)
+	defaultParams := group.DefaultParams()
+	paramSpace.SetParamSet(parentCtx, &defaultParams)
+
+	members := []group.Member{
+		{Address: []byte("valid-member-address"), Power: sdk.OneDec()},
+	}
+	myGroupID, err := k.CreateGroup(parentCtx, []byte("valid--admin-address"), members, "test")
+	require.NoError(t, err)
+
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(parentCtx, []byte("valid--admin-address"), myGroupID, &policy, "test")
+	require.NoError(t, err)
+	autoExecAccountAddr, err := k.CreateGroupAccount(parentCtx, []byte("valid--admin-address"), myGroupID, &policy, "test", group.WithAutoExec(true))
+	require.NoError(t, err)
+
+	minExecPolicy := group.ThresholdDecisionPolicy{
+		Threshold:          sdk.OneDec(),
+		Timout:             types.Duration{Seconds: 2},
+		MinExecutionPeriod: types.Duration{Seconds: 1},
+	}
+	minExecAccountAddr, err := k.CreateGroupAccount(parentCtx, []byte("valid--admin-address"), myGroupID, &minExecPolicy, "test")
+	require.NoError(t, err)
+
+	member := []sdk.AccAddress{[]byte("valid-member-address")}
+	afterTimeout := blockTime.Add(time.Second)
+
+	specs := map[string]struct {
+		srcBlockTime      time.Time
+		srcParams         *group.Params
+		setupProposal     func(t *testing.T, ctx sdk.Context) group.ProposalID
+		expProposalStatus group.ProposalBase_Status
+		expProposalResult group.ProposalBase_Result
+		expExecutorResult group.ProposalBase_ExecutorResult
+		expFailedMsgIndex int32
+		expPayloadCounter uint64
+	}{
+		"open proposal not touched before timeout": {
+			srcBlockTime: blockTime.Add(time.Second - time.Nanosecond),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expProposalResult: group.ProposalResultUndefined,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"open proposal rejected on timeout": {
+			srcBlockTime: afterTimeout,
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultRejected,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"open proposal aborted on timeout when group modified": {
+			srcBlockTime: afterTimeout,
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				g, err := k.GetGroup(ctx, myGroupID)
+				require.NoError(t, err)
+				require.NoError(t, k.UpdateGroupMembership(ctx, &g))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusAborted,
+			expProposalResult: group.ProposalResultUndefined,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"accepted proposal within min execution period accepted on timeout": {
+			srcBlockTime: blockTime.Add(2 * time.Second),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, minExecAccountAddr, "test", member, nil)
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"accepted proposal not executed without auto exec": {
+			srcBlockTime: afterTimeout,
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, []sdk.Msg{
+					&testdata.MsgIncCounter{},
+				})
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"accepted proposal executed with auto exec": {
+			srcBlockTime: afterTimeout,
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, autoExecAccountAddr, "test", member, []sdk.Msg{
+					&testdata.MsgIncCounter{},
+				})
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expPayloadCounter: 1,
+		},
+		"accepted proposal not executed with auto exec before timeout": {
+			srcBlockTime: blockTime,
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, autoExecAccountAddr, "test", member, []sdk.Msg{
+					&testdata.MsgIncCounter{},
+				})
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"executed proposal not executed again with auto exec": {
+			srcBlockTime: afterTimeout,
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, autoExecAccountAddr, "test", member, []sdk.Msg{
+					&testdata.MsgIncCounter{},
+				})
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				require.NoError(t, k.ExecProposal(ctx, myProposalID))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultSuccess,
+			expPayloadCounter: 1,
+		},
+		"auto exec fails when payload fails": {
+			srcBlockTime: afterTimeout,
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, autoExecAccountAddr, "test", member, []sdk.Msg{
+					&testdata.MsgIncCounter{}, &testdata.MsgAlwaysFail{},
+				})
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultFailure,
+			expFailedMsgIndex: 1,
+		},
+		"auto exec fails when out of gas": {
+			srcBlockTime: afterTimeout,
+			srcParams: &group.Params{
+				MaxCommentLength:     defaultParams.MaxCommentLength,
+				MaxEndBlockProposals: defaultParams.MaxEndBlockProposals,
+				MaxAutoExecGas:       1,
+			},
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, autoExecAccountAddr, "test", member, []sdk.Msg{
+					&testdata.MsgIncCounter{},
+				})
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultFailure,
+			expFailedMsgIndex: -1,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.srcParams != nil {
+				paramSpace.SetParamSet(ctx, spec.srcParams)
+			}
+			proposalID := spec.setupProposal(t, ctx)
+
+			// when
+			ctx = ctx.WithBlockTime(spec.srcBlockTime)
+			group.EndBlocker(ctx, k)
+
+			// then proposal is updated
+			proposal, err := k.GetProposal(ctx, proposalID)
+			require.NoError(t, err)
+			exp := group.ProposalBase_Result_name[int32(spec.expProposalResult)]
+			got := group.ProposalBase_Result_name[int32(proposal.GetBase().Result)]
+			assert.Equal(t, exp, got)
+
+			exp = group.ProposalBase_Status_name[int32(spec.expProposalStatus)]
+			got = group.ProposalBase_Status_name[int32(proposal.GetBase().Status)]
+			assert.Equal(t, exp, got)
+
+			exp = group.ProposalBase_ExecutorResult_name[int32(spec.expExecutorResult)]
+			got = group.ProposalBase_ExecutorResult_name[int32(proposal.GetBase().ExecutorResult)]
+			assert.Equal(t, exp, got)
+
+			// and execution recorded
+			execution, err := k.GetProposalExecution(ctx, proposalID)
+			switch spec.expExecutorResult {
+			case group.ProposalExecutorResultNotRun:
+				assert.True(t, orm.ErrNotFound.Is(err))
+			case group.ProposalExecutorResultFailure:
+				require.NoError(t, err)
+				assert.Equal(t, spec.expExecutorResult, execution.ExecutorResult)
+				assert.Equal(t, spec.expFailedMsgIndex, execution.FailedMsgIndex)
+				assert.NotEmpty(t, execution.FailureReason)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, spec.expExecutorResult, execution.ExecutorResult)
+			}
+
+			// and proposal messages executed
+			assert.Equal(t, spec.expPayloadCounter, testdataKeeper.GetCounter(ctx), "counter")
+		})
+	}
+}
+
+func TestEndBlockerRecoversHandlerPanic(t *testing.T) {
+	amino := codec.New()
+	pKey, pTKey := sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)
+	paramSpace := subspace.NewSubspace(amino, pKey, pTKey, group.DefaultParamspace)
+
+	router := baseapp.NewRouter()
+	groupKey := sdk.NewKVStoreKey(group.StoreKeyName)
+	k := group.NewGroupKeeper(groupKey, paramSpace, router, &testdata.MyAppProposal{})
+	testdataKey := sdk.NewKVStoreKey(testdata.ModuleName)
+	testdataKeeper := testdata.NewKeeper(testdataKey, k)
+	router.AddRoute(testdata.ModuleName, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		testdataKeeper.IncCounter(ctx)
+		panic("testing")
+	})
+
+	blockTime := time.Now().UTC()
+	ctx := group.NewContext(pKey, pTKey, groupKey, testdataKey).WithBlockTime(blockTime)
+	defaultParams := group.DefaultParams()
+	paramSpace.SetParamSet(ctx, &defaultParams)
+
+	member := []sdk.AccAddress{[]byte("valid-member-address")}
+	members := []group.Member{{Address: member[0], Power: sdk.OneDec()}}
+	myGroupID, err := k.CreateGroup(ctx, []byte("valid--admin-address"), members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test", group.WithAutoExec(true))
+	require.NoError(t, err)
+	myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, []sdk.Msg{&testdata.MsgIncCounter{}})
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+
+	// when
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Second))
+	require.NotPanics(t, func() { group.EndBlocker(ctx, k) })
+
+	// then the execution failed without payload changes
+	proposal, err := k.GetProposal(ctx, myProposalID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalExecutorResultFailure, proposal.GetBase().ExecutorResult)
+	execution, err := k.GetProposalExecution(ctx, myProposalID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(-1), execution.FailedMsgIndex)
+	assert.Equal(t, "panic: testing", execution.FailureReason)
+	assert.Equal(t, uint64(0), testdataKeeper.GetCounter(ctx))
+}
+
+func TestEndBlockerMaxProposals(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+	members := []group.Member{{Address: []byte("valid-member-address"), Power: sdk.OneDec()}}
+	myGroupID, err := k.CreateGroup(ctx, []byte("valid--admin-address"), members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test")
+	require.NoError(t, err)
+
+	maxProposals := int(k.GetParams(ctx).MaxEndBlockProposals)
+	for i := 0; i < maxProposals+1; i++ {
+		_, err := k.CreateProposal(ctx, accountAddr, "test", []sdk.AccAddress{[]byte("valid-member-address")}, nil)
+		require.NoError(t, err)
+	}
+
+	// when
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	group.EndBlocker(ctx, k)
+
+	// then only the max number was processed
+	last := group.ProposalID(maxProposals + 1)
+	p, err := k.GetProposal(ctx, last-1)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusClosed, p.GetBase().Status)
+	p, err = k.GetProposal(ctx, last)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusSubmitted, p.GetBase().Status)
+
+	// and the remaining in the next block
+	group.EndBlocker(ctx, k)
+	p, err = k.GetProposal(ctx, last)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusClosed, p.GetBase().Status)
+}
+
+func TestEndBlockerPruneProposals(t *testing.T) {
+	k, pCtx, _ := createTestKeeper(time.Now().UTC())
+	member := []sdk.AccAddress{[]byte("valid-member-address")}
+	members := []group.Member{{Address: member[0], Power: sdk.OneDec()}}
+	myGroupID, err := k.CreateGroup(pCtx, []byte("valid--admin-address"), members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(pCtx, []byte("valid--admin-address"), myGroupID, &policy, "test")
+	require.NoError(t, err)
+	snapshotAccountAddr, err := k.CreateGroupAccount(pCtx, []byte("valid--admin-address"), myGroupID, &policy, "test", group.WithElectorateSnapshot(true))
+	require.NoError(t, err)
+
+	retention := k.GetParams(pCtx).ProposalRetention
+	afterTimeout := pCtx.BlockTime().Add(time.Second)
+
+	specs := map[string]struct {
+		srcBlockTime  time.Time
+		setupProposal func(t *testing.T, ctx sdk.Context) group.ProposalID
+		expPruned     bool
+	}{
+		"closed proposal pruned after retention": {
+			srcBlockTime: afterTimeout.Add(retention),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expPruned: true,
+		},
+		"closed proposal kept before retention ends": {
+			srcBlockTime: afterTimeout.Add(retention - time.Nanosecond),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+		},
+		"open proposal not pruned before timeout": {
+			srcBlockTime: afterTimeout.Add(-time.Nanosecond),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				return myProposalID
+			},
+		},
+		"expired open proposal closed and pruned after retention": {
+			srcBlockTime: afterTimeout.Add(retention),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				return myProposalID
+			},
+			expPruned: true,
+		},
+		"closed proposal with electorate snapshot pruned after retention": {
+			srcBlockTime: afterTimeout.Add(retention),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, snapshotAccountAddr, "test", member, nil)
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				return myProposalID
+			},
+			expPruned: true,
+		},
+		"executed proposal pruned with execution record after retention": {
+			srcBlockTime: afterTimeout.Add(retention),
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				require.NoError(t, k.ExecProposal(ctx, myProposalID))
+				return myProposalID
+			},
+			expPruned: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			proposalID := spec.setupProposal(t, ctx)
+
+			// when
+			ctx = ctx.WithBlockTime(spec.srcBlockTime).WithEventManager(sdk.NewEventManager())
+			group.EndBlocker(ctx, k)
+
+			// then
+			_, err := k.GetProposal(ctx, proposalID)
+			_, voteErr := k.GetVote(ctx, proposalID, member[0])
+			_, snapshotErr := k.GetElectorateSnapshot(ctx, proposalID)
+			snapshotMembers, _ := k.GetElectorateSnapshotMembers(ctx, proposalID)
+			_, executionErr := k.GetProposalExecution(ctx, proposalID)
+			var prunedEvents int
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type == group.EventTypeProposalPruned {
+					prunedEvents++
+				}
+			}
+			if !spec.expPruned {
+				require.NoError(t, err)
+				assert.Equal(t, 0, prunedEvents)
+				return
+			}
+			require.True(t, orm.ErrNotFound.Is(err), err)
+			require.True(t, orm.ErrNotFound.Is(voteErr), voteErr)
+			require.True(t, orm.ErrNotFound.Is(snapshotErr), snapshotErr)
+			assert.Empty(t, snapshotMembers)
+			require.True(t, orm.ErrNotFound.Is(executionErr), executionErr)
+			assert.Equal(t, 1, prunedEvents)
+		})
+	}
+}
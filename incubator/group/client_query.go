@@ -0,0 +1,155 @@
+package group
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the group module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "Querying commands for the group module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		groupQueryCmd(cdc, "group [group-id]", "Query a group by id", QueryGroup),
+		groupListQueryCmd(cdc, "group-members [group-id]", "Query the members of a group", QueryGroupMembers),
+		addressListQueryCmd(cdc, "groups-by-admin [admin]", "Query groups by admin", QueryGroupsByAdmin),
+		addressListQueryCmd(cdc, "groups-by-member [member]", "Query the group memberships of an address", QueryGroupsByMember),
+		addressQueryCmd(cdc, "group-account [group-account]", "Query a group account by address", QueryGroupAccount),
+		groupListQueryCmd(cdc, "group-accounts-by-group [group-id]", "Query the group accounts of a group", QueryGroupAccountsByGroup),
+		addressListQueryCmd(cdc, "group-accounts-by-admin [admin]", "Query group accounts by admin", QueryGroupAccountsByAdmin),
+		proposalQueryCmd(cdc, "proposal [proposal-id]", "Query a proposal by id", QueryProposal),
+		proposalQueryCmd(cdc, "proposal-execution [proposal-id]", "Query the result of the last proposal execution", QueryProposalExecution),
+		addressListQueryCmd(cdc, "proposals-by-group-account [group-account]", "Query the proposals of a group account", QueryProposalsByGroupAccount),
+		addressListQueryCmd(cdc, "proposals-by-proposer [proposer]", "Query proposals by proposer", QueryProposalsByProposer),
+		VoteQueryCmd(cdc),
+		proposalListQueryCmd(cdc, "votes-by-proposal [proposal-id]", "Query the votes of a proposal", QueryVotesByProposal),
+		addressListQueryCmd(cdc, "votes-by-voter [voter]", "Query votes by voter", QueryVotesByVoter),
+	)...)
+	return queryCmd
+}
+
+// VoteQueryCmd creates a cli command to query a single vote.
+func VoteQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return newQueryCmd(cdc, "vote [proposal-id] [voter]", "Query a vote by proposal id and voter", QueryVote, 2, false, voteParams)
+}
+
+func voteParams(args []string, _ QueryPagination) (interface{}, error) {
+	proposalID, err := parseProposalID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	voter, err := sdk.AccAddressFromBech32(args[1])
+	if err != nil {
+		return nil, errors.Wrap(err, "voter")
+	}
+	return QueryVoteParams{Proposal: proposalID, Voter: voter}, nil
+}
+
+func groupQueryCmd(cdc *codec.Codec, use, short, endpoint string) *cobra.Command {
+	return newQueryCmd(cdc, use, short, endpoint, 1, false, groupParams)
+}
+
+func groupListQueryCmd(cdc *codec.Codec, use, short, endpoint string) *cobra.Command {
+	return newQueryCmd(cdc, use, short, endpoint, 1, true, groupParams)
+}
+
+func groupParams(args []string, p QueryPagination) (interface{}, error) {
+	groupID, err := parseGroupID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return QueryGroupParams{Group: groupID, QueryPagination: p}, nil
+}
+
+func addressQueryCmd(cdc *codec.Codec, use, short, endpoint string) *cobra.Command {
+	return newQueryCmd(cdc, use, short, endpoint, 1, false, addressParams)
+}
+
+func addressListQueryCmd(cdc *codec.Codec, use, short, endpoint string) *cobra.Command {
+	return newQueryCmd(cdc, use, short, endpoint, 1, true, addressParams)
+}
+
+func addressParams(args []string, p QueryPagination) (interface{}, error) {
+	addr, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "address")
+	}
+	return QueryAddressParams{Address: addr, QueryPagination: p}, nil
+}
+
+func proposalQueryCmd(cdc *codec.Codec, use, short, endpoint string) *cobra.Command {
+	return newQueryCmd(cdc, use, short, endpoint, 1, false, proposalParams)
+}
+
+func proposalListQueryCmd(cdc *codec.Codec, use, short, endpoint string) *cobra.Command {
+	return newQueryCmd(cdc, use, short, endpoint, 1, true, proposalParams)
+}
+
+func proposalParams(args []string, p QueryPagination) (interface{}, error) {
+	proposalID, err := parseProposalID(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return QueryProposalParams{Proposal: proposalID, QueryPagination: p}, nil
+}
+
+// newQueryCmd creates a cli command with nArgs arguments that sends the params built from the args to the querier
+// endpoint and prints the json result. Paginated list queries support the page and limit flags.
+func newQueryCmd(cdc *codec.Codec, use, short, endpoint string, nArgs int, paginated bool, paramsFn func(args []string, p QueryPagination) (interface{}, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(nArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var p QueryPagination
+			if paginated {
+				var err error
+				if p.Page, err = cmd.Flags().GetInt(flags.FlagPage); err != nil {
+					return err
+				}
+				if p.Limit, err = cmd.Flags().GetInt(flags.FlagLimit); err != nil {
+					return err
+				}
+			}
+			params, err := paramsFn(args, p)
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := queryGroupModule(cliCtx, endpoint, params)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(res))
+			return err
+		},
+	}
+	if paginated {
+		cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+		cmd.Flags().Int(flags.FlagLimit, DefaultQueryLimit, "Query number of results returned per page")
+	}
+	return cmd
+}
+
+// queryGroupModule sends the json encoded params to the querier endpoint. The result and height of the query are
+// returned.
+func queryGroupModule(cliCtx context.CLIContext, endpoint string, params interface{}) ([]byte, int64, error) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "query params")
+	}
+	return cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, endpoint), bz)
+}
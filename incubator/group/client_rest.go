@@ -0,0 +1,386 @@
+package group
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/gorilla/mux"
+)
+
+// RegisterRESTRoutes registers the query and tx routes of the group module. Tx routes return an unsigned
+// transaction for the `base_req.from` address.
+func RegisterRESTRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// queries
+	r.HandleFunc("/group/groups/{group}", queryHandlerFn(cliCtx, QueryGroup, groupVarParams)).Methods("GET")
+	r.HandleFunc("/group/groups/{group}/members", queryHandlerFn(cliCtx, QueryGroupMembers, groupVarParams)).Methods("GET")
+	r.HandleFunc("/group/groups/{group}/accounts", queryHandlerFn(cliCtx, QueryGroupAccountsByGroup, groupVarParams)).Methods("GET")
+	r.HandleFunc("/group/admins/{address}/groups", queryHandlerFn(cliCtx, QueryGroupsByAdmin, addressVarParams)).Methods("GET")
+	r.HandleFunc("/group/admins/{address}/accounts", queryHandlerFn(cliCtx, QueryGroupAccountsByAdmin, addressVarParams)).Methods("GET")
+	r.HandleFunc("/group/members/{address}/groups", queryHandlerFn(cliCtx, QueryGroupsByMember, addressVarParams)).Methods("GET")
+	r.HandleFunc("/group/accounts/{address}", queryHandlerFn(cliCtx, QueryGroupAccount, addressVarParams)).Methods("GET")
+	r.HandleFunc("/group/accounts/{address}/proposals", queryHandlerFn(cliCtx, QueryProposalsByGroupAccount, addressVarParams)).Methods("GET")
+	r.HandleFunc("/group/proposers/{address}/proposals", queryHandlerFn(cliCtx, QueryProposalsByProposer, addressVarParams)).Methods("GET")
+	r.HandleFunc("/group/proposals/{proposal}", queryHandlerFn(cliCtx, QueryProposal, proposalVarParams)).Methods("GET")
+	r.HandleFunc("/group/proposals/{proposal}/execution", queryHandlerFn(cliCtx, QueryProposalExecution, proposalVarParams)).Methods("GET")
+	r.HandleFunc("/group/proposals/{proposal}/votes", queryHandlerFn(cliCtx, QueryVotesByProposal, proposalVarParams)).Methods("GET")
+	r.HandleFunc("/group/proposals/{proposal}/votes/{address}", queryHandlerFn(cliCtx, QueryVote, voteVarParams)).Methods("GET")
+	r.HandleFunc("/group/voters/{address}/votes", queryHandlerFn(cliCtx, QueryVotesByVoter, addressVarParams)).Methods("GET")
+
+	// txs
+	r.HandleFunc("/group/groups", createGroupHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/groups/{group}/members", updateGroupMembersHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/groups/{group}/admin", updateGroupAdminHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/groups/{group}/comment", updateGroupCommentHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/accounts", createGroupAccountHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/accounts/{address}/admin", updateGroupAccountAdminHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/accounts/{address}/decision_policy", updateGroupAccountDecisionPolicyHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/accounts/{address}/comment", updateGroupAccountCommentHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/proposals/{proposal}/votes", voteHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/proposals/{proposal}/exec", execHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/group/proposals/{proposal}/withdraw", withdrawProposalHandlerFn(cliCtx)).Methods("POST")
+}
+
+// queryHandlerFn returns a handler that sends the params built from the path variables and the `page` and `limit`
+// url params to the querier endpoint.
+func queryHandlerFn(cliCtx context.CLIContext, endpoint string, paramsFn func(vars map[string]string, p QueryPagination) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		p, err := parsePagination(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params, err := paramsFn(mux.Vars(r), p)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := queryGroupModule(cliCtx, endpoint, params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, json.RawMessage(res))
+	}
+}
+
+func parsePagination(r *http.Request) (QueryPagination, error) {
+	var p QueryPagination
+	for name, dest := range map[string]*int{"page": &p.Page, "limit": &p.Limit} {
+		s := r.URL.Query().Get(name)
+		if s == "" {
+			continue
+		}
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return p, fmt.Errorf("%s: %s", name, err)
+		}
+		*dest = v
+	}
+	return p, nil
+}
+
+func groupVarParams(vars map[string]string, p QueryPagination) (interface{}, error) {
+	return groupParams([]string{vars["group"]}, p)
+}
+
+func addressVarParams(vars map[string]string, p QueryPagination) (interface{}, error) {
+	return addressParams([]string{vars["address"]}, p)
+}
+
+func proposalVarParams(vars map[string]string, p QueryPagination) (interface{}, error) {
+	return proposalParams([]string{vars["proposal"]}, p)
+}
+
+func voteVarParams(vars map[string]string, _ QueryPagination) (interface{}, error) {
+	return voteParams([]string{vars["proposal"], vars["address"]}, QueryPagination{})
+}
+
+// BaseReq is the request body of the group tx routes that require no further fields.
+type BaseReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// CreateGroupReq is the request body to create a group.
+type CreateGroupReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Members Members      `json:"members" yaml:"members"`
+	Comment string       `json:"comment" yaml:"comment"`
+}
+
+// UpdateGroupMembersReq is the request body to update group members.
+type UpdateGroupMembersReq struct {
+	BaseReq       rest.BaseReq `json:"base_req" yaml:"base_req"`
+	MemberUpdates Members      `json:"member_updates" yaml:"member_updates"`
+}
+
+// UpdateAdminReq is the request body to set a new group or group account admin.
+type UpdateAdminReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	NewAdmin sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+// UpdateCommentReq is the request body to set a new group or group account comment.
+type UpdateCommentReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Comment string       `json:"comment" yaml:"comment"`
+}
+
+// CreateGroupAccountReq is the request body to create a group account. The decision policy is a StdDecisionPolicy
+// in protobuf json format.
+type CreateGroupAccountReq struct {
+	BaseReq            rest.BaseReq    `json:"base_req" yaml:"base_req"`
+	Group              GroupID         `json:"group" yaml:"group"`
+	Comment            string          `json:"comment" yaml:"comment"`
+	AutoExec           bool            `json:"auto_exec" yaml:"auto_exec"`
+	SnapshotElectorate bool            `json:"snapshot_electorate" yaml:"snapshot_electorate"`
+	AllowedMsgTypes    []MsgType       `json:"allowed_msg_types" yaml:"allowed_msg_types"`
+	DeniedMsgTypes     []MsgType       `json:"denied_msg_types" yaml:"denied_msg_types"`
+	DecisionPolicy     json.RawMessage `json:"decision_policy" yaml:"decision_policy"`
+}
+
+// UpdateDecisionPolicyReq is the request body to set a new group account decision policy. The decision policy is a
+// StdDecisionPolicy in protobuf json format.
+type UpdateDecisionPolicyReq struct {
+	BaseReq        rest.BaseReq    `json:"base_req" yaml:"base_req"`
+	DecisionPolicy json.RawMessage `json:"decision_policy" yaml:"decision_policy"`
+}
+
+// VoteReq is the request body to vote on a proposal. The voter is the `base_req.from` address.
+type VoteReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Choice  string       `json:"choice" yaml:"choice"`
+	Comment string       `json:"comment" yaml:"comment"`
+}
+
+func createGroupHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateGroupReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgCreateGroup{Admin: from, Members: req.Members, Comment: req.Comment})
+	}
+}
+
+func updateGroupMembersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupID, err := parseGroupID(mux.Vars(r)["group"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req UpdateGroupMembersReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgUpdateGroupMembers{Admin: from, Group: groupID, MemberUpdates: req.MemberUpdates})
+	}
+}
+
+func updateGroupAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupID, err := parseGroupID(mux.Vars(r)["group"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req UpdateAdminReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgUpdateGroupAdmin{Admin: from, Group: groupID, NewAdmin: req.NewAdmin})
+	}
+}
+
+func updateGroupCommentHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupID, err := parseGroupID(mux.Vars(r)["group"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req UpdateCommentReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgUpdateGroupComment{Admin: from, Group: groupID, Comment: req.Comment})
+	}
+}
+
+func createGroupAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateGroupAccountReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		policy, err := parseDecisionPolicy(req.DecisionPolicy)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgCreateGroupAccountStd{
+			Base: MsgCreateGroupAccountBase{
+				Admin:              from,
+				Group:              req.Group,
+				Comment:            req.Comment,
+				AutoExec:           req.AutoExec,
+				SnapshotElectorate: req.SnapshotElectorate,
+				AllowedMsgTypes:    req.AllowedMsgTypes,
+				DeniedMsgTypes:     req.DeniedMsgTypes,
+			},
+			DecisionPolicy: policy,
+		})
+	}
+}
+
+func updateGroupAccountAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupAccount, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req UpdateAdminReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgUpdateGroupAccountAdmin{Admin: from, GroupAccount: groupAccount, NewAdmin: req.NewAdmin})
+	}
+}
+
+func updateGroupAccountDecisionPolicyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupAccount, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req UpdateDecisionPolicyReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		policy, err := parseDecisionPolicy(req.DecisionPolicy)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgUpdateGroupAccountDecisionPolicyStd{
+			Base:           MsgUpdateGroupAccountBase{Admin: from, GroupAccount: groupAccount},
+			DecisionPolicy: policy,
+		})
+	}
+}
+
+func updateGroupAccountCommentHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		groupAccount, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req UpdateCommentReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgUpdateGroupAccountComment{Admin: from, GroupAccount: groupAccount, Comment: req.Comment})
+	}
+}
+
+func voteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proposalID, err := parseProposalID(mux.Vars(r)["proposal"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req VoteReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		choice, err := parseChoice(req.Choice)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgVote{Proposal: proposalID, Voters: []sdk.AccAddress{from}, Choice: choice, Comment: req.Comment})
+	}
+}
+
+func execHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proposalID, err := parseProposalID(mux.Vars(r)["proposal"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req BaseReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgExec{Proposal: proposalID, Signer: from})
+	}
+}
+
+func withdrawProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proposalID, err := parseProposalID(mux.Vars(r)["proposal"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var req BaseReq
+		from, ok := readTxReq(w, r, cliCtx, &req, &req.BaseReq)
+		if !ok {
+			return
+		}
+		writeGenerateTxResponse(w, cliCtx, req.BaseReq, MsgWithdrawProposal{Proposal: proposalID, Signer: from})
+	}
+}
+
+// readTxReq reads the request body into req and validates the base request. The `from` address is returned.
+// An error response is written when false is returned.
+func readTxReq(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, req interface{}, baseReq *rest.BaseReq) (sdk.AccAddress, bool) {
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, req) {
+		return nil, false
+	}
+	*baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return nil, false
+	}
+	from, err := sdk.AccAddressFromBech32(baseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return from, true
+}
+
+// writeGenerateTxResponse validates the message and writes the unsigned transaction.
+func writeGenerateTxResponse(w http.ResponseWriter, cliCtx context.CLIContext, baseReq rest.BaseReq, msg sdk.Msg) {
+	if err := msg.ValidateBasic(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	authclient.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+}
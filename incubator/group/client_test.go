@@ -0,0 +1,457 @@
+package group_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/group/testdata"
+	proto "github.com/gogo/protobuf/types"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+)
+
+// clientFixture is a committed chain state with a group, group account, proposal and vote created by myAddr.
+type clientFixture struct {
+	app          *testdata.SimApp
+	myKey        crypto.PrivKey
+	myAddr       sdk.AccAddress
+	accountAddr  sdk.AccAddress
+	myProposalID group.ProposalID
+	node         *httptest.Server
+}
+
+func setupClientFixture(t *testing.T) clientFixture {
+	app, ctx := createTestApp(false)
+	myKey, _, myAddr := types.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, myAddr))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, myAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10000))))
+
+	members := []group.Member{
+		{Address: myAddr, Power: sdk.OneDec()},
+		{Address: sdk.AccAddress("other-member-address"), Power: sdk.OneDec()},
+		{Address: sdk.AccAddress("third-member-address"), Power: sdk.OneDec()},
+	}
+	myGroupID, err := app.GroupKeeper.CreateGroup(ctx, myAddr, members, "client test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(2),
+		Timout:    proto.Duration{Seconds: 3600},
+	}
+	accountAddr, err := app.GroupKeeper.CreateGroupAccount(ctx, myAddr, myGroupID, &policy, "client test")
+	require.NoError(t, err)
+	myProposalID, err := app.TestdataKeeper.CreateProposal(ctx, accountAddr, []sdk.AccAddress{myAddr}, "client test", nil)
+	require.NoError(t, err)
+	require.NoError(t, app.GroupKeeper.Vote(ctx, myProposalID, []sdk.AccAddress{myAddr}, group.Choice_YES, "client test"))
+	app.EndBlock(abci.RequestEndBlock{Height: ctx.BlockHeight()})
+	app.Commit()
+
+	return clientFixture{
+		app:          app,
+		myKey:        myKey,
+		myAddr:       myAddr,
+		accountAddr:  accountAddr,
+		myProposalID: myProposalID,
+		node:         startFakeNode(app),
+	}
+}
+
+// startFakeNode starts a tendermint rpc server that answers abci queries with the committed state of the app.
+func startFakeNode(app *testdata.SimApp) *httptest.Server {
+	cdc := codec.New()
+	ctypes.RegisterAmino(cdc)
+	abciQuery := func(_ *rpctypes.Context, path string, data tmbytes.HexBytes, height int64, prove bool) (*ctypes.ResultABCIQuery, error) {
+		res := app.Query(abci.RequestQuery{Path: path, Data: data, Height: height, Prove: prove})
+		return &ctypes.ResultABCIQuery{Response: res}, nil
+	}
+	m := http.NewServeMux()
+	routes := map[string]*rpcserver.RPCFunc{"abci_query": rpcserver.NewRPCFunc(abciQuery, "path,data,height,prove")}
+	rpcserver.RegisterRPCFuncs(m, routes, cdc, log.NewNopLogger())
+	return httptest.NewServer(m)
+}
+
+func TestQueryCmds(t *testing.T) {
+	f := setupClientFixture(t)
+	defer f.node.Close()
+	defer viper.Reset()
+	viper.Set(flags.FlagNode, f.node.URL)
+	viper.Set(flags.FlagTrustNode, true)
+
+	myAddr, accountAddr, proposalID := f.myAddr.String(), f.accountAddr.String(), fmt.Sprint(f.myProposalID)
+	specs := map[string]struct {
+		srcArgs  []string
+		expCount int // number of list elements, -1 for a single object
+		expErr   bool
+	}{
+		"group":                      {srcArgs: []string{"group", "1"}, expCount: -1},
+		"group members":              {srcArgs: []string{"group-members", "1"}, expCount: 3},
+		"groups by admin":            {srcArgs: []string{"groups-by-admin", myAddr}, expCount: 1},
+		"groups by member":           {srcArgs: []string{"groups-by-member", myAddr}, expCount: 1},
+		"group account":              {srcArgs: []string{"group-account", accountAddr}, expCount: -1},
+		"group accounts by group":    {srcArgs: []string{"group-accounts-by-group", "1"}, expCount: 1},
+		"group accounts by admin":    {srcArgs: []string{"group-accounts-by-admin", myAddr}, expCount: 1},
+		"proposal":                   {srcArgs: []string{"proposal", proposalID}, expCount: -1},
+		"proposals by group account": {srcArgs: []string{"proposals-by-group-account", accountAddr}, expCount: 1},
+		"proposals by proposer":      {srcArgs: []string{"proposals-by-proposer", myAddr}, expCount: 1},
+		"vote":                       {srcArgs: []string{"vote", proposalID, myAddr}, expCount: -1},
+		"votes by proposal":          {srcArgs: []string{"votes-by-proposal", proposalID}, expCount: 1},
+		"votes by voter":             {srcArgs: []string{"votes-by-voter", myAddr}, expCount: 1},
+		"votes by voter second page": {srcArgs: []string{"votes-by-voter", myAddr, "--page=2", "--limit=1"}, expCount: 0},
+		"proposal not executed":      {srcArgs: []string{"proposal-execution", proposalID}, expErr: true},
+		"unknown group":              {srcArgs: []string{"group", "100"}, expErr: true},
+		"invalid group id":           {srcArgs: []string{"group", "foo"}, expErr: true},
+		"invalid address":            {srcArgs: []string{"groups-by-admin", "foo"}, expErr: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var out bytes.Buffer
+			cmd := group.GetQueryCmd(f.app.Codec())
+			cmd.SetArgs(spec.srcArgs)
+			cmd.SetOut(&out)
+			cmd.SetErr(ioutil.Discard)
+			err := cmd.Execute()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assertQueryResult(t, out.Bytes(), spec.expCount)
+		})
+	}
+}
+
+func TestTxCmds(t *testing.T) {
+	f := setupClientFixture(t)
+	defer f.node.Close()
+	defer viper.Reset()
+	tmpDir, err := ioutil.TempDir("", "group-client")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+	viper.Set(flags.FlagHome, tmpDir)
+	viper.Set(flags.FlagKeyringBackend, "test")
+	viper.Set(flags.FlagChainID, "testchain")
+	viper.Set(flags.FlagGenerateOnly, true)
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(tmpDir, name)
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+	myAddr, accountAddr, proposalID := f.myAddr.String(), f.accountAddr.String(), fmt.Sprint(f.myProposalID)
+	membersFile := writeFile("members.json", fmt.Sprintf(`[{"address": %q, "power": "1", "comment": "me"}]`, myAddr))
+	policyFile := writeFile("policy.json", `{"threshold": {"threshold": "1", "timout": "3600s"}}`)
+	// executed in order as they modify the state
+	specs := []struct {
+		name    string
+		srcArgs []string
+		expMsg  sdk.Msg
+		expErr  bool
+	}{
+		{
+			name:    "create group",
+			srcArgs: []string{"create-group", myAddr, membersFile, "my group"},
+			expMsg: group.MsgCreateGroup{
+				Admin:   f.myAddr,
+				Members: []group.Member{{Address: f.myAddr, Power: sdk.OneDec(), Comment: "me"}},
+				Comment: "my group",
+			},
+		},
+		{
+			name:    "update group members",
+			srcArgs: []string{"update-group-members", myAddr, "2", membersFile},
+			expMsg: group.MsgUpdateGroupMembers{
+				Admin:         f.myAddr,
+				Group:         2,
+				MemberUpdates: []group.Member{{Address: f.myAddr, Power: sdk.OneDec(), Comment: "me"}},
+			},
+		},
+		{
+			name:    "update group comment",
+			srcArgs: []string{"update-group-comment", myAddr, "2", "new comment"},
+			expMsg:  group.MsgUpdateGroupComment{Admin: f.myAddr, Group: 2, Comment: "new comment"},
+		},
+		{
+			name:    "create group account",
+			srcArgs: []string{"create-group-account", myAddr, "2", policyFile, "my account", "--auto-exec"},
+			expMsg: group.MsgCreateGroupAccountStd{
+				Base: group.MsgCreateGroupAccountBase{Admin: f.myAddr, Group: 2, Comment: "my account", AutoExec: true},
+				DecisionPolicy: group.StdDecisionPolicy{Sum: &group.StdDecisionPolicy_Threshold{
+					Threshold: &group.ThresholdDecisionPolicy{Threshold: sdk.OneDec(), Timout: proto.Duration{Seconds: 3600}},
+				}},
+			},
+		},
+		{
+			name:    "create group account with electorate snapshot",
+			srcArgs: []string{"create-group-account", myAddr, "2", policyFile, "my account", "--snapshot-electorate"},
+			expMsg: group.MsgCreateGroupAccountStd{
+				Base: group.MsgCreateGroupAccountBase{Admin: f.myAddr, Group: 2, Comment: "my account", SnapshotElectorate: true},
+				DecisionPolicy: group.StdDecisionPolicy{Sum: &group.StdDecisionPolicy_Threshold{
+					Threshold: &group.ThresholdDecisionPolicy{Threshold: sdk.OneDec(), Timout: proto.Duration{Seconds: 3600}},
+				}},
+			},
+		},
+		{
+			name: "create group account with msg types",
+			srcArgs: []string{"create-group-account", myAddr, "2", policyFile, "my account",
+				"--allow-msg-types", "bank/send,distr", "--deny-msg-types", "staking"},
+			expMsg: group.MsgCreateGroupAccountStd{
+				Base: group.MsgCreateGroupAccountBase{Admin: f.myAddr, Group: 2, Comment: "my account",
+					AllowedMsgTypes: []group.MsgType{{Route: "bank", Type: "send"}, {Route: "distr"}},
+					DeniedMsgTypes:  []group.MsgType{{Route: "staking"}},
+				},
+				DecisionPolicy: group.StdDecisionPolicy{Sum: &group.StdDecisionPolicy_Threshold{
+					Threshold: &group.ThresholdDecisionPolicy{Threshold: sdk.OneDec(), Timout: proto.Duration{Seconds: 3600}},
+				}},
+			},
+		},
+		{
+			name:    "vote",
+			srcArgs: []string{"vote", myAddr, proposalID, "no", "changed my mind"},
+			expMsg:  group.MsgVote{Proposal: f.myProposalID, Voters: []sdk.AccAddress{f.myAddr}, Choice: group.Choice_NO, Comment: "changed my mind"},
+		},
+		{
+			name:    "withdraw proposal",
+			srcArgs: []string{"withdraw-proposal", myAddr, proposalID},
+			expMsg:  group.MsgWithdrawProposal{Proposal: f.myProposalID, Signer: f.myAddr},
+		},
+		{
+			name:    "update group account comment",
+			srcArgs: []string{"update-group-account-comment", myAddr, accountAddr, "new comment"},
+			expMsg:  group.MsgUpdateGroupAccountComment{Admin: f.myAddr, GroupAccount: f.accountAddr, Comment: "new comment"},
+		},
+		{
+			name:    "invalid choice",
+			srcArgs: []string{"vote", myAddr, proposalID, "unknown"},
+			expErr:  true,
+		},
+		{
+			name:    "missing members file",
+			srcArgs: []string{"create-group", myAddr, filepath.Join(tmpDir, "unknown.json"), "my group"},
+			expErr:  true,
+		},
+		{
+			name:    "invalid msg",
+			srcArgs: []string{"update-group-comment", myAddr, "0", "new comment"},
+			expErr:  true,
+		},
+	}
+
+	app := f.app
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.NewContext(false, header)
+	myAccount := app.AccountKeeper.GetAccount(ctx, f.myAddr)
+	fee := types.NewTestStdFee()
+	for _, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			var out bytes.Buffer
+			cmd := group.GetTxCmd(app.Codec())
+			cmd.SetArgs(spec.srcArgs)
+			cmd.SetOut(&out)
+			cmd.SetErr(ioutil.Discard)
+			err := cmd.Execute()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var unsignedTx types.StdTx
+			require.NoError(t, app.Codec().UnmarshalJSON(out.Bytes(), &unsignedTx))
+			require.Len(t, unsignedTx.Msgs, 1)
+			assert.Equal(t, spec.expMsg, unsignedTx.Msgs[0])
+
+			// and the generated msg is accepted by the app
+			accSeq, err := app.AccountKeeper.GetSequence(ctx, f.myAddr)
+			require.NoError(t, err)
+			tx := types.NewTestTx(ctx, unsignedTx.Msgs, []crypto.PrivKey{f.myKey}, []uint64{myAccount.GetAccountNumber()}, []uint64{accSeq}, fee)
+			resp := app.DeliverTx(abci.RequestDeliverTx{Tx: app.Codec().MustMarshalBinaryLengthPrefixed(tx)})
+			require.Equal(t, uint32(0), resp.Code, resp.Log)
+		})
+	}
+}
+
+func TestRESTRoutes(t *testing.T) {
+	f := setupClientFixture(t)
+	defer f.node.Close()
+	client, err := rpcclient.NewHTTP(f.node.URL, "/websocket")
+	require.NoError(t, err)
+	cliCtx := context.NewCLIContext().WithClient(client).WithTrustNode(true).WithCodec(f.app.Codec())
+	r := mux.NewRouter()
+	group.RegisterRESTRoutes(cliCtx, r)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	myAddr, accountAddr, proposalID := f.myAddr.String(), f.accountAddr.String(), fmt.Sprint(f.myProposalID)
+	baseReq := fmt.Sprintf(`"base_req": {"from": %q, "chain_id": "testchain"}`, myAddr)
+	specs := map[string]struct {
+		srcMethod string
+		srcPath   string
+		srcBody   string
+		expCode   int
+		expCount  int // number of list elements, -1 for a single object
+		expMsg    sdk.Msg
+	}{
+		"query group": {
+			srcPath:  "/group/groups/1",
+			expCode:  http.StatusOK,
+			expCount: -1,
+		},
+		"query group members": {
+			srcPath:  "/group/groups/1/members",
+			expCode:  http.StatusOK,
+			expCount: 3,
+		},
+		"query group accounts by admin with pagination": {
+			srcPath:  "/group/admins/" + myAddr + "/accounts?page=2&limit=1",
+			expCode:  http.StatusOK,
+			expCount: 0,
+		},
+		"query proposals by group account": {
+			srcPath:  "/group/accounts/" + accountAddr + "/proposals",
+			expCode:  http.StatusOK,
+			expCount: 1,
+		},
+		"query vote": {
+			srcPath:  "/group/proposals/" + proposalID + "/votes/" + myAddr,
+			expCode:  http.StatusOK,
+			expCount: -1,
+		},
+		"query votes by voter": {
+			srcPath:  "/group/voters/" + myAddr + "/votes",
+			expCode:  http.StatusOK,
+			expCount: 1,
+		},
+		"query with invalid address": {
+			srcPath: "/group/admins/foo/groups",
+			expCode: http.StatusBadRequest,
+		},
+		"query with invalid page": {
+			srcPath: "/group/groups/1/members?page=foo",
+			expCode: http.StatusBadRequest,
+		},
+		"query unknown proposal": {
+			srcPath: "/group/proposals/100",
+			expCode: http.StatusInternalServerError,
+		},
+		"create group": {
+			srcMethod: http.MethodPost,
+			srcPath:   "/group/groups",
+			srcBody:   fmt.Sprintf(`{%s, "members": [{"address": %q, "power": "1"}], "comment": "my group"}`, baseReq, myAddr),
+			expCode:   http.StatusOK,
+			expMsg: group.MsgCreateGroup{
+				Admin:   f.myAddr,
+				Members: []group.Member{{Address: f.myAddr, Power: sdk.OneDec()}},
+				Comment: "my group",
+			},
+		},
+		"create group account": {
+			srcMethod: http.MethodPost,
+			srcPath:   "/group/accounts",
+			srcBody:   fmt.Sprintf(`{%s, "group": "1", "decision_policy": {"threshold": {"threshold": "1", "timout": "1s"}}}`, baseReq),
+			expCode:   http.StatusOK,
+			expMsg: group.MsgCreateGroupAccountStd{
+				Base: group.MsgCreateGroupAccountBase{Admin: f.myAddr, Group: 1},
+				DecisionPolicy: group.StdDecisionPolicy{Sum: &group.StdDecisionPolicy_Threshold{
+					Threshold: &group.ThresholdDecisionPolicy{Threshold: sdk.OneDec(), Timout: proto.Duration{Seconds: 1}},
+				}},
+			},
+		},
+		"vote": {
+			srcMethod: http.MethodPost,
+			srcPath:   "/group/proposals/" + proposalID + "/votes",
+			srcBody:   fmt.Sprintf(`{%s, "choice": "ABSTAIN"}`, baseReq),
+			expCode:   http.StatusOK,
+			expMsg:    group.MsgVote{Proposal: f.myProposalID, Voters: []sdk.AccAddress{f.myAddr}, Choice: group.Choice_ABSTAIN},
+		},
+		"exec": {
+			srcMethod: http.MethodPost,
+			srcPath:   "/group/proposals/" + proposalID + "/exec",
+			srcBody:   fmt.Sprintf(`{%s}`, baseReq),
+			expCode:   http.StatusOK,
+			expMsg:    group.MsgExec{Proposal: f.myProposalID, Signer: f.myAddr},
+		},
+		"tx without chain id": {
+			srcMethod: http.MethodPost,
+			srcPath:   "/group/proposals/" + proposalID + "/exec",
+			srcBody:   fmt.Sprintf(`{"base_req": {"from": %q}}`, myAddr),
+			expCode:   http.StatusUnauthorized,
+		},
+		"invalid msg": {
+			srcMethod: http.MethodPost,
+			srcPath:   "/group/groups/0/comment",
+			srcBody:   fmt.Sprintf(`{%s, "comment": "foo"}`, baseReq),
+			expCode:   http.StatusBadRequest,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			method := spec.srcMethod
+			if method == "" {
+				method = http.MethodGet
+			}
+			req, err := http.NewRequest(method, srv.URL+spec.srcPath, strings.NewReader(spec.srcBody))
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, spec.expCode, resp.StatusCode, string(body))
+			if spec.expCode != http.StatusOK {
+				return
+			}
+			if spec.expMsg == nil {
+				var res struct {
+					Height string          `json:"height"`
+					Result json.RawMessage `json:"result"`
+				}
+				require.NoError(t, json.Unmarshal(body, &res))
+				assert.NotEmpty(t, res.Height)
+				assertQueryResult(t, res.Result, spec.expCount)
+				return
+			}
+			var unsignedTx types.StdTx
+			require.NoError(t, f.app.Codec().UnmarshalJSON(body, &unsignedTx))
+			require.Len(t, unsignedTx.Msgs, 1)
+			assert.Equal(t, spec.expMsg, unsignedTx.Msgs[0])
+		})
+	}
+}
+
+// assertQueryResult checks that bz is a json list with expCount elements or a json object when expCount is -1.
+func assertQueryResult(t *testing.T, bz []byte, expCount int) {
+	if expCount < 0 {
+		var obj map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(bz, &obj), string(bz))
+		assert.NotEmpty(t, obj)
+		return
+	}
+	var list []json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &list), string(bz))
+	assert.Len(t, list, expCount)
+}
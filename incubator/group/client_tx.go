@@ -0,0 +1,420 @@
+package group
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAutoExec           = "auto-exec"
+	flagSnapshotElectorate = "snapshot-electorate"
+	flagAllowMsgTypes      = "allow-msg-types"
+	flagDenyMsgTypes       = "deny-msg-types"
+)
+
+// GetTxCmd returns the transaction commands for the group module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(flags.PostCommands(
+		CreateGroupCmd(cdc),
+		UpdateGroupMembersCmd(cdc),
+		UpdateGroupAdminCmd(cdc),
+		UpdateGroupCommentCmd(cdc),
+		CreateGroupAccountCmd(cdc),
+		UpdateGroupAccountAdminCmd(cdc),
+		UpdateGroupAccountDecisionPolicyCmd(cdc),
+		UpdateGroupAccountCommentCmd(cdc),
+		VoteCmd(cdc),
+		ExecCmd(cdc),
+		WithdrawProposalCmd(cdc),
+	)...)
+	return txCmd
+}
+
+// CreateGroupCmd creates a cli command to create a group.
+func CreateGroupCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-group [admin] [members-json-file] [comment]",
+		Short: "Create a group with the members from the json file",
+		Long: `Create a group with the members from the json file. The file must contain a json array of members:
+
+[{"address": "cosmos1...", "power": "1", "comment": "first"}]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			members, err := parseMembersFile(args[1])
+			if err != nil {
+				return err
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgCreateGroup{Admin: from, Members: members, Comment: args[2]}
+			})
+		},
+	}
+}
+
+// UpdateGroupMembersCmd creates a cli command to add, update or remove group members.
+func UpdateGroupMembersCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-members [admin] [group-id] [members-json-file]",
+		Short: "Update the group members from the json file, set power to 0 to remove a member",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+			members, err := parseMembersFile(args[2])
+			if err != nil {
+				return err
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgUpdateGroupMembers{Admin: from, Group: groupID, MemberUpdates: members}
+			})
+		},
+	}
+}
+
+// UpdateGroupAdminCmd creates a cli command to set a new group admin.
+func UpdateGroupAdminCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-admin [admin] [group-id] [new-admin]",
+		Short: "Set a new admin for the group",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.Wrap(err, "new admin")
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgUpdateGroupAdmin{Admin: from, Group: groupID, NewAdmin: newAdmin}
+			})
+		},
+	}
+}
+
+// UpdateGroupCommentCmd creates a cli command to set a new group comment.
+func UpdateGroupCommentCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-comment [admin] [group-id] [comment]",
+		Short: "Set a new comment for the group",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgUpdateGroupComment{Admin: from, Group: groupID, Comment: args[2]}
+			})
+		},
+	}
+}
+
+// CreateGroupAccountCmd creates a cli command to create a group account.
+func CreateGroupAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-account [admin] [group-id] [decision-policy-json-file] [comment]",
+		Short: "Create a group account with the decision policy from the json file",
+		Long: `Create a group account with the decision policy from the json file. The file must contain a
+StdDecisionPolicy in protobuf json format:
+
+{"threshold": {"threshold": "2", "timout": "86400s"}}`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+			policy, err := parseDecisionPolicyFile(args[2])
+			if err != nil {
+				return err
+			}
+			autoExec, err := cmd.Flags().GetBool(flagAutoExec)
+			if err != nil {
+				return err
+			}
+			snapshot, err := cmd.Flags().GetBool(flagSnapshotElectorate)
+			if err != nil {
+				return err
+			}
+			allowed, err := parseMsgTypesFlag(cmd, flagAllowMsgTypes)
+			if err != nil {
+				return err
+			}
+			denied, err := parseMsgTypesFlag(cmd, flagDenyMsgTypes)
+			if err != nil {
+				return err
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgCreateGroupAccountStd{
+					Base: MsgCreateGroupAccountBase{
+						Admin:              from,
+						Group:              groupID,
+						Comment:            args[3],
+						AutoExec:           autoExec,
+						SnapshotElectorate: snapshot,
+						AllowedMsgTypes:    allowed,
+						DeniedMsgTypes:     denied,
+					},
+					DecisionPolicy: policy,
+				}
+			})
+		},
+	}
+	cmd.Flags().Bool(flagAutoExec, false, "Execute accepted proposals in the end blocker")
+	cmd.Flags().Bool(flagSnapshotElectorate, false, "Weight votes by the group members at proposal submission")
+	cmd.Flags().StringSlice(flagAllowMsgTypes, nil, "Comma separated msg types as route/type or route that proposals are restricted to")
+	cmd.Flags().StringSlice(flagDenyMsgTypes, nil, "Comma separated msg types as route/type or route that proposals must not contain")
+	return cmd
+}
+
+// UpdateGroupAccountAdminCmd creates a cli command to set a new group account admin.
+func UpdateGroupAccountAdminCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-account-admin [admin] [group-account] [new-admin]",
+		Short: "Set a new admin for the group account",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupAccount, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.Wrap(err, "group account")
+			}
+			newAdmin, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.Wrap(err, "new admin")
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgUpdateGroupAccountAdmin{Admin: from, GroupAccount: groupAccount, NewAdmin: newAdmin}
+			})
+		},
+	}
+}
+
+// UpdateGroupAccountDecisionPolicyCmd creates a cli command to set a new decision policy for a group account.
+func UpdateGroupAccountDecisionPolicyCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-account-policy [admin] [group-account] [decision-policy-json-file]",
+		Short: "Set a new decision policy from the json file for the group account",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupAccount, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.Wrap(err, "group account")
+			}
+			policy, err := parseDecisionPolicyFile(args[2])
+			if err != nil {
+				return err
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgUpdateGroupAccountDecisionPolicyStd{
+					Base:           MsgUpdateGroupAccountBase{Admin: from, GroupAccount: groupAccount},
+					DecisionPolicy: policy,
+				}
+			})
+		},
+	}
+}
+
+// UpdateGroupAccountCommentCmd creates a cli command to set a new comment for a group account.
+func UpdateGroupAccountCommentCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-group-account-comment [admin] [group-account] [comment]",
+		Short: "Set a new comment for the group account",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			groupAccount, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.Wrap(err, "group account")
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgUpdateGroupAccountComment{Admin: from, GroupAccount: groupAccount, Comment: args[2]}
+			})
+		},
+	}
+}
+
+// VoteCmd creates a cli command to vote on a proposal.
+func VoteCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote [voter] [proposal-id] [choice] [comment]",
+		Short: "Vote on a proposal",
+		Long:  "Vote on a proposal. The choice is one of: yes, no, abstain, veto",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[1])
+			if err != nil {
+				return err
+			}
+			choice, err := parseChoice(args[2])
+			if err != nil {
+				return err
+			}
+			var comment string
+			if len(args) == 4 {
+				comment = args[3]
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgVote{Proposal: proposalID, Voters: []sdk.AccAddress{from}, Choice: choice, Comment: comment}
+			})
+		},
+	}
+}
+
+// ExecCmd creates a cli command to execute a proposal.
+func ExecCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [signer] [proposal-id]",
+		Short: "Execute a proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[1])
+			if err != nil {
+				return err
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgExec{Proposal: proposalID, Signer: from}
+			})
+		},
+	}
+}
+
+// WithdrawProposalCmd creates a cli command to withdraw a proposal.
+func WithdrawProposalCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-proposal [signer] [proposal-id]",
+		Short: "Withdraw a submitted proposal, the signer must be a proposer or the group account admin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := parseProposalID(args[1])
+			if err != nil {
+				return err
+			}
+			return generateOrBroadcastMsg(cmd, cdc, args[0], func(from sdk.AccAddress) sdk.Msg {
+				return MsgWithdrawProposal{Proposal: proposalID, Signer: from}
+			})
+		},
+	}
+}
+
+// generateOrBroadcastMsg builds the message for the `from` key or address and generates, signs or broadcasts the
+// transaction depending on the flags. The output is written to the command's out stream.
+func generateOrBroadcastMsg(cmd *cobra.Command, cdc *codec.Codec, from string, msgFn func(from sdk.AccAddress) sdk.Msg) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, from).WithCodec(cdc).WithOutput(cmd.OutOrStdout())
+
+	msg := msgFn(cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
+
+func parseGroupID(s string) (GroupID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "group id")
+	}
+	return GroupID(id), nil
+}
+
+func parseProposalID(s string) (ProposalID, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "proposal id")
+	}
+	return ProposalID(id), nil
+}
+
+// parseChoice parses the case insensitive vote choice name.
+func parseChoice(s string) (Choice, error) {
+	c, ok := Choice_value[strings.ToUpper(s)]
+	if !ok || Choice(c) == Choice_UNKNOWN {
+		return Choice_UNKNOWN, errors.Wrapf(ErrInvalid, "choice: %q", s)
+	}
+	return Choice(c), nil
+}
+
+// parseMsgTypes parses msg types in `route/type` format. The type is optional to match all msgs of a route.
+func parseMsgTypes(ss []string) ([]MsgType, error) {
+	var result []MsgType
+	for _, s := range ss {
+		parts := strings.SplitN(s, "/", 2)
+		t := MsgType{Route: parts[0]}
+		if len(parts) == 2 {
+			t.Type = parts[1]
+		}
+		if err := t.ValidateBasic(); err != nil {
+			return nil, errors.Wrapf(err, "msg type: %q", s)
+		}
+		result = append(result, t)
+	}
+	return result, nil
+}
+
+func parseMsgTypesFlag(cmd *cobra.Command, flag string) ([]MsgType, error) {
+	ss, err := cmd.Flags().GetStringSlice(flag)
+	if err != nil {
+		return nil, err
+	}
+	return parseMsgTypes(ss)
+}
+
+// parseMembers decodes a json array of members with bech32 addresses.
+func parseMembers(bz []byte) (Members, error) {
+	var members Members
+	if err := json.Unmarshal(bz, &members); err != nil {
+		return nil, errors.Wrap(err, "members")
+	}
+	return members, nil
+}
+
+func parseMembersFile(path string) (Members, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "members file")
+	}
+	return parseMembers(bz)
+}
+
+// parseDecisionPolicy decodes a StdDecisionPolicy in protobuf json format.
+func parseDecisionPolicy(bz []byte) (StdDecisionPolicy, error) {
+	var policy StdDecisionPolicy
+	if err := jsonpb.Unmarshal(bytes.NewReader(bz), &policy); err != nil {
+		return StdDecisionPolicy{}, errors.Wrap(err, "decision policy")
+	}
+	return policy, nil
+}
+
+func parseDecisionPolicyFile(path string) (StdDecisionPolicy, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return StdDecisionPolicy{}, errors.Wrap(err, "decision policy file")
+	}
+	return parseDecisionPolicy(bz)
+}
@@ -26,13 +26,21 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUpdateGroupAdmin{}, "cosmos-sdk/MsgUpdateGroupAdmin", nil)
 	cdc.RegisterConcrete(MsgUpdateGroupComment{}, "cosmos-sdk/MsgUpdateGroupComment", nil)
 	cdc.RegisterConcrete(MsgCreateGroupAccountStd{}, "cosmos-sdk/MsgCreateGroupAccountStd", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupAccountAdmin{}, "cosmos-sdk/MsgUpdateGroupAccountAdmin", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupAccountDecisionPolicyStd{}, "cosmos-sdk/MsgUpdateGroupAccountDecisionPolicyStd", nil)
+	cdc.RegisterConcrete(MsgUpdateGroupAccountComment{}, "cosmos-sdk/MsgUpdateGroupAccountComment", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/group/MsgVote", nil)
 	cdc.RegisterConcrete(MsgExec{}, "cosmos-sdk/group/MsgExec", nil)
+	cdc.RegisterConcrete(MsgWithdrawProposal{}, "cosmos-sdk/group/MsgWithdrawProposal", nil)
 
 	// oh man... amino
 	cdc.RegisterConcrete(StdDecisionPolicy{}, "cosmos-sdk/StdDecisionPolicy", nil)
 	cdc.RegisterConcrete(&StdDecisionPolicy_Threshold{}, "cosmos-sdk/StdDecisionPolicy_Threshold", nil)
 	cdc.RegisterConcrete(ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
+	cdc.RegisterConcrete(&StdDecisionPolicy_Percentage{}, "cosmos-sdk/StdDecisionPolicy_Percentage", nil)
+	cdc.RegisterConcrete(PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+	cdc.RegisterConcrete(&StdDecisionPolicy_Quorum{}, "cosmos-sdk/StdDecisionPolicy_Quorum", nil)
+	cdc.RegisterConcrete(QuorumDecisionPolicy{}, "cosmos-sdk/QuorumDecisionPolicy", nil)
 	cdc.RegisterInterface((*isStdDecisionPolicy_Sum)(nil), nil)
 }
 
@@ -0,0 +1,21 @@
+package group
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type sdkContextKey struct{}
+
+// WrapSDKContext returns a context.Context that carries the sdk.Context for the Msg and Query service
+// implementations.
+func WrapSDKContext(ctx sdk.Context) context.Context {
+	return context.WithValue(ctx.Context(), sdkContextKey{}, ctx)
+}
+
+// UnwrapSDKContext returns the sdk.Context stored by WrapSDKContext. It panics when the context does not carry a
+// sdk.Context.
+func UnwrapSDKContext(goCtx context.Context) sdk.Context {
+	return goCtx.Value(sdkContextKey{}).(sdk.Context)
+}
@@ -0,0 +1,83 @@
+package group
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// group module event types
+const (
+	EventTypeCreateGroup                      = "create_group"
+	EventTypeUpdateGroupMembers               = "update_group_members"
+	EventTypeUpdateGroupAdmin                 = "update_group_admin"
+	EventTypeUpdateGroupComment               = "update_group_comment"
+	EventTypeCreateGroupAccount               = "create_group_account"
+	EventTypeUpdateGroupAccountAdmin          = "update_group_account_admin"
+	EventTypeUpdateGroupAccountDecisionPolicy = "update_group_account_decision_policy"
+	EventTypeUpdateGroupAccountComment        = "update_group_account_comment"
+	EventTypeSubmitProposal                   = "submit_proposal"
+	EventTypeVote                             = "vote"
+	EventTypeWithdrawProposal                 = "withdraw_proposal"
+	EventTypeProposalFinalized                = "proposal_finalized"
+	EventTypeExecProposal                     = "exec_proposal"
+	EventTypeProposalPruned                   = "proposal_pruned"
+)
+
+// group module event attributes
+const (
+	AttributeKeyGroup             = "group_id"
+	AttributeKeyGroupAccount      = "group_account"
+	AttributeKeyProposal          = "proposal_id"
+	AttributeKeyVoter             = "voter"
+	AttributeKeyChoice            = "choice"
+	AttributeKeyStatus            = "status"
+	AttributeKeyResult            = "result"
+	AttributeKeyExecutorResult    = "executor_result"
+	AttributeKeyVersion           = "version"
+	AttributeKeyMembershipVersion = "membership_version"
+)
+
+// newGroupEvent returns an event with the group id, version and membership version attributes set.
+func newGroupEvent(eventType string, g GroupMetadata) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyGroup, fmt.Sprintf("%d", g.Group)),
+		sdk.NewAttribute(AttributeKeyVersion, fmt.Sprintf("%d", g.Version)),
+		sdk.NewAttribute(AttributeKeyMembershipVersion, fmt.Sprintf("%d", g.MembershipVersion)),
+	)
+}
+
+// newGroupAccountEvent returns an event with the group account, group id and version attributes set.
+func newGroupAccountEvent(eventType string, a GroupAccountMetadataBase) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyGroupAccount, a.GroupAccount.String()),
+		sdk.NewAttribute(AttributeKeyGroup, fmt.Sprintf("%d", a.Group)),
+		sdk.NewAttribute(AttributeKeyVersion, fmt.Sprintf("%d", a.Version)),
+	)
+}
+
+// newProposalEvent returns an event with the proposal id and group account attributes set. Additional attributes
+// are appended.
+func newProposalEvent(eventType string, id ProposalID, base ProposalBase, attrs ...sdk.Attribute) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyProposal, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(AttributeKeyGroupAccount, base.GroupAccount.String()),
+	).AppendAttributes(attrs...)
+}
+
+// emitProposalFinalized emits an event when the proposal status changed from submitted to a final status.
+func emitProposalFinalized(ctx sdk.Context, id ProposalID, oldStatus ProposalBase_Status, base ProposalBase) {
+	if oldStatus != ProposalStatusSubmitted || base.Status == ProposalStatusSubmitted {
+		return
+	}
+	ctx.EventManager().EmitEvent(newProposalEvent(EventTypeProposalFinalized, id, base,
+		sdk.NewAttribute(AttributeKeyStatus, base.Status.String()),
+		sdk.NewAttribute(AttributeKeyResult, base.Result.String()),
+	))
+}
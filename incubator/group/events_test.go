@@ -0,0 +1,204 @@
+package group_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupEvents(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+	h := group.NewHandler(k)
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+	myMember := sdk.AccAddress([]byte("valid-member-address"))
+
+	res, err := h(ctx, group.MsgCreateGroup{
+		Admin:   myAdmin,
+		Members: []group.Member{{Address: myMember, Power: sdk.OneDec()}},
+		Comment: "test",
+	})
+	require.NoError(t, err)
+	assertEvent(t, res.Events, group.EventTypeCreateGroup, map[string]string{
+		group.AttributeKeyGroup:             "1",
+		group.AttributeKeyVersion:           "1",
+		group.AttributeKeyMembershipVersion: "1",
+	})
+
+	specs := []struct {
+		name         string
+		src          sdk.Msg
+		expEventType string
+		expAttrs     map[string]string
+	}{
+		{
+			name:         "update comment",
+			src:          group.MsgUpdateGroupComment{Group: 1, Admin: myAdmin, Comment: "other"},
+			expEventType: group.EventTypeUpdateGroupComment,
+			expAttrs:     map[string]string{group.AttributeKeyGroup: "1", group.AttributeKeyVersion: "2", group.AttributeKeyMembershipVersion: "1"},
+		},
+		{
+			name: "update members",
+			src: group.MsgUpdateGroupMembers{Group: 1, Admin: myAdmin, MemberUpdates: []group.Member{
+				{Address: myMember, Power: sdk.NewDec(2)},
+			}},
+			expEventType: group.EventTypeUpdateGroupMembers,
+			expAttrs:     map[string]string{group.AttributeKeyGroup: "1", group.AttributeKeyVersion: "2", group.AttributeKeyMembershipVersion: "2"},
+		},
+		{
+			name:         "update admin",
+			src:          group.MsgUpdateGroupAdmin{Group: 1, Admin: myAdmin, NewAdmin: myMember},
+			expEventType: group.EventTypeUpdateGroupAdmin,
+			expAttrs:     map[string]string{group.AttributeKeyGroup: "1", group.AttributeKeyVersion: "3", group.AttributeKeyMembershipVersion: "2"},
+		},
+	}
+	// executed in order as each step increments either the metadata or the membership version
+	for _, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			res, err := h(ctx, spec.src)
+			require.NoError(t, err)
+			assertEvent(t, res.Events, spec.expEventType, spec.expAttrs)
+		})
+	}
+}
+
+func TestGroupAccountEvents(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+	h := group.NewHandler(k)
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+
+	myGroupID, err := k.CreateGroup(ctx, myAdmin, []group.Member{{Address: myAdmin, Power: sdk.OneDec()}}, "test")
+	require.NoError(t, err)
+
+	res, err := h(ctx, group.MsgCreateGroupAccountStd{
+		Base: group.MsgCreateGroupAccountBase{
+			Admin:   myAdmin,
+			Group:   myGroupID,
+			Comment: "test",
+		},
+		DecisionPolicy: group.StdDecisionPolicy{
+			Sum: &group.StdDecisionPolicy_Threshold{Threshold: &group.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Timout:    types.Duration{Seconds: 1},
+			}}},
+	})
+	require.NoError(t, err)
+	accountAddr := sdk.AccAddress(res.Data)
+	assertEvent(t, res.Events, group.EventTypeCreateGroupAccount, map[string]string{
+		group.AttributeKeyGroupAccount: accountAddr.String(),
+		group.AttributeKeyGroup:        fmt.Sprintf("%d", myGroupID),
+		group.AttributeKeyVersion:      "1",
+	})
+
+	res, err = h(ctx, group.MsgUpdateGroupAccountComment{Admin: myAdmin, GroupAccount: accountAddr, Comment: "other"})
+	require.NoError(t, err)
+	assertEvent(t, res.Events, group.EventTypeUpdateGroupAccountComment, map[string]string{
+		group.AttributeKeyGroupAccount: accountAddr.String(),
+		group.AttributeKeyVersion:      "2",
+	})
+}
+
+func TestProposalEvents(t *testing.T) {
+	blockTime := time.Now().UTC()
+	k, parentCtx, _ := createTestKeeper(blockTime)
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+	myMember := sdk.AccAddress([]byte("valid-member-address"))
+
+	myGroupID, err := k.CreateGroup(parentCtx, myAdmin, []group.Member{{Address: myMember, Power: sdk.OneDec()}}, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(parentCtx, myAdmin, myGroupID, &policy, "test")
+	require.NoError(t, err)
+
+	t.Run("submit, vote and exec", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		proposalID, err := k.CreateProposal(ctx, accountAddr, "test", []sdk.AccAddress{myMember}, nil)
+		require.NoError(t, err)
+		assertEvent(t, ctx.EventManager().Events(), group.EventTypeSubmitProposal, map[string]string{
+			group.AttributeKeyProposal:     fmt.Sprintf("%d", proposalID),
+			group.AttributeKeyGroupAccount: accountAddr.String(),
+		})
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.Vote(ctx, proposalID, []sdk.AccAddress{myMember}, group.Choice_YES, ""))
+		assertEvent(t, ctx.EventManager().Events(), group.EventTypeVote, map[string]string{
+			group.AttributeKeyProposal: fmt.Sprintf("%d", proposalID),
+			group.AttributeKeyVoter:    myMember.String(),
+			group.AttributeKeyChoice:   group.Choice_YES.String(),
+		})
+		assertEvent(t, ctx.EventManager().Events(), group.EventTypeProposalFinalized, map[string]string{
+			group.AttributeKeyProposal: fmt.Sprintf("%d", proposalID),
+			group.AttributeKeyStatus:   group.ProposalStatusClosed.String(),
+			group.AttributeKeyResult:   group.ProposalResultAccepted.String(),
+		})
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.ExecProposal(ctx, proposalID))
+		assertEvent(t, ctx.EventManager().Events(), group.EventTypeExecProposal, map[string]string{
+			group.AttributeKeyProposal:       fmt.Sprintf("%d", proposalID),
+			group.AttributeKeyExecutorResult: group.ProposalExecutorResultSuccess.String(),
+		})
+		assertNoEvent(t, ctx.EventManager().Events(), group.EventTypeProposalFinalized)
+	})
+	t.Run("withdraw", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		proposalID, err := k.CreateProposal(ctx, accountAddr, "test", []sdk.AccAddress{myMember}, nil)
+		require.NoError(t, err)
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.WithdrawProposal(ctx, proposalID, myMember))
+		assertEvent(t, ctx.EventManager().Events(), group.EventTypeWithdrawProposal, map[string]string{
+			group.AttributeKeyProposal: fmt.Sprintf("%d", proposalID),
+			group.AttributeKeyStatus:   group.ProposalStatusWithdrawn.String(),
+		})
+	})
+	t.Run("expired", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		proposalID, err := k.CreateProposal(ctx, accountAddr, "test", []sdk.AccAddress{myMember}, nil)
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockTime(blockTime.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, k.ProcessExpiredProposals(ctx))
+		assertEvent(t, ctx.EventManager().Events(), group.EventTypeProposalFinalized, map[string]string{
+			group.AttributeKeyProposal: fmt.Sprintf("%d", proposalID),
+			group.AttributeKeyStatus:   group.ProposalStatusClosed.String(),
+			group.AttributeKeyResult:   group.ProposalResultRejected.String(),
+		})
+	})
+}
+
+// assertEvent asserts that an event of the given type with all expected attributes was emitted.
+func assertEvent(t *testing.T, events sdk.Events, eventType string, expAttrs map[string]string) {
+	t.Helper()
+	for _, e := range events {
+		if e.Type != eventType {
+			continue
+		}
+		attrs := make(map[string]string, len(e.Attributes))
+		for _, a := range e.Attributes {
+			attrs[string(a.Key)] = string(a.Value)
+		}
+		assert.Equal(t, group.ModuleName, attrs[sdk.AttributeKeyModule])
+		for k, v := range expAttrs {
+			assert.Equal(t, v, attrs[k], "attribute %q", k)
+		}
+		return
+	}
+	t.Fatalf("event %q not emitted in %v", eventType, events)
+}
+
+func assertNoEvent(t *testing.T, events sdk.Events, eventType string) {
+	t.Helper()
+	for _, e := range events {
+		require.NotEqual(t, eventType, e.Type)
+	}
+}
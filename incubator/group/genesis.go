@@ -1,7 +1,14 @@
 package group
 
 import (
+	"bytes"
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
 )
 
 // NewGenesisState creates a new genesis state with default values.
@@ -11,13 +18,307 @@ func NewGenesisState() *GenesisState {
 	}
 }
 
+// Validate performs a basic validation of all genesis elements and checks the references between them.
+// Proposals are app specific types and are only checked by their keys.
 func (s GenesisState) Validate() error {
-	return s.Params.Validate()
+	if err := s.Params.Validate(); err != nil {
+		return err
+	}
+
+	groupWeights := make(map[GroupID]sdk.Dec)
+	err := forEachGenesisModel(s.Groups, func() proto.Message { return &GroupMetadata{} }, func(_ orm.RowID, obj proto.Message) error {
+		g := obj.(*GroupMetadata)
+		if err := g.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "group %d", g.Group)
+		}
+		if g.Group.Uint64() > s.GroupSeq {
+			return errors.Wrapf(ErrInvalid, "group %d exceeds group sequence", g.Group)
+		}
+		groupWeights[g.Group] = sdk.ZeroDec()
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "groups")
+	}
+
+	err = forEachGenesisModel(s.GroupMembers, func() proto.Message { return &GroupMember{} }, func(_ orm.RowID, obj proto.Message) error {
+		m := obj.(*GroupMember)
+		if err := m.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "member %s", m.Member)
+		}
+		w, ok := groupWeights[m.Group]
+		if !ok {
+			return errors.Wrapf(ErrInvalid, "member %s references unknown group %d", m.Member, m.Group)
+		}
+		groupWeights[m.Group] = w.Add(m.Weight)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "group members")
+	}
+
+	err = forEachGenesisModel(s.Groups, func() proto.Message { return &GroupMetadata{} }, func(_ orm.RowID, obj proto.Message) error {
+		g := obj.(*GroupMetadata)
+		if !g.TotalWeight.Equal(groupWeights[g.Group]) {
+			return errors.Wrapf(ErrInvalid, "total weight of group %d does not match sum of member weights", g.Group)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "groups")
+	}
+
+	var accountAddrs []sdk.AccAddress
+	err = forEachGenesisModel(s.GroupAccounts, func() proto.Message { return &StdGroupAccountMetadata{} }, func(_ orm.RowID, obj proto.Message) error {
+		a := obj.(*StdGroupAccountMetadata)
+		if err := a.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "group account %s", a.Base.GroupAccount)
+		}
+		if _, ok := groupWeights[a.Base.Group]; !ok {
+			return errors.Wrapf(ErrInvalid, "group account %s references unknown group %d", a.Base.GroupAccount, a.Base.Group)
+		}
+		accountAddrs = append(accountAddrs, a.Base.GroupAccount)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "group accounts")
+	}
+	if err := assertGroupAccountsInSeq(accountAddrs, s.GroupAccountSeq); err != nil {
+		return errors.Wrap(err, "group accounts")
+	}
+
+	proposals := make(map[ProposalID]struct{})
+	err = forEachGenesisRowID(s.Proposals, func(rowID orm.RowID) error {
+		id := ProposalID(orm.DecodeSequence(rowID))
+		if id.Uint64() > s.ProposalSeq {
+			return errors.Wrapf(ErrInvalid, "proposal %d exceeds proposal sequence", id)
+		}
+		proposals[id] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "proposals")
+	}
+
+	err = forEachGenesisModel(s.Votes, func() proto.Message { return &Vote{} }, func(_ orm.RowID, obj proto.Message) error {
+		v := obj.(*Vote)
+		if err := v.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "vote by %s", v.Voter)
+		}
+		if _, ok := proposals[v.Proposal]; !ok {
+			return errors.Wrapf(ErrInvalid, "vote by %s references unknown proposal %d", v.Voter, v.Proposal)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "votes")
+	}
+
+	snapshotWeights := make(map[ProposalID]sdk.Dec)
+	err = forEachGenesisModel(s.ElectorateSnapshots, func() proto.Message { return &ElectorateSnapshot{} }, func(_ orm.RowID, obj proto.Message) error {
+		e := obj.(*ElectorateSnapshot)
+		if err := e.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "proposal %d", e.Proposal)
+		}
+		if _, ok := proposals[e.Proposal]; !ok {
+			return errors.Wrapf(ErrInvalid, "electorate snapshot references unknown proposal %d", e.Proposal)
+		}
+		snapshotWeights[e.Proposal] = sdk.ZeroDec()
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "electorate snapshots")
+	}
+
+	err = forEachGenesisModel(s.ElectorateSnapshotMembers, func() proto.Message { return &ElectorateSnapshotMember{} }, func(_ orm.RowID, obj proto.Message) error {
+		m := obj.(*ElectorateSnapshotMember)
+		if err := m.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "member %s", m.Member)
+		}
+		w, ok := snapshotWeights[m.Proposal]
+		if !ok {
+			return errors.Wrapf(ErrInvalid, "member %s references unknown electorate snapshot %d", m.Member, m.Proposal)
+		}
+		snapshotWeights[m.Proposal] = w.Add(m.Weight)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "electorate snapshot members")
+	}
+
+	err = forEachGenesisModel(s.ElectorateSnapshots, func() proto.Message { return &ElectorateSnapshot{} }, func(_ orm.RowID, obj proto.Message) error {
+		e := obj.(*ElectorateSnapshot)
+		if !e.TotalWeight.Equal(snapshotWeights[e.Proposal]) {
+			return errors.Wrapf(ErrInvalid, "total weight of electorate snapshot %d does not match sum of member weights", e.Proposal)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "electorate snapshots")
+	}
+
+	err = forEachGenesisModel(s.ProposalExecutions, func() proto.Message { return &ProposalExecution{} }, func(_ orm.RowID, obj proto.Message) error {
+		e := obj.(*ProposalExecution)
+		if err := e.ValidateBasic(); err != nil {
+			return errors.Wrapf(err, "proposal %d", e.Proposal)
+		}
+		if _, ok := proposals[e.Proposal]; !ok {
+			return errors.Wrapf(ErrInvalid, "proposal execution references unknown proposal %d", e.Proposal)
+		}
+		return nil
+	})
+	return errors.Wrap(err, "proposal executions")
+}
+
+// assertGroupAccountsInSeq checks that every address is derived from a group account sequence value up to seq.
+// Otherwise a group account created after the import could get the address of an existing one.
+func assertGroupAccountsInSeq(addrs []sdk.AccAddress, seq uint64) error {
+	pending := make(map[string]struct{}, len(addrs))
+	for _, a := range addrs {
+		pending[string(a)] = struct{}{}
+	}
+	for id := uint64(1); id <= seq && len(pending) != 0; id++ {
+		delete(pending, string(AccountCondition(id).Address()))
+	}
+	for _, a := range addrs {
+		if _, ok := pending[string(a)]; ok {
+			return errors.Wrapf(ErrInvalid, "group account %s not derived from group account sequence %d", a, seq)
+		}
+	}
+	return nil
+}
+
+// forEachGenesisModel decodes the json encoded `[]orm.Model` and calls the callback for every element.
+func forEachGenesisModel(src json.RawMessage, newModel func() proto.Message, f func(orm.RowID, proto.Message) error) error {
+	if len(src) == 0 {
+		return nil
+	}
+	var models []orm.Model
+	if err := json.Unmarshal(src, &models); err != nil {
+		return errors.Wrap(err, "decode models")
+	}
+	for _, m := range models {
+		obj := newModel()
+		if err := jsonpb.Unmarshal(bytes.NewReader(m.Value), obj); err != nil {
+			return errors.Wrapf(err, "can not unmarshal %s into %T", string(m.Value), obj)
+		}
+		if err := f(m.Key, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forEachGenesisRowID decodes the json encoded `[]orm.Model` and calls the callback with every row id.
+func forEachGenesisRowID(src json.RawMessage, f func(orm.RowID) error) error {
+	if len(src) == 0 {
+		return nil
+	}
+	var models []orm.Model
+	if err := json.Unmarshal(src, &models); err != nil {
+		return errors.Wrap(err, "decode models")
+	}
+	for _, m := range models {
+		if err := f(m.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis initializes the params, all tables and sequences from the given genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	k.setParams(ctx, data.Params)
+
+	if err := importGenesisTable(ctx, k.groupTable, data.Groups, data.GroupSeq); err != nil {
+		return errors.Wrap(err, "groups")
+	}
+	if err := k.MigrateGroupMembershipVersions(ctx); err != nil {
+		return errors.Wrap(err, "groups")
+	}
+	if err := importGenesisTable(ctx, k.groupMemberTable, data.GroupMembers, 0); err != nil {
+		return errors.Wrap(err, "group members")
+	}
+	if err := importGenesisTable(ctx, k.groupAccountTable, data.GroupAccounts, 0); err != nil {
+		return errors.Wrap(err, "group accounts")
+	}
+	if err := k.groupAccountSeq.InitVal(ctx, data.GroupAccountSeq); err != nil {
+		return errors.Wrap(err, "group account sequence")
+	}
+	if err := importGenesisTable(ctx, k.proposalTable, data.Proposals, data.ProposalSeq); err != nil {
+		return errors.Wrap(err, "proposals")
+	}
+	if err := importGenesisTable(ctx, k.voteTable, data.Votes, 0); err != nil {
+		return errors.Wrap(err, "votes")
+	}
+	if err := importGenesisTable(ctx, k.electorateSnapshotTable, data.ElectorateSnapshots, 0); err != nil {
+		return errors.Wrap(err, "electorate snapshots")
+	}
+	if err := importGenesisTable(ctx, k.electorateSnapshotMemberTable, data.ElectorateSnapshotMembers, 0); err != nil {
+		return errors.Wrap(err, "electorate snapshot members")
+	}
+	if err := importGenesisTable(ctx, k.proposalExecutionTable, data.ProposalExecutions, 0); err != nil {
+		return errors.Wrap(err, "proposal executions")
+	}
+	return nil
+}
+
+// importGenesisTable imports the table data. The sequence of tables that implement `orm.SequenceExportable`
+// is initialized even when there is no data to import.
+func importGenesisTable(ctx sdk.Context, t orm.TableExportable, src json.RawMessage, seqValue uint64) error {
+	if len(src) == 0 {
+		src = json.RawMessage(`[]`)
+	}
+	return orm.ImportTableData(ctx, t, src, seqValue)
 }
 
 // ExportGenesis returns a GenesisState for a given context and Keeper.
-func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
-	return &GenesisState{
-		Params: k.GetParams(ctx),
+func ExportGenesis(ctx sdk.Context, k Keeper) (*GenesisState, error) {
+	groups, groupSeq, err := orm.ExportTableData(ctx, k.groupTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "groups")
+	}
+	groupMembers, _, err := orm.ExportTableData(ctx, k.groupMemberTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "group members")
+	}
+	groupAccounts, _, err := orm.ExportTableData(ctx, k.groupAccountTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "group accounts")
 	}
+	proposals, proposalSeq, err := orm.ExportTableData(ctx, k.proposalTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposals")
+	}
+	votes, _, err := orm.ExportTableData(ctx, k.voteTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "votes")
+	}
+	electorateSnapshots, _, err := orm.ExportTableData(ctx, k.electorateSnapshotTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "electorate snapshots")
+	}
+	electorateSnapshotMembers, _, err := orm.ExportTableData(ctx, k.electorateSnapshotMemberTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "electorate snapshot members")
+	}
+	proposalExecutions, _, err := orm.ExportTableData(ctx, k.proposalExecutionTable)
+	if err != nil {
+		return nil, errors.Wrap(err, "proposal executions")
+	}
+	return &GenesisState{
+		Params:          k.GetParams(ctx),
+		Groups:          groups,
+		GroupSeq:        groupSeq,
+		GroupMembers:    groupMembers,
+		GroupAccounts:   groupAccounts,
+		GroupAccountSeq: k.groupAccountSeq.CurVal(ctx),
+		Proposals:       proposals,
+		ProposalSeq:     proposalSeq,
+		Votes:           votes,
+
+		ElectorateSnapshots:       electorateSnapshots,
+		ElectorateSnapshotMembers: electorateSnapshotMembers,
+		ProposalExecutions:        proposalExecutions,
+	}, nil
 }
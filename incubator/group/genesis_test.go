@@ -0,0 +1,336 @@
+package group_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/group/testdata"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+
+	members := []group.Member{
+		{Address: []byte("valid-member-address"), Power: sdk.OneDec()},
+		{Address: []byte("power-member-address"), Power: sdk.NewDec(2)},
+	}
+	myGroupID, err := k.CreateGroup(ctx, []byte("valid--admin-address"), members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(3),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test", group.WithElectorateSnapshot(true))
+	require.NoError(t, err)
+	myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", []sdk.AccAddress{[]byte("valid-member-address")}, nil)
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, myProposalID, []sdk.AccAddress{[]byte("valid-member-address")}, group.Choice_YES, ""))
+
+	policy.Threshold = sdk.OneDec()
+	execAccountAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test")
+	require.NoError(t, err)
+	execProposalID, err := k.CreateProposal(ctx, execAccountAddr, "test", []sdk.AccAddress{[]byte("valid-member-address")}, nil)
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, execProposalID, []sdk.AccAddress{[]byte("valid-member-address")}, group.Choice_YES, ""))
+	require.NoError(t, k.ExecProposal(ctx, execProposalID))
+
+	exported, err := group.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	assert.Equal(t, uint64(1), exported.GroupSeq)
+	assert.Equal(t, uint64(2), exported.GroupAccountSeq)
+	assert.Equal(t, uint64(2), exported.ProposalSeq)
+
+	// round trip through json
+	var buf bytes.Buffer
+	require.NoError(t, (&jsonpb.Marshaler{}).Marshal(&buf, exported))
+	var loaded group.GenesisState
+	require.NoError(t, jsonpb.Unmarshal(&buf, &loaded))
+
+	// when imported into a new store
+	newK, newCtx, _ := createTestKeeper(time.Now().UTC())
+	require.NoError(t, group.InitGenesis(newCtx, newK, loaded))
+
+	// then
+	g, err := newK.GetGroup(newCtx, myGroupID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(3), g.TotalWeight)
+	assert.True(t, newK.HasGroupAccount(newCtx, accountAddr))
+	p, err := newK.GetProposal(newCtx, myProposalID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.OneDec(), p.GetBase().VoteState.YesCount)
+	_, err = newK.GetVote(newCtx, myProposalID, []byte("valid-member-address"))
+	require.NoError(t, err)
+	snapshot, err := newK.GetElectorateSnapshot(newCtx, myProposalID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(3), snapshot.TotalWeight)
+	snapshotMembers, err := newK.GetElectorateSnapshotMembers(newCtx, myProposalID)
+	require.NoError(t, err)
+	assert.Len(t, snapshotMembers, 2)
+	execution, err := newK.GetProposalExecution(newCtx, execProposalID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalExecutorResultSuccess, execution.ExecutorResult)
+
+	reExported, err := group.ExportGenesis(newCtx, newK)
+	require.NoError(t, err)
+	assertJSONEqualGenesis(t, exported, reExported)
+
+	// and sequences continue
+	nextGroupID, err := newK.CreateGroup(newCtx, []byte("valid--admin-address"), members, "test")
+	require.NoError(t, err)
+	assert.Equal(t, myGroupID+1, nextGroupID)
+	nextProposalID, err := newK.CreateProposal(newCtx, accountAddr, "test", []sdk.AccAddress{[]byte("valid-member-address")}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, execProposalID+1, nextProposalID)
+}
+
+func TestInitGenesisDefault(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+	require.NoError(t, group.InitGenesis(ctx, k, *group.NewGenesisState()))
+
+	exported, err := group.ExportGenesis(ctx, k)
+	require.NoError(t, err)
+	assert.Equal(t, group.DefaultParams(), exported.Params)
+	assert.JSONEq(t, `[]`, string(exported.Groups))
+	assert.Equal(t, uint64(0), exported.GroupSeq)
+}
+
+func TestInitGenesisMigratesMembershipVersion(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+	// a group exported before the membership version existed
+	legacy := group.GroupMetadata{Group: 1, Admin: []byte("valid--admin-address"), Comment: "test", Version: 3, TotalWeight: sdk.OneDec()}
+	genesis := group.NewGenesisState()
+	genesis.Groups = encodeModels(t, legacy.Group.Bytes(), &legacy)
+	genesis.GroupSeq = 1
+	require.NoError(t, group.InitGenesis(ctx, k, *genesis))
+
+	g, err := k.GetGroup(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), g.Version)
+	assert.Equal(t, uint64(3), g.MembershipVersion)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	var (
+		admin  = sdk.AccAddress("valid--admin-address")
+		member = sdk.AccAddress("valid-member-address")
+	)
+	myGroup := group.GroupMetadata{Group: 1, Admin: admin, Comment: "test", Version: 1, TotalWeight: sdk.OneDec()}
+	myMember := group.GroupMember{Group: 1, Member: member, Weight: sdk.OneDec()}
+	myAccount := group.StdGroupAccountMetadata{
+		Base: group.GroupAccountMetadataBase{
+			Group:        1,
+			Admin:        admin,
+			GroupAccount: group.AccountCondition(1).Address(),
+			Version:      1,
+		},
+		DecisionPolicy: group.StdDecisionPolicy{Sum: &group.StdDecisionPolicy_Threshold{Threshold: &group.ThresholdDecisionPolicy{
+			Threshold: sdk.OneDec(),
+			Timout:    types.Duration{Seconds: 1},
+		}}},
+	}
+	otherAccount := myAccount
+	otherAccount.Base.GroupAccount = group.AccountCondition(2).Address()
+	myVote := group.Vote{Proposal: 1, Voter: member, Choice: group.Choice_YES, SubmittedAt: types.Timestamp{Seconds: 1}}
+	mySnapshot := group.ElectorateSnapshot{Proposal: 1, TotalWeight: sdk.OneDec()}
+	mySnapshotMember := group.ElectorateSnapshotMember{Proposal: 1, Member: member, Weight: sdk.OneDec()}
+	myExecution := group.ProposalExecution{Proposal: 1, ExecutorResult: group.ProposalExecutorResultFailure, FailedMsgIndex: 0, FailureReason: "testing"}
+
+	specs := map[string]struct {
+		src    group.GenesisState
+		expErr bool
+	}{
+		"default": {
+			src: *group.NewGenesisState(),
+		},
+		"all good": {
+			src: group.GenesisState{
+				Params:          group.DefaultParams(),
+				Groups:          encodeModels(t, myGroup.Group.Bytes(), &myGroup),
+				GroupSeq:        1,
+				GroupMembers:    encodeModels(t, myMember.NaturalKey(), &myMember),
+				GroupAccounts:   encodeModels(t, myAccount.NaturalKey(), &myAccount),
+				GroupAccountSeq: 1,
+				Proposals:       encodeModels(t, group.ProposalID(1).Bytes(), &testdata.MyAppProposal{}),
+				ProposalSeq:     1,
+				Votes:           encodeModels(t, myVote.NaturalKey(), &myVote),
+
+				ElectorateSnapshots:       encodeModels(t, mySnapshot.NaturalKey(), &mySnapshot),
+				ElectorateSnapshotMembers: encodeModels(t, mySnapshotMember.NaturalKey(), &mySnapshotMember),
+				ProposalExecutions:        encodeModels(t, myExecution.NaturalKey(), &myExecution),
+			},
+		},
+		"group exceeds sequence": {
+			src: group.GenesisState{
+				Params:       group.DefaultParams(),
+				Groups:       encodeModels(t, myGroup.Group.Bytes(), &myGroup),
+				GroupMembers: encodeModels(t, myMember.NaturalKey(), &myMember),
+			},
+			expErr: true,
+		},
+		"total weight does not match members": {
+			src: group.GenesisState{
+				Params:   group.DefaultParams(),
+				Groups:   encodeModels(t, myGroup.Group.Bytes(), &myGroup),
+				GroupSeq: 1,
+			},
+			expErr: true,
+		},
+		"member references unknown group": {
+			src: group.GenesisState{
+				Params:       group.DefaultParams(),
+				GroupMembers: encodeModels(t, myMember.NaturalKey(), &myMember),
+			},
+			expErr: true,
+		},
+		"group account references unknown group": {
+			src: group.GenesisState{
+				Params:          group.DefaultParams(),
+				GroupAccounts:   encodeModels(t, myAccount.NaturalKey(), &myAccount),
+				GroupAccountSeq: 1,
+			},
+			expErr: true,
+		},
+		"group account exceeds sequence": {
+			src: group.GenesisState{
+				Params:        group.DefaultParams(),
+				Groups:        encodeModels(t, myGroup.Group.Bytes(), &myGroup),
+				GroupSeq:      1,
+				GroupMembers:  encodeModels(t, myMember.NaturalKey(), &myMember),
+				GroupAccounts: encodeModels(t, myAccount.NaturalKey(), &myAccount),
+			},
+			expErr: true,
+		},
+		"group account address not derived from sequence": {
+			src: group.GenesisState{
+				Params:          group.DefaultParams(),
+				Groups:          encodeModels(t, myGroup.Group.Bytes(), &myGroup),
+				GroupSeq:        1,
+				GroupMembers:    encodeModels(t, myMember.NaturalKey(), &myMember),
+				GroupAccounts:   encodeModels(t, otherAccount.NaturalKey(), &otherAccount),
+				GroupAccountSeq: 1,
+			},
+			expErr: true,
+		},
+		"proposal exceeds sequence": {
+			src: group.GenesisState{
+				Params:    group.DefaultParams(),
+				Proposals: encodeModels(t, group.ProposalID(1).Bytes(), &testdata.MyAppProposal{}),
+			},
+			expErr: true,
+		},
+		"vote references unknown proposal": {
+			src: group.GenesisState{
+				Params: group.DefaultParams(),
+				Votes:  encodeModels(t, myVote.NaturalKey(), &myVote),
+			},
+			expErr: true,
+		},
+		"electorate snapshot references unknown proposal": {
+			src: group.GenesisState{
+				Params:                    group.DefaultParams(),
+				ElectorateSnapshots:       encodeModels(t, mySnapshot.NaturalKey(), &mySnapshot),
+				ElectorateSnapshotMembers: encodeModels(t, mySnapshotMember.NaturalKey(), &mySnapshotMember),
+			},
+			expErr: true,
+		},
+		"electorate snapshot total weight does not match members": {
+			src: group.GenesisState{
+				Params:              group.DefaultParams(),
+				Proposals:           encodeModels(t, group.ProposalID(1).Bytes(), &testdata.MyAppProposal{}),
+				ProposalSeq:         1,
+				ElectorateSnapshots: encodeModels(t, mySnapshot.NaturalKey(), &mySnapshot),
+			},
+			expErr: true,
+		},
+		"electorate snapshot member references unknown snapshot": {
+			src: group.GenesisState{
+				Params:                    group.DefaultParams(),
+				Proposals:                 encodeModels(t, group.ProposalID(1).Bytes(), &testdata.MyAppProposal{}),
+				ProposalSeq:               1,
+				ElectorateSnapshotMembers: encodeModels(t, mySnapshotMember.NaturalKey(), &mySnapshotMember),
+			},
+			expErr: true,
+		},
+		"proposal execution references unknown proposal": {
+			src: group.GenesisState{
+				Params:             group.DefaultParams(),
+				ProposalExecutions: encodeModels(t, myExecution.NaturalKey(), &myExecution),
+			},
+			expErr: true,
+		},
+		"proposal execution without failure reason": {
+			src: group.GenesisState{
+				Params:      group.DefaultParams(),
+				Proposals:   encodeModels(t, group.ProposalID(1).Bytes(), &testdata.MyAppProposal{}),
+				ProposalSeq: 1,
+				ProposalExecutions: encodeModels(t, myExecution.NaturalKey(), &group.ProposalExecution{
+					Proposal:       1,
+					ExecutorResult: group.ProposalExecutorResultFailure,
+				}),
+			},
+			expErr: true,
+		},
+		"invalid json": {
+			src: group.GenesisState{
+				Params: group.DefaultParams(),
+				Groups: json.RawMessage(`{}`),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// createTestKeeper returns a keeper with the default params and a context with the given block time. The store key
+// gives access to the raw group store.
+func createTestKeeper(blockTime time.Time) (group.Keeper, sdk.Context, sdk.StoreKey) {
+	amino := codec.New()
+	pKey, pTKey := sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)
+	paramSpace := subspace.NewSubspace(amino, pKey, pTKey, group.DefaultParamspace)
+
+	groupKey := sdk.NewKVStoreKey(group.StoreKeyName)
+	k := group.NewGroupKeeper(groupKey, paramSpace, baseapp.NewRouter(), &testdata.MyAppProposal{})
+	ctx := group.NewContext(pKey, pTKey, groupKey).WithBlockTime(blockTime)
+	defaultParams := group.DefaultParams()
+	paramSpace.SetParamSet(ctx, &defaultParams)
+	return k, ctx, groupKey
+}
+
+func encodeModels(t *testing.T, key []byte, obj proto.Message) json.RawMessage {
+	var buf bytes.Buffer
+	require.NoError(t, (&jsonpb.Marshaler{}).Marshal(&buf, obj))
+	bz, err := json.Marshal([]orm.Model{{Key: key, Value: buf.Bytes()}})
+	require.NoError(t, err)
+	return bz
+}
+
+func assertJSONEqualGenesis(t *testing.T, exp, got *group.GenesisState) {
+	var expBuf, gotBuf bytes.Buffer
+	require.NoError(t, (&jsonpb.Marshaler{}).Marshal(&expBuf, exp))
+	require.NoError(t, (&jsonpb.Marshaler{}).Marshal(&gotBuf, got))
+	assert.JSONEq(t, expBuf.String(), gotBuf.String())
+}
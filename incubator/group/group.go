@@ -4,16 +4,20 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/modules/incubator/orm"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -37,13 +41,20 @@ func AccountCondition(id uint64) Condition {
 	return NewCondition("group", "account", orm.EncodeSequence(id))
 }
 
+var _ module.AppModuleSimulation = AppModule{}
+
 type AppModule struct {
-	keeper Keeper
+	keeper        Keeper
+	accountKeeper AccountKeeper
+	bankKeeper    BankKeeper
 }
 
-func NewAppModule(keeper Keeper) AppModule {
+// NewAppModule creates a new AppModule. The account and bank keeper are only used by the simulation.
+func NewAppModule(keeper Keeper, accountKeeper AccountKeeper, bankKeeper BankKeeper) AppModule {
 	return AppModule{
-		keeper: keeper,
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
 
@@ -74,19 +85,15 @@ func (a AppModule) ValidateGenesis(bz json.RawMessage) error {
 }
 
 func (a AppModule) RegisterRESTRoutes(ctx context.CLIContext, r *mux.Router) {
-	//rest.RegisterRoutes(ctx, r, ModuleCdc, RouterKey)
-	// todo: what client functions do we want to support?
-	panic("implement me")
+	RegisterRESTRoutes(ctx, r)
 }
 
-func (a AppModule) GetTxCmd(*codec.Codec) *cobra.Command {
-	//return cli.GetTxCmd(StoreKey, cdc)
-	panic("implement me")
+func (a AppModule) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return GetTxCmd(cdc)
 }
 
-func (a AppModule) GetQueryCmd(*codec.Codec) *cobra.Command {
-	//return cli.GetQueryCmd(StoreKey, cdc)
-	panic("implement me")
+func (a AppModule) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return GetQueryCmd(cdc)
 }
 
 func (a AppModule) InitGenesis(ctx sdk.Context, bz json.RawMessage) []abci.ValidatorUpdate {
@@ -98,23 +105,28 @@ func (a AppModule) InitGenesis(ctx sdk.Context, bz json.RawMessage) []abci.Valid
 	if err := data.Validate(); err != nil {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
 	}
-	a.keeper.setParams(ctx, data.Params)
+	if err := InitGenesis(ctx, a.keeper, data); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
 	return []abci.ValidatorUpdate{}
 
 }
 
 func (a AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	genesisState, err := ExportGenesis(ctx, a.keeper)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
 	var buf bytes.Buffer
 	marshaller := jsonpb.Marshaler{}
-	if err := marshaller.Marshal(&buf, ExportGenesis(ctx, a.keeper)); err != nil {
+	if err := marshaller.Marshal(&buf, genesisState); err != nil {
 		panic(errors.Wrap(err, "export genesis"))
 	}
 	return buf.Bytes()
 }
 
-func (a AppModule) RegisterInvariants(sdk.InvariantRegistry) {
-	// todo: anything to check?
-	// todo: check that tally sums must never have less than block before ?
+func (a AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, a.keeper)
 }
 
 func (a AppModule) Route() string {
@@ -125,6 +137,13 @@ func (a AppModule) NewHandler() sdk.Handler {
 	return NewHandler(a.keeper)
 }
 
+// RegisterServices registers the Query service. The sdk.Context must be wrapped into the request context with
+// WrapSDKContext, for example by a server interceptor. The Msg service is not registered as msgs must only be
+// executed within transactions where the signatures are verified. They are routed with NewHandler.
+func (a AppModule) RegisterServices(s *grpc.Server) {
+	RegisterQueryServer(s, a.keeper)
+}
+
 func (a AppModule) QuerierRoute() string {
 	return QuerierRoute
 }
@@ -133,8 +152,36 @@ func (a AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(a.keeper)
 }
 
-func (a AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
+func (a AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, a.keeper)
+}
 
-func (a AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (a AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, a.keeper)
 	return nil
 }
+
+// GenerateGenesisState creates a randomized GenState of the group module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	RandomizedGenState(simState)
+}
+
+// ProposalContents returns nil as the group module has no governance proposal content.
+func (AppModule) ProposalContents(_ module.SimulationState) []simulation.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized group param changes for the simulator.
+func (AppModule) RandomizedParams(r *rand.Rand) []simulation.ParamChange {
+	return RandomizedParams(r)
+}
+
+// RegisterStoreDecoder registers a decoder for group module's types.
+func (a AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[StoreKeyName] = NewStoreDecoder(a.keeper)
+}
+
+// WeightedOperations returns all the group module operations with their respective weights.
+func (a AppModule) WeightedOperations(simState module.SimulationState) []simulation.WeightedOperation {
+	return WeightedOperations(simState.AppParams, simState.Cdc, a.accountKeeper, a.bankKeeper, a.keeper)
+}
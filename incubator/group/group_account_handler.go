@@ -0,0 +1,110 @@
+package group
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+func (s msgServer) CreateGroupAccount(goCtx context.Context, msg *MsgCreateGroupAccountStd) (*MsgCreateGroupAccountResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	acc, err := createGroupAccount(UnwrapSDKContext(goCtx), s.Keeper, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgCreateGroupAccountResponse{GroupAccount: acc}, nil
+}
+
+// createGroupAccount creates a group account for the standard or an app specific create msg.
+func createGroupAccount(ctx sdk.Context, k Keeper, msg MsgCreateGroupAccountI) (sdk.AccAddress, error) {
+	decisionPolicy := msg.GetDecisionPolicy()
+	acc, err := k.CreateGroupAccount(ctx, msg.GetBase().Admin, msg.GetBase().Group, decisionPolicy.GetDecisionPolicy(), msg.GetBase().Comment,
+		WithAutoExec(msg.GetBase().AutoExec), WithElectorateSnapshot(msg.GetBase().SnapshotElectorate),
+		WithMsgTypes(msg.GetBase().AllowedMsgTypes, msg.GetBase().DeniedMsgTypes))
+	if err != nil {
+		return nil, errors.Wrap(err, "create group account")
+	}
+	emitMessageEvent(ctx, msg.GetBase().Admin)
+	return acc, nil
+}
+
+func (s msgServer) UpdateGroupAccountAdmin(goCtx context.Context, msg *MsgUpdateGroupAccountAdmin) (*MsgUpdateGroupAccountAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx, k := UnwrapSDKContext(goCtx), s.Keeper
+	action := func(m *StdGroupAccountMetadata) error {
+		m.Base.Admin = msg.NewAdmin
+		return k.UpdateGroupAccount(ctx, m)
+	}
+	if err := doAuthenticatedAccount(k, ctx, msg, action, EventTypeUpdateGroupAccountAdmin, "admin updated"); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateGroupAccountAdminResponse{}, nil
+}
+
+func (s msgServer) UpdateGroupAccountDecisionPolicy(goCtx context.Context, msg *MsgUpdateGroupAccountDecisionPolicyStd) (*MsgUpdateGroupAccountDecisionPolicyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := updateGroupAccountDecisionPolicy(UnwrapSDKContext(goCtx), s.Keeper, msg); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateGroupAccountDecisionPolicyResponse{}, nil
+}
+
+// updateGroupAccountDecisionPolicy sets the decision policy of the standard or an app specific update msg.
+func updateGroupAccountDecisionPolicy(ctx sdk.Context, k Keeper, msg MsgUpdateGroupAccountDecisionPolicyI) error {
+	action := func(m *StdGroupAccountMetadata) error {
+		decisionPolicy := msg.GetDecisionPolicy()
+		if err := k.assertMaxVotingWindow(ctx, decisionPolicy.GetDecisionPolicy()); err != nil {
+			return err
+		}
+		m.DecisionPolicy = decisionPolicy
+		return k.UpdateGroupAccount(ctx, m)
+	}
+	base := msg.GetBase()
+	return doAuthenticatedAccount(k, ctx, &base, action, EventTypeUpdateGroupAccountDecisionPolicy, "decision policy updated")
+}
+
+func (s msgServer) UpdateGroupAccountComment(goCtx context.Context, msg *MsgUpdateGroupAccountComment) (*MsgUpdateGroupAccountCommentResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx, k := UnwrapSDKContext(goCtx), s.Keeper
+	action := func(m *StdGroupAccountMetadata) error {
+		if len(msg.Comment) > k.MaxCommentSize(ctx) {
+			return errors.Wrap(ErrMaxLimit, "group account comment")
+		}
+		m.Base.Comment = msg.Comment
+		return k.UpdateGroupAccount(ctx, m)
+	}
+	if err := doAuthenticatedAccount(k, ctx, msg, action, EventTypeUpdateGroupAccountComment, "comment updated"); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateGroupAccountCommentResponse{}, nil
+}
+
+type authNGroupAccountMsg interface {
+	GetGroupAccount() sdk.AccAddress
+	GetAdmin() sdk.AccAddress // equal GetSigners()
+}
+
+func doAuthenticatedAccount(k Keeper, ctx sdk.Context, msg authNGroupAccountMsg, action func(*StdGroupAccountMetadata) error, eventType, note string) error {
+	groupAccount, err := k.GetGroupAccount(ctx, msg.GetGroupAccount())
+	if err != nil {
+		return err
+	}
+	if !groupAccount.Base.Admin.Equals(msg.GetAdmin()) {
+		return errors.Wrap(ErrUnauthorized, "not group account admin")
+	}
+	if err := action(&groupAccount); err != nil {
+		return errors.Wrap(err, note)
+	}
+	ctx.EventManager().EmitEvent(newGroupAccountEvent(eventType, groupAccount.Base))
+	emitMessageEvent(ctx, msg.GetAdmin())
+	return nil
+}
@@ -0,0 +1,218 @@
+package group
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateGroupAccountAdmin(t *testing.T) {
+	k, pCtx := createGroupKeeper()
+	oldAdmin, accountAddr, expStored := createTestGroupAccount(t, k, pCtx)
+
+	specs := map[string]struct {
+		src       MsgUpdateGroupAccountAdmin
+		expErr    *errors.Error
+		expStored func(StdGroupAccountMetadata) StdGroupAccountMetadata
+	}{
+		"with correct admin": {
+			src: MsgUpdateGroupAccountAdmin{
+				GroupAccount: accountAddr,
+				Admin:        oldAdmin,
+				NewAdmin:     []byte("my-new-admin-address"),
+			},
+			expStored: func(m StdGroupAccountMetadata) StdGroupAccountMetadata {
+				m.Base.Admin = []byte("my-new-admin-address")
+				m.Base.Version = 2
+				return m
+			},
+		},
+		"with wrong admin": {
+			src: MsgUpdateGroupAccountAdmin{
+				GroupAccount: accountAddr,
+				Admin:        []byte("other--admin-address"),
+				NewAdmin:     []byte("my-new-admin-address"),
+			},
+			expErr: ErrUnauthorized,
+		},
+		"with unknown group account": {
+			src: MsgUpdateGroupAccountAdmin{
+				GroupAccount: []byte("unknown-account-addr"),
+				Admin:        oldAdmin,
+				NewAdmin:     []byte("my-new-admin-address"),
+			},
+			expErr: orm.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			_, err := NewHandler(k)(ctx, spec.src)
+			require.True(t, spec.expErr.Is(err), err)
+			// then
+			loaded, err := k.GetGroupAccount(ctx, accountAddr)
+			require.NoError(t, err)
+			exp := expStored
+			if spec.expStored != nil {
+				exp = spec.expStored(expStored)
+			}
+			assert.Equal(t, exp, loaded)
+		})
+	}
+}
+
+func TestMsgUpdateGroupAccountDecisionPolicy(t *testing.T) {
+	k, pCtx := createGroupKeeper()
+	oldAdmin, accountAddr, expStored := createTestGroupAccount(t, k, pCtx)
+
+	newPolicy := StdDecisionPolicy{Sum: &StdDecisionPolicy_Threshold{&ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(2),
+		Timout:    types.Duration{Seconds: 2},
+	}}}
+	specs := map[string]struct {
+		src       MsgUpdateGroupAccountDecisionPolicyStd
+		expErr    *errors.Error
+		expStored func(StdGroupAccountMetadata) StdGroupAccountMetadata
+	}{
+		"with correct admin": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base:           MsgUpdateGroupAccountBase{GroupAccount: accountAddr, Admin: oldAdmin},
+				DecisionPolicy: newPolicy,
+			},
+			expStored: func(m StdGroupAccountMetadata) StdGroupAccountMetadata {
+				m.DecisionPolicy = newPolicy
+				m.Base.Version = 2
+				return m
+			},
+		},
+		"with timeout exceeding max voting window": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base: MsgUpdateGroupAccountBase{GroupAccount: accountAddr, Admin: oldAdmin},
+				DecisionPolicy: StdDecisionPolicy{Sum: &StdDecisionPolicy_Threshold{&ThresholdDecisionPolicy{
+					Threshold: sdk.NewDec(2),
+					Timout:    *types.DurationProto(k.MaxVotingWindow(pCtx) + time.Nanosecond),
+				}}},
+			},
+			expErr: ErrMaxLimit,
+		},
+		"with wrong admin": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base:           MsgUpdateGroupAccountBase{GroupAccount: accountAddr, Admin: []byte("other--admin-address")},
+				DecisionPolicy: newPolicy,
+			},
+			expErr: ErrUnauthorized,
+		},
+		"with unknown group account": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base:           MsgUpdateGroupAccountBase{GroupAccount: []byte("unknown-account-addr"), Admin: oldAdmin},
+				DecisionPolicy: newPolicy,
+			},
+			expErr: orm.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			_, err := NewHandler(k)(ctx, spec.src)
+			require.True(t, spec.expErr.Is(err), err)
+			// then
+			loaded, err := k.GetGroupAccount(ctx, accountAddr)
+			require.NoError(t, err)
+			exp := expStored
+			if spec.expStored != nil {
+				exp = spec.expStored(expStored)
+			}
+			assert.Equal(t, exp, loaded)
+		})
+	}
+}
+
+func TestMsgUpdateGroupAccountComment(t *testing.T) {
+	k, pCtx := createGroupKeeper()
+	oldAdmin, accountAddr, expStored := createTestGroupAccount(t, k, pCtx)
+
+	specs := map[string]struct {
+		src       MsgUpdateGroupAccountComment
+		expErr    *errors.Error
+		expStored func(StdGroupAccountMetadata) StdGroupAccountMetadata
+	}{
+		"with correct admin": {
+			src: MsgUpdateGroupAccountComment{
+				GroupAccount: accountAddr,
+				Admin:        oldAdmin,
+				Comment:      "new comment",
+			},
+			expStored: func(m StdGroupAccountMetadata) StdGroupAccountMetadata {
+				m.Base.Comment = "new comment"
+				m.Base.Version = 2
+				return m
+			},
+		},
+		"with comment too long": {
+			src: MsgUpdateGroupAccountComment{
+				GroupAccount: accountAddr,
+				Admin:        oldAdmin,
+				Comment:      strings.Repeat("a", 256),
+			},
+			expErr: ErrMaxLimit,
+		},
+		"with wrong admin": {
+			src: MsgUpdateGroupAccountComment{
+				GroupAccount: accountAddr,
+				Admin:        []byte("other--admin-address"),
+				Comment:      "new comment",
+			},
+			expErr: ErrUnauthorized,
+		},
+		"with unknown group account": {
+			src: MsgUpdateGroupAccountComment{
+				GroupAccount: []byte("unknown-account-addr"),
+				Admin:        oldAdmin,
+				Comment:      "new comment",
+			},
+			expErr: orm.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			_, err := NewHandler(k)(ctx, spec.src)
+			require.True(t, spec.expErr.Is(err), err)
+			// then
+			loaded, err := k.GetGroupAccount(ctx, accountAddr)
+			require.NoError(t, err)
+			exp := expStored
+			if spec.expStored != nil {
+				exp = spec.expStored(expStored)
+			}
+			assert.Equal(t, exp, loaded)
+		})
+	}
+}
+
+func createTestGroupAccount(t *testing.T, k Keeper, ctx sdk.Context) (sdk.AccAddress, sdk.AccAddress, StdGroupAccountMetadata) {
+	members := []Member{{
+		Address: sdk.AccAddress([]byte("valid-member-address")),
+		Power:   sdk.NewDec(1),
+		Comment: "first member",
+	}}
+	admin := sdk.AccAddress([]byte("my-old-admin-address"))
+	groupID, err := k.CreateGroup(ctx, admin, members, "test")
+	require.NoError(t, err)
+	policy := ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(ctx, admin, groupID, &policy, "test")
+	require.NoError(t, err)
+	stored, err := k.GetGroupAccount(ctx, accountAddr)
+	require.NoError(t, err)
+	return admin, accountAddr, stored
+}
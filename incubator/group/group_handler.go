@@ -1,38 +1,61 @@
 package group
 
 import (
-	"fmt"
+	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/modules/incubator/orm"
 	"github.com/pkg/errors"
 )
 
-func handleMsgCreateGroup(ctx sdk.Context, k Keeper, msg MsgCreateGroup) (*sdk.Result, error) {
+func (s msgServer) CreateGroup(goCtx context.Context, msg *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx, k := UnwrapSDKContext(goCtx), s.Keeper
 	id, err := k.CreateGroup(ctx, msg.Admin, msg.Members, msg.Comment)
 	if err != nil {
 		return nil, errors.Wrap(err, "create group")
 	}
-	return buildGroupResult(ctx, msg.Admin, id, "created")
+	emitMessageEvent(ctx, msg.Admin)
+	return &MsgCreateGroupResponse{Group: id}, nil
 }
 
-func handleMsgUpdateGroupAdmin(ctx sdk.Context, k Keeper, msg MsgUpdateGroupAdmin) (*sdk.Result, error) {
+func (s msgServer) UpdateGroupAdmin(goCtx context.Context, msg *MsgUpdateGroupAdmin) (*MsgUpdateGroupAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx, k := UnwrapSDKContext(goCtx), s.Keeper
 	action := func(m *GroupMetadata) error {
 		m.Admin = msg.NewAdmin
 		return k.UpdateGroup(ctx, m)
 	}
-	return doAuthenticated(k, ctx, &msg, action, "admin updated")
+	if err := doAuthenticated(k, ctx, msg, action, EventTypeUpdateGroupAdmin, "admin updated"); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateGroupAdminResponse{}, nil
 }
 
-func handleMsgUpdateGroupComment(ctx sdk.Context, k Keeper, msg MsgUpdateGroupComment) (*sdk.Result, error) {
+func (s msgServer) UpdateGroupComment(goCtx context.Context, msg *MsgUpdateGroupComment) (*MsgUpdateGroupCommentResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx, k := UnwrapSDKContext(goCtx), s.Keeper
 	action := func(m *GroupMetadata) error {
 		m.Comment = msg.Comment
 		return k.UpdateGroup(ctx, m)
 	}
-	return doAuthenticated(k, ctx, &msg, action, "comment updated")
+	if err := doAuthenticated(k, ctx, msg, action, EventTypeUpdateGroupComment, "comment updated"); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateGroupCommentResponse{}, nil
 }
 
-func handleMsgUpdateGroupMembers(ctx sdk.Context, k Keeper, msg MsgUpdateGroupMembers) (*sdk.Result, error) {
+func (s msgServer) UpdateGroupMembers(goCtx context.Context, msg *MsgUpdateGroupMembers) (*MsgUpdateGroupMembersResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx, k := UnwrapSDKContext(goCtx), s.Keeper
 	action := func(m *GroupMetadata) error {
 
 		for i := range msg.MemberUpdates {
@@ -42,8 +65,8 @@ func handleMsgUpdateGroupMembers(ctx sdk.Context, k Keeper, msg MsgUpdateGroupMe
 				Comment: msg.MemberUpdates[i].Comment,
 			}
 			var found bool
-			var previousMemberStatus GroupMember
-			switch err := k.groupMemberTable.GetOne(ctx, member.NaturalKey(), &previousMemberStatus); {
+			previousMemberStatus, err := k.groupMemberTable.Get(ctx, member.NaturalKey())
+			switch {
 			case err == nil:
 				found = true
 			case orm.ErrNotFound.Is(err):
@@ -70,15 +93,21 @@ func handleMsgUpdateGroupMembers(ctx sdk.Context, k Keeper, msg MsgUpdateGroupMe
 					return errors.Wrap(err, "add member")
 				}
 			} else {
+				if err := k.assertNoMembershipCycle(ctx, msg.Group, member.Member); err != nil {
+					return err
+				}
 				if err := k.groupMemberTable.Create(ctx, &member); err != nil {
 					return errors.Wrap(err, "add member")
 				}
 			}
 			m.TotalWeight = m.TotalWeight.Add(member.Weight)
 		}
-		return k.UpdateGroup(ctx, m)
+		return k.UpdateGroupMembership(ctx, m)
 	}
-	return doAuthenticated(k, ctx, &msg, action, "members updated")
+	if err := doAuthenticated(k, ctx, msg, action, EventTypeUpdateGroupMembers, "members updated"); err != nil {
+		return nil, err
+	}
+	return &MsgUpdateGroupMembersResponse{}, nil
 }
 
 type authNGroupMsg interface {
@@ -86,31 +115,18 @@ type authNGroupMsg interface {
 	GetAdmin() sdk.AccAddress // equal GetSigners()
 }
 
-func doAuthenticated(k Keeper, ctx sdk.Context, msg authNGroupMsg, action func(*GroupMetadata) error, note string) (*sdk.Result, error) {
+func doAuthenticated(k Keeper, ctx sdk.Context, msg authNGroupMsg, action func(*GroupMetadata) error, eventType, note string) error {
 	group, err := k.GetGroup(ctx, msg.GetGroup())
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if !group.Admin.Equals(msg.GetAdmin()) {
-		return nil, errors.Wrap(ErrUnauthorized, "not group admin")
+		return errors.Wrap(ErrUnauthorized, "not group admin")
 	}
 	if err := action(&group); err != nil {
-		return nil, errors.Wrap(err, note)
+		return errors.Wrap(err, note)
 	}
-	return buildGroupResult(ctx, msg.GetAdmin(), msg.GetGroup(), note)
-}
-
-func buildGroupResult(ctx sdk.Context, admin sdk.AccAddress, group GroupID, note string) (*sdk.Result, error) {
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, admin.String()),
-		),
-	)
-	return &sdk.Result{
-		Data:   group.Bytes(),
-		Log:    fmt.Sprintf("Group %d %s", group, note),
-		Events: ctx.EventManager().Events(),
-	}, nil
+	ctx.EventManager().EmitEvent(newGroupEvent(eventType, group))
+	emitMessageEvent(ctx, msg.GetAdmin())
+	return nil
 }
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,11 +31,12 @@ func TestMsgCreateGroup(t *testing.T) {
 				}},
 			},
 			expGroup: GroupMetadata{
-				Group:       1,
-				Admin:       myAdmin,
-				Comment:     "test",
-				Version:     1,
-				TotalWeight: sdk.OneDec(),
+				Group:             1,
+				Admin:             myAdmin,
+				Comment:           "test",
+				Version:           1,
+				MembershipVersion: 1,
+				TotalWeight:       sdk.OneDec(),
 			},
 			expMembers: []GroupMember{
 				{
@@ -65,7 +67,7 @@ func TestMsgCreateGroup(t *testing.T) {
 			assert.Equal(t, spec.expGroup, loaded)
 
 			// and members persisted
-			it, err := k.groupMemberByGroupIndex.Get(ctx, groupID)
+			it, err := k.groupMemberTable.GetByGroup(ctx, GroupID(groupID))
 			require.NoError(t, err)
 			var loadedMembers []GroupMember
 			_, err = orm.ReadAll(it, &loadedMembers)
@@ -99,26 +101,28 @@ func TestMsgUpdateGroupAdmin(t *testing.T) {
 				NewAdmin: []byte("my-new-admin-address"),
 			},
 			expStored: GroupMetadata{
-				Group:       groupID,
-				Admin:       []byte("my-new-admin-address"),
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     2,
+				Group:             groupID,
+				Admin:             []byte("my-new-admin-address"),
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           2,
+				MembershipVersion: 1,
 			},
 		},
 		"with wrong admin": {
 			src: MsgUpdateGroupAdmin{
 				Group:    groupID,
-				Admin:    []byte("unknown-address"),
+				Admin:    []byte("other--admin-address"),
 				NewAdmin: []byte("my-new-admin-address"),
 			},
 			expErr: ErrUnauthorized,
 			expStored: GroupMetadata{
-				Group:       groupID,
-				Admin:       oldAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     1,
+				Group:             groupID,
+				Admin:             oldAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 1,
 			},
 		},
 		"with unknown groupID": {
@@ -129,11 +133,12 @@ func TestMsgUpdateGroupAdmin(t *testing.T) {
 			},
 			expErr: orm.ErrNotFound,
 			expStored: GroupMetadata{
-				Group:       groupID,
-				Admin:       oldAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     1,
+				Group:             groupID,
+				Admin:             oldAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 1,
 			},
 		},
 	}
@@ -176,41 +181,44 @@ func TestMsgUpdateGroupComment(t *testing.T) {
 				Comment: "new comment",
 			},
 			expStored: GroupMetadata{
-				Group:       groupID,
-				Admin:       oldAdmin,
-				Comment:     "new comment",
-				TotalWeight: sdk.NewDec(1),
-				Version:     2,
+				Group:             groupID,
+				Admin:             oldAdmin,
+				Comment:           "new comment",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           2,
+				MembershipVersion: 1,
 			},
 		},
 		"with wrong admin": {
 			src: MsgUpdateGroupComment{
 				Group:   groupID,
-				Admin:   []byte("unknown-address"),
+				Admin:   []byte("other--admin-address"),
 				Comment: "new comment",
 			},
 			expErr: ErrUnauthorized,
 			expStored: GroupMetadata{
-				Group:       groupID,
-				Admin:       oldAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     1,
+				Group:             groupID,
+				Admin:             oldAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 1,
 			},
 		},
 		"with unknown groupid": {
 			src: MsgUpdateGroupComment{
 				Group:   999,
-				Admin:   []byte("unknown-address"),
+				Admin:   []byte("other--admin-address"),
 				Comment: "new comment",
 			},
 			expErr: orm.ErrNotFound,
 			expStored: GroupMetadata{
-				Group:       groupID,
-				Admin:       oldAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     1,
+				Group:             groupID,
+				Admin:             oldAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 1,
 			},
 		},
 	}
@@ -257,11 +265,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 				}},
 			},
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(3),
-				Version:     2,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(3),
+				Version:           1,
+				MembershipVersion: 2,
 			},
 			expMembers: []GroupMember{
 				{
@@ -289,11 +298,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 				}},
 			},
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(2),
-				Version:     2,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(2),
+				Version:           1,
+				MembershipVersion: 2,
 			},
 			expMembers: []GroupMember{
 				{
@@ -315,11 +325,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 				}},
 			},
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     2,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 2,
 			},
 			expMembers: []GroupMember{
 				{
@@ -346,11 +357,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 					}},
 			},
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     2,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 2,
 			},
 			expMembers: []GroupMember{{
 				Member:  sdk.AccAddress([]byte("my-new-member-addres")),
@@ -370,11 +382,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 				}},
 			},
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(0),
-				Version:     2,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(0),
+				Version:           1,
+				MembershipVersion: 2,
 			},
 			expMembers: []GroupMember{},
 		},
@@ -390,11 +403,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 			},
 			expErr: orm.ErrNotFound,
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     1,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 1,
 			},
 			expMembers: []GroupMember{{
 				Member:  sdk.AccAddress([]byte("valid-member-address")),
@@ -406,7 +420,7 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 		"with wrong admin": {
 			src: MsgUpdateGroupMembers{
 				Group: groupID,
-				Admin: []byte("unknown-address"),
+				Admin: []byte("other--admin-address"),
 				MemberUpdates: []Member{{
 					Address: sdk.AccAddress([]byte("other-member-address")),
 					Power:   sdk.NewDec(2),
@@ -415,11 +429,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 			},
 			expErr: ErrUnauthorized,
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     1,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 1,
 			},
 			expMembers: []GroupMember{{
 				Member:  sdk.AccAddress([]byte("valid-member-address")),
@@ -440,11 +455,12 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 			},
 			expErr: orm.ErrNotFound,
 			expGroup: GroupMetadata{
-				Group:       groupID,
-				Admin:       myAdmin,
-				Comment:     "test",
-				TotalWeight: sdk.NewDec(1),
-				Version:     1,
+				Group:             groupID,
+				Admin:             myAdmin,
+				Comment:           "test",
+				TotalWeight:       sdk.NewDec(1),
+				Version:           1,
+				MembershipVersion: 1,
 			},
 			expMembers: []GroupMember{{
 				Member:  sdk.AccAddress([]byte("valid-member-address")),
@@ -465,7 +481,7 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 			assert.Equal(t, spec.expGroup, loaded)
 
 			// and members persisted
-			it, err := k.groupMemberByGroupIndex.Get(ctx, uint64(groupID))
+			it, err := k.groupMemberTable.GetByGroup(ctx, groupID)
 			require.NoError(t, err)
 			var loadedMembers []GroupMember
 			_, err = orm.ReadAll(it, &loadedMembers)
@@ -474,3 +490,209 @@ func TestMsgUpdateGroupMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateGroupMembersNestedGroups(t *testing.T) {
+	k, pCtx := createGroupKeeper()
+
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+	parentGroupID, err := k.CreateGroup(pCtx, myAdmin, nil, "parent")
+	require.NoError(t, err)
+	childGroupID, err := k.CreateGroup(pCtx, myAdmin, nil, "child")
+	require.NoError(t, err)
+	grandChildGroupID, err := k.CreateGroup(pCtx, myAdmin, nil, "grand child")
+	require.NoError(t, err)
+
+	policy := ThresholdDecisionPolicy{Threshold: sdk.OneDec(), Timout: types.Duration{Seconds: 1}}
+	parentAccount, err := k.CreateGroupAccount(pCtx, myAdmin, parentGroupID, &policy, "parent account")
+	require.NoError(t, err)
+	childAccount, err := k.CreateGroupAccount(pCtx, myAdmin, childGroupID, &policy, "child account")
+	require.NoError(t, err)
+	grandChildAccount, err := k.CreateGroupAccount(pCtx, myAdmin, grandChildGroupID, &policy, "grand child account")
+	require.NoError(t, err)
+
+	// parent <- child <- grand child
+	addMember := func(ctx sdk.Context, group GroupID, member sdk.AccAddress) error {
+		_, err := NewHandler(k)(ctx, MsgUpdateGroupMembers{
+			Group:         group,
+			Admin:         myAdmin,
+			MemberUpdates: []Member{{Address: member, Power: sdk.OneDec()}},
+		})
+		return err
+	}
+	require.NoError(t, addMember(pCtx, parentGroupID, childAccount))
+	require.NoError(t, addMember(pCtx, childGroupID, grandChildAccount))
+
+	specs := map[string]struct {
+		group  GroupID
+		member sdk.AccAddress
+		expErr *errors.Error
+	}{
+		"own group account": {
+			group:  parentGroupID,
+			member: parentAccount,
+			expErr: ErrInvalid,
+		},
+		"direct cycle": {
+			group:  childGroupID,
+			member: parentAccount,
+			expErr: ErrInvalid,
+		},
+		"indirect cycle": {
+			group:  grandChildGroupID,
+			member: parentAccount,
+			expErr: ErrInvalid,
+		},
+		"nested group account of a descendant": {
+			group:  parentGroupID,
+			member: grandChildAccount,
+		},
+		"plain address": {
+			group:  grandChildGroupID,
+			member: sdk.AccAddress([]byte("valid-member-address")),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			err := addMember(ctx, spec.group, spec.member)
+			require.True(t, spec.expErr.Is(err), err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.True(t, k.groupMemberTable.Has(ctx, GroupMember{Group: spec.group, Member: spec.member}.NaturalKey()))
+		})
+	}
+}
+
+func TestCreateGroupAccountNestedGroups(t *testing.T) {
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+	policy := ThresholdDecisionPolicy{Threshold: sdk.OneDec(), Timout: types.Duration{Seconds: 1}}
+	member := func(addr sdk.AccAddress) Members {
+		return Members{{Address: addr, Power: sdk.OneDec()}}
+	}
+
+	specs := map[string]struct {
+		doSetup func(t *testing.T, ctx sdk.Context, k Keeper) GroupID
+		expErr  *errors.Error
+	}{
+		"own account as initial member": {
+			doSetup: func(t *testing.T, ctx sdk.Context, k Keeper) GroupID {
+				groupID, err := k.CreateGroup(ctx, myAdmin, member(AccountCondition(1).Address()), "test")
+				require.NoError(t, err)
+				return groupID
+			},
+			expErr: ErrInvalid,
+		},
+		"indirect cycle": {
+			doSetup: func(t *testing.T, ctx sdk.Context, k Keeper) GroupID {
+				// child has the future parent account as member: parent <- child <- parent account
+				childGroupID, err := k.CreateGroup(ctx, myAdmin, member(AccountCondition(2).Address()), "child")
+				require.NoError(t, err)
+				childAccount, err := k.CreateGroupAccount(ctx, myAdmin, childGroupID, &policy, "child account")
+				require.NoError(t, err)
+				parentGroupID, err := k.CreateGroup(ctx, myAdmin, member(childAccount), "parent")
+				require.NoError(t, err)
+				return parentGroupID
+			},
+			expErr: ErrInvalid,
+		},
+		"account of another group as member": {
+			doSetup: func(t *testing.T, ctx sdk.Context, k Keeper) GroupID {
+				_, err := k.CreateGroup(ctx, myAdmin, member(AccountCondition(1).Address()), "parent")
+				require.NoError(t, err)
+				childGroupID, err := k.CreateGroup(ctx, myAdmin, member([]byte("valid-member-address")), "child")
+				require.NoError(t, err)
+				return childGroupID
+			},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			k, ctx := createGroupKeeper()
+			groupID := spec.doSetup(t, ctx, k)
+			addr, err := k.CreateGroupAccount(ctx, myAdmin, groupID, &policy, "test")
+			require.True(t, spec.expErr.Is(err), err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.True(t, k.HasGroupAccount(ctx, addr))
+		})
+	}
+}
+
+func TestCreateGroupNestedGroups(t *testing.T) {
+	k, ctx := createGroupKeeper()
+
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+	policy := ThresholdDecisionPolicy{Threshold: sdk.OneDec(), Timout: types.Duration{Seconds: 1}}
+	childGroupID, err := k.CreateGroup(ctx, myAdmin, nil, "child")
+	require.NoError(t, err)
+	childAccount, err := k.CreateGroupAccount(ctx, myAdmin, childGroupID, &policy, "child account")
+	require.NoError(t, err)
+
+	// an account of the next group id, for example from an imported state
+	futureAccount := AccountCondition(100).Address()
+	var stdPolicy StdDecisionPolicy
+	require.NoError(t, stdPolicy.SetDecisionPolicy(&policy))
+	require.NoError(t, k.groupAccountTable.Create(ctx, &StdGroupAccountMetadata{
+		Base: GroupAccountMetadataBase{
+			GroupAccount: futureAccount,
+			Group:        childGroupID + 1,
+			Admin:        myAdmin,
+			Version:      1,
+		},
+		DecisionPolicy: stdPolicy,
+	}))
+
+	specs := map[string]struct {
+		member sdk.AccAddress
+		expErr *errors.Error
+	}{
+		"own group account": {
+			member: futureAccount,
+			expErr: ErrInvalid,
+		},
+		"nested group account": {
+			member: childAccount,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			groupID, err := k.CreateGroup(ctx, myAdmin, Members{{Address: spec.member, Power: sdk.OneDec()}}, "parent")
+			require.True(t, spec.expErr.Is(err), err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.True(t, k.groupMemberTable.Has(ctx, GroupMember{Group: groupID, Member: spec.member}.NaturalKey()))
+		})
+	}
+}
+
+func TestHandlerPointerMsgs(t *testing.T) {
+	k, ctx := createGroupKeeper()
+	h := NewHandler(k)
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+	myMember := sdk.AccAddress([]byte("valid-member-address"))
+
+	res, err := h(ctx, &MsgCreateGroup{Admin: myAdmin, Comment: "test"})
+	require.NoError(t, err)
+	groupID := GroupID(orm.DecodeSequence(res.Data))
+
+	_, err = h(ctx, &MsgUpdateGroupMembers{
+		Group:         groupID,
+		Admin:         myAdmin,
+		MemberUpdates: []Member{{Address: myMember, Power: sdk.OneDec()}},
+	})
+	require.NoError(t, err)
+	_, err = h(ctx, &MsgUpdateGroupComment{Group: groupID, Admin: myAdmin, Comment: "updated"})
+	require.NoError(t, err)
+
+	loaded, err := k.GetGroup(ctx, groupID)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", loaded.Comment)
+	assert.Equal(t, sdk.OneDec(), loaded.TotalWeight)
+
+	_, err = h(ctx, (*MsgUpdateGroupComment)(nil))
+	assert.True(t, errors.ErrUnknownRequest.Is(err), err)
+}
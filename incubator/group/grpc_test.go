@@ -0,0 +1,289 @@
+package group_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/group/testdata"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startGRPCServer serves the group Msg and Query services on an in memory connection. All requests are executed
+// with the given sdk.Context. The Msg service is registered for testing only as there is no signature verification.
+func startGRPCServer(t *testing.T, k group.Keeper, ctx sdk.Context) (*grpc.ClientConn, func()) {
+	withSDKContext := func(_ context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(group.WrapSDKContext(ctx), req)
+	}
+	srv := grpc.NewServer(grpc.UnaryInterceptor(withSDKContext))
+	group.NewAppModule(k, nil, nil).RegisterServices(srv)
+	group.RegisterMsgServer(srv, group.NewMsgServerImpl(k))
+	listener := bufconn.Listen(1024 * 1024)
+	go srv.Serve(listener)
+
+	dialer := func(context.Context, string) (net.Conn, error) { return listener.Dial() }
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	require.NoError(t, err)
+	return conn, func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+func TestGRPCServices(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+
+	conn, stop := startGRPCServer(t, k, ctx)
+	defer stop()
+	msgClient, queryClient := group.NewMsgClient(conn), group.NewQueryClient(conn)
+	goCtx := context.Background()
+
+	var (
+		admin  = sdk.AccAddress("valid--admin-address")
+		member = sdk.AccAddress("valid-member-address")
+	)
+	// when
+	createGroupRes, err := msgClient.CreateGroup(goCtx, &group.MsgCreateGroup{
+		Admin: admin,
+		Members: []group.Member{
+			{Address: member, Power: sdk.OneDec()},
+			{Address: sdk.AccAddress("other-member-address"), Power: sdk.OneDec()},
+		},
+		Comment: "grpc",
+	})
+	require.NoError(t, err)
+	// then
+	myGroupID := createGroupRes.Group
+	assert.Equal(t, group.GroupID(1), myGroupID)
+
+	// when
+	createAccountRes, err := msgClient.CreateGroupAccount(goCtx, &group.MsgCreateGroupAccountStd{
+		Base: group.MsgCreateGroupAccountBase{Admin: admin, Group: myGroupID, Comment: "grpc"},
+		DecisionPolicy: group.StdDecisionPolicy{Sum: &group.StdDecisionPolicy_Threshold{
+			Threshold: &group.ThresholdDecisionPolicy{Threshold: sdk.NewDec(2), Timout: types.Duration{Seconds: 1}},
+		}},
+	})
+	require.NoError(t, err)
+	// then
+	accountAddr := createAccountRes.GroupAccount
+	require.NotEmpty(t, accountAddr)
+
+	myProposalID, err := k.CreateProposal(ctx, accountAddr, "grpc", []sdk.AccAddress{member}, nil)
+	require.NoError(t, err)
+	_, err = msgClient.Vote(goCtx, &group.MsgVote{Proposal: myProposalID, Voters: []sdk.AccAddress{member}, Choice: group.Choice_NO})
+	require.NoError(t, err)
+
+	// and errors are returned to the client
+	_, err = msgClient.UpdateGroupComment(goCtx, &group.MsgUpdateGroupComment{Admin: member, Group: myGroupID, Comment: "not admin"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not group admin")
+	_, err = msgClient.UpdateGroupComment(goCtx, &group.MsgUpdateGroupComment{Group: myGroupID, Comment: "no admin"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "admin")
+
+	specs := map[string]struct {
+		query    func() (interface{}, error)
+		expCount int // number of list elements, -1 for a single object
+		expErr   bool
+	}{
+		"group": {
+			query: func() (interface{}, error) {
+				return queryClient.Group(goCtx, &group.QueryGroupRequest{Group: myGroupID})
+			},
+			expCount: -1,
+		},
+		"unknown group": {
+			query: func() (interface{}, error) {
+				return queryClient.Group(goCtx, &group.QueryGroupRequest{Group: 100})
+			},
+			expErr: true,
+		},
+		"group members": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.GroupMembers(goCtx, &group.QueryGroupMembersRequest{Group: myGroupID})
+				if err != nil {
+					return nil, err
+				}
+				return res.Members, nil
+			},
+			expCount: 2,
+		},
+		"groups by admin": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.GroupsByAdmin(goCtx, &group.QueryGroupsByAdminRequest{Admin: admin})
+				if err != nil {
+					return nil, err
+				}
+				return res.Groups, nil
+			},
+			expCount: 1,
+		},
+		"groups by admin second page": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.GroupsByAdmin(goCtx, &group.QueryGroupsByAdminRequest{
+					Admin:      admin,
+					Pagination: &group.PageRequest{Page: 2, Limit: 1},
+				})
+				if err != nil {
+					return nil, err
+				}
+				return res.Groups, nil
+			},
+			expCount: 0,
+		},
+		"groups by admin limit exceeded": {
+			query: func() (interface{}, error) {
+				return queryClient.GroupsByAdmin(goCtx, &group.QueryGroupsByAdminRequest{
+					Admin:      admin,
+					Pagination: &group.PageRequest{Limit: group.MaxQueryLimit + 1},
+				})
+			},
+			expErr: true,
+		},
+		"groups by empty admin": {
+			query: func() (interface{}, error) {
+				return queryClient.GroupsByAdmin(goCtx, &group.QueryGroupsByAdminRequest{})
+			},
+			expErr: true,
+		},
+		"groups by member": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.GroupsByMember(goCtx, &group.QueryGroupsByMemberRequest{Member: member})
+				if err != nil {
+					return nil, err
+				}
+				return res.Memberships, nil
+			},
+			expCount: 1,
+		},
+		"group account": {
+			query: func() (interface{}, error) {
+				return queryClient.GroupAccount(goCtx, &group.QueryGroupAccountRequest{GroupAccount: accountAddr})
+			},
+			expCount: -1,
+		},
+		"group accounts by group": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.GroupAccountsByGroup(goCtx, &group.QueryGroupAccountsByGroupRequest{Group: myGroupID})
+				if err != nil {
+					return nil, err
+				}
+				return res.GroupAccounts, nil
+			},
+			expCount: 1,
+		},
+		"group accounts by admin": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.GroupAccountsByAdmin(goCtx, &group.QueryGroupAccountsByAdminRequest{Admin: admin})
+				if err != nil {
+					return nil, err
+				}
+				return res.GroupAccounts, nil
+			},
+			expCount: 1,
+		},
+		"proposals by group account": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.ProposalsByGroupAccount(goCtx, &group.QueryProposalsByGroupAccountRequest{GroupAccount: accountAddr})
+				if err != nil {
+					return nil, err
+				}
+				return res.Proposals, nil
+			},
+			expCount: 1,
+		},
+		"proposals by proposer": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.ProposalsByProposer(goCtx, &group.QueryProposalsByProposerRequest{Proposer: member})
+				if err != nil {
+					return nil, err
+				}
+				return res.Proposals, nil
+			},
+			expCount: 1,
+		},
+		"vote": {
+			query: func() (interface{}, error) {
+				return queryClient.VoteByProposalVoter(goCtx, &group.QueryVoteByProposalVoterRequest{Proposal: myProposalID, Voter: member})
+			},
+			expCount: -1,
+		},
+		"votes by proposal": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.VotesByProposal(goCtx, &group.QueryVotesByProposalRequest{Proposal: myProposalID})
+				if err != nil {
+					return nil, err
+				}
+				return res.Votes, nil
+			},
+			expCount: 1,
+		},
+		"votes by voter": {
+			query: func() (interface{}, error) {
+				res, err := queryClient.VotesByVoter(goCtx, &group.QueryVotesByVoterRequest{Voter: member})
+				if err != nil {
+					return nil, err
+				}
+				return res.Votes, nil
+			},
+			expCount: 1,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := spec.query()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if spec.expCount < 0 {
+				assert.NotNil(t, res)
+				return
+			}
+			assert.Len(t, res, spec.expCount)
+		})
+	}
+
+	t.Run("proposal contains the app type", func(t *testing.T) {
+		res, err := queryClient.Proposal(goCtx, &group.QueryProposalRequest{Proposal: myProposalID})
+		require.NoError(t, err)
+		var loaded testdata.MyAppProposal
+		require.NoError(t, types.UnmarshalAny(res.Proposal, &loaded))
+		assert.Equal(t, accountAddr, loaded.Base.GroupAccount)
+		assert.Equal(t, sdk.OneDec(), loaded.Base.VoteState.NoCount)
+	})
+	t.Run("proposal execution of not executed proposal", func(t *testing.T) {
+		_, err := queryClient.ProposalExecution(goCtx, &group.QueryProposalExecutionRequest{Proposal: myProposalID})
+		require.Error(t, err)
+	})
+}
+
+func TestRegisterServicesWithoutMsgService(t *testing.T) {
+	k, _, _ := createTestKeeper(time.Now().UTC())
+	srv := grpc.NewServer()
+	group.NewAppModule(k, nil, nil).RegisterServices(srv)
+	listener := bufconn.Listen(1024 * 1024)
+	go srv.Serve(listener)
+	defer srv.Stop()
+
+	dialer := func(context.Context, string) (net.Conn, error) { return listener.Dial() }
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = group.NewMsgClient(conn).CreateGroup(context.Background(), &group.MsgCreateGroup{
+		Admin:   sdk.AccAddress("valid--admin-address"),
+		Comment: "grpc",
+	})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -2,81 +2,116 @@ package group
 
 import (
 	"fmt"
+	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/pkg/errors"
 )
 
-// NewHandler creates a new message handler.
+// NewHandler creates a new message handler. It is an adapter to the Msg service that keeps the legacy result
+// data and log.
 func NewHandler(k Keeper) sdk.Handler {
+	srv := msgServer{Keeper: k}
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
-		switch msg := msg.(type) {
+		goCtx := WrapSDKContext(ctx)
+		switch msg := derefMsg(msg).(type) {
 		case MsgCreateGroup:
-			return handleMsgCreateGroup(ctx, k, msg)
+			res, err := srv.CreateGroup(goCtx, &msg)
+			if err != nil {
+				return nil, err
+			}
+			return buildGroupResult(ctx, res.Group, "created"), nil
 		case MsgUpdateGroupAdmin:
-			return handleMsgUpdateGroupAdmin(ctx, k, msg)
+			if _, err := srv.UpdateGroupAdmin(goCtx, &msg); err != nil {
+				return nil, err
+			}
+			return buildGroupResult(ctx, msg.Group, "admin updated"), nil
 		case MsgUpdateGroupComment:
-			return handleMsgUpdateGroupComment(ctx, k, msg)
+			if _, err := srv.UpdateGroupComment(goCtx, &msg); err != nil {
+				return nil, err
+			}
+			return buildGroupResult(ctx, msg.Group, "comment updated"), nil
 		case MsgUpdateGroupMembers:
-			return handleMsgUpdateGroupMembers(ctx, k, msg)
+			if _, err := srv.UpdateGroupMembers(goCtx, &msg); err != nil {
+				return nil, err
+			}
+			return buildGroupResult(ctx, msg.Group, "members updated"), nil
 		case MsgCreateGroupAccountI:
-			return handleMsgCreateGroupAccountI(ctx, k, msg)
+			// app specific msg types are not part of the Msg service
+			acc, err := createGroupAccount(ctx, k, msg)
+			if err != nil {
+				return nil, err
+			}
+			return buildGroupAccountResult(ctx, acc, "created"), nil
+		case MsgUpdateGroupAccountAdmin:
+			if _, err := srv.UpdateGroupAccountAdmin(goCtx, &msg); err != nil {
+				return nil, err
+			}
+			return buildGroupAccountResult(ctx, msg.GroupAccount, "admin updated"), nil
+		case MsgUpdateGroupAccountDecisionPolicyI:
+			// app specific msg types are not part of the Msg service
+			if err := updateGroupAccountDecisionPolicy(ctx, k, msg); err != nil {
+				return nil, err
+			}
+			return buildGroupAccountResult(ctx, msg.GetBase().GroupAccount, "decision policy updated"), nil
+		case MsgUpdateGroupAccountComment:
+			if _, err := srv.UpdateGroupAccountComment(goCtx, &msg); err != nil {
+				return nil, err
+			}
+			return buildGroupAccountResult(ctx, msg.GroupAccount, "comment updated"), nil
 		case MsgVote:
-			return handleMsgVote(ctx, k, msg)
+			if _, err := srv.Vote(goCtx, &msg); err != nil {
+				return nil, err
+			}
+			return buildResult(ctx, nil, fmt.Sprintf("Voted for proposal: %d", msg.Proposal)), nil
 		case MsgExec:
-			return handleMsgExec(ctx, k, msg)
+			res, err := srv.Exec(goCtx, &msg)
+			if err != nil {
+				return nil, err
+			}
+			// the data contains the execution record
+			bz, err := res.Marshal()
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "marshal exec response")
+			}
+			return buildResult(ctx, bz, fmt.Sprintf("Executed proposal: %d", msg.Proposal)), nil
+		case MsgWithdrawProposal:
+			if _, err := srv.WithdrawProposal(goCtx, &msg); err != nil {
+				return nil, err
+			}
+			return buildResult(ctx, nil, fmt.Sprintf("Withdrawn proposal: %d", msg.Proposal)), nil
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized group message type: %T", msg)
 		}
 	}
 }
 
-// TODO: Do we want to introduce any new event types?
-
-func handleMsgVote(ctx sdk.Context, k Keeper, msg MsgVote) (*sdk.Result, error) {
-	if err := k.Vote(ctx, msg.Proposal, msg.Voters, msg.Choice, msg.Comment); err != nil {
-		return nil, err
+// derefMsg returns the value of a msg pointer when the value is a msg, too. Proposal payloads are pointers, for
+// example when a nested group account votes in its parent group.
+func derefMsg(msg sdk.Msg) sdk.Msg {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return msg
 	}
-	// todo: event?
-	return &sdk.Result{
-		Log:    fmt.Sprintf("Voted for proposal: %d", msg.Proposal),
-		Events: ctx.EventManager().Events(),
-	}, nil
+	if m, ok := v.Elem().Interface().(sdk.Msg); ok {
+		return m
+	}
+	return msg
 }
 
-func handleMsgExec(ctx sdk.Context, k Keeper, msg MsgExec) (*sdk.Result, error) {
-	if err := k.ExecProposal(ctx, msg.Proposal); err != nil {
-		return nil, err
-	}
-	// todo: event?
-	return &sdk.Result{
-		Log:    fmt.Sprintf("Executed proposal: %d", msg.Proposal),
-		Events: ctx.EventManager().Events(),
-	}, nil
+func buildGroupResult(ctx sdk.Context, group GroupID, note string) *sdk.Result {
+	return buildResult(ctx, group.Bytes(), fmt.Sprintf("Group %d %s", group, note))
 }
 
-func handleMsgCreateGroupAccountI(ctx sdk.Context, k Keeper, msg MsgCreateGroupAccountI) (*sdk.Result, error) {
-	decisionPolicy := msg.GetDecisionPolicy()
-	acc, err := k.CreateGroupAccount(ctx, msg.GetBase().Admin, msg.GetBase().Group, *decisionPolicy.GetThreshold(), msg.GetBase().Comment)
-	if err != nil {
-		return nil, errors.Wrap(err, "create group account")
-	}
-	return buildGroupAccountResult(ctx, msg.GetBase().Admin, acc, "created")
+func buildGroupAccountResult(ctx sdk.Context, acc sdk.AccAddress, note string) *sdk.Result {
+	return buildResult(ctx, acc.Bytes(), fmt.Sprintf("Group account %s %s", acc.String(), note))
 }
 
-func buildGroupAccountResult(ctx sdk.Context, admin sdk.AccAddress, acc sdk.AccAddress, note string) (*sdk.Result, error) {
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, admin.String()),
-		),
-	)
+func buildResult(ctx sdk.Context, data []byte, log string) *sdk.Result {
 	return &sdk.Result{
-		Data:   acc.Bytes(),
-		Log:    fmt.Sprintf("Group account %s %s", acc.String(), note),
+		Data:   data,
+		Log:    log,
 		Events: ctx.EventManager().Events(),
-	}, nil
+	}
 }
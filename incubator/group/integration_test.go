@@ -161,6 +161,20 @@ func TestCreateGroupAccountScenario(t *testing.T) {
 					}}},
 			},
 		},
+		"with percentage decision policy": {
+			src: group.MsgCreateGroupAccountStd{
+				Base: group.MsgCreateGroupAccountBase{
+					Admin:   myAddr,
+					Group:   myGroupID,
+					Comment: "integration test",
+				},
+				DecisionPolicy: group.StdDecisionPolicy{
+					Sum: &group.StdDecisionPolicy_Percentage{Percentage: &group.PercentageDecisionPolicy{
+						Percentage: sdk.NewDecWithPrec(5, 1),
+						Timout:     proto.Duration{Seconds: 1},
+					}}},
+			},
+		},
 		"unknown group in message": {
 			src: group.MsgCreateGroupAccountStd{
 				Base: group.MsgCreateGroupAccountBase{
@@ -354,3 +368,227 @@ func TestFullProposalWorkflow(t *testing.T) {
 	expTally = group.Tally{YesCount: sdk.ZeroDec(), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.OneDec()}
 	assert.Equal(t, expTally, proposal.GetBase().VoteState)
 }
+
+func TestWithdrawProposalScenario(t *testing.T) {
+	app, ctx := createTestApp(false)
+	myKey, _, myAddr := types.KeyTestPubAddr()
+	myAccount := app.AccountKeeper.NewAccountWithAddress(ctx, myAddr)
+	app.AccountKeeper.SetAccount(ctx, myAccount)
+
+	otherKey, _, otherAddr := types.KeyTestPubAddr()
+	otherAccount := app.AccountKeeper.NewAccountWithAddress(ctx, otherAddr)
+	app.AccountKeeper.SetAccount(ctx, otherAccount)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("atom", 10000))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, myAddr, balances))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, otherAddr, balances))
+
+	members := []group.Member{
+		{Address: myAddr, Power: sdk.OneDec()},
+		{Address: otherAddr, Power: sdk.OneDec()},
+	}
+	myGroupID, err := app.GroupKeeper.CreateGroup(ctx, myAddr, members, "integration test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(2),
+		Timout:    proto.Duration{Seconds: 10},
+	}
+	accountAddr, err := app.GroupKeeper.CreateGroupAccount(ctx, myAddr, myGroupID, &policy, "integration test")
+	require.NoError(t, err)
+	myProposalID, err := app.GroupKeeper.CreateProposal(ctx, accountAddr, "integration test", []sdk.AccAddress{otherAddr}, nil)
+	require.NoError(t, err)
+
+	fee := types.NewTestStdFee()
+	specs := []struct {
+		name    string
+		src     sdk.Msg
+		signer  crypto.PrivKey
+		account sdk.AccAddress
+		expCode uint32
+	}{
+		{
+			name:    "invalid signer",
+			src:     group.MsgWithdrawProposal{Proposal: myProposalID, Signer: otherAddr},
+			signer:  myKey,
+			account: myAddr,
+			expCode: errors.ErrInvalidPubKey.ABCICode(),
+		},
+		{
+			name:    "withdrawn by proposer",
+			src:     group.MsgWithdrawProposal{Proposal: myProposalID, Signer: otherAddr},
+			signer:  otherKey,
+			account: otherAddr,
+		},
+		{
+			name:    "vote rejected when withdrawn",
+			src:     group.MsgVote{Proposal: myProposalID, Voters: []sdk.AccAddress{myAddr}, Choice: group.Choice_YES},
+			signer:  myKey,
+			account: myAddr,
+			expCode: group.ErrInvalid.ABCICode(),
+		},
+		{
+			name:    "exec rejected when withdrawn",
+			src:     group.MsgExec{Proposal: myProposalID, Signer: myAddr},
+			signer:  myKey,
+			account: myAddr,
+			expCode: group.ErrInvalid.ABCICode(),
+		},
+		{
+			name:    "withdraw rejected when withdrawn",
+			src:     group.MsgWithdrawProposal{Proposal: myProposalID, Signer: myAddr},
+			signer:  myKey,
+			account: myAddr,
+			expCode: group.ErrInvalid.ABCICode(),
+		},
+	}
+	// executed in order as each step depends on the previous state
+	for _, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			acc := app.AccountKeeper.GetAccount(ctx, spec.account)
+			tx := types.NewTestTx(ctx, []sdk.Msg{spec.src}, []crypto.PrivKey{spec.signer}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, fee)
+
+			resp := app.DeliverTx(abci.RequestDeliverTx{Tx: app.Codec().MustMarshalBinaryLengthPrefixed(tx)})
+			// then
+			require.Equal(t, spec.expCode, resp.Code, resp.Log)
+		})
+	}
+
+	proposal, err := app.GroupKeeper.GetProposal(ctx, myProposalID)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalStatusWithdrawn, proposal.GetBase().Status, proposal.GetBase().Status.String())
+}
+
+func TestNestedGroupScenario(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// setup account
+	myKey, _, myAddr := types.KeyTestPubAddr()
+	myAccount := app.AccountKeeper.NewAccountWithAddress(ctx, myAddr)
+	app.AccountKeeper.SetAccount(ctx, myAccount)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("atom", 100000))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, myAddr, balances))
+
+	childAccountAddr := group.AccountCondition(1).Address()
+	parentAccountAddr := group.AccountCondition(2).Address()
+	thresholdPolicy := func(threshold int64) group.StdDecisionPolicy {
+		return group.StdDecisionPolicy{
+			Sum: &group.StdDecisionPolicy_Threshold{
+				Threshold: &group.ThresholdDecisionPolicy{
+					Threshold: sdk.NewDec(threshold),
+					Timout:    *proto.DurationProto(10 * time.Second),
+				},
+			},
+		}
+	}
+	msgs := []sdk.Msg{
+		// setup child group with an account
+		group.MsgCreateGroup{
+			Admin:   myAddr,
+			Members: []group.Member{{Address: myAddr, Power: sdk.OneDec()}},
+			Comment: "child",
+		},
+		group.MsgCreateGroupAccountStd{
+			Base:           group.MsgCreateGroupAccountBase{Admin: myAddr, Group: 1, Comment: "child account"},
+			DecisionPolicy: thresholdPolicy(1),
+		},
+		// setup parent group with the child group account as member
+		group.MsgCreateGroup{
+			Admin: myAddr,
+			Members: []group.Member{
+				{Address: myAddr, Power: sdk.OneDec()},
+				{Address: childAccountAddr, Power: sdk.NewDec(2)},
+			},
+			Comment: "parent",
+		},
+		group.MsgCreateGroupAccountStd{
+			Base:           group.MsgCreateGroupAccountBase{Admin: myAddr, Group: 2, Comment: "parent account"},
+			DecisionPolicy: thresholdPolicy(2),
+		},
+		// submit parent proposal
+		testdata.MsgPropose{
+			Base: group.MsgProposeBase{
+				GroupAccount: parentAccountAddr,
+				Proposers:    []sdk.AccAddress{myAddr},
+				Comment:      "parent proposal",
+			},
+			Msgs: []testdata.MyAppMsg{{Sum: &testdata.MyAppMsg_A{A: &testdata.MsgAlwaysSucceed{}}}},
+		},
+		// submit child proposal to vote on the parent proposal
+		testdata.MsgPropose{
+			Base: group.MsgProposeBase{
+				GroupAccount: childAccountAddr,
+				Proposers:    []sdk.AccAddress{myAddr},
+				Comment:      "vote for parent proposal",
+			},
+			Msgs: []testdata.MyAppMsg{{Sum: &testdata.MyAppMsg_G{G: &group.MsgVote{
+				Proposal: 1,
+				Voters:   []sdk.AccAddress{childAccountAddr},
+				Choice:   group.Choice_YES,
+				Comment:  "voted by child group",
+			}}}},
+		},
+		group.MsgVote{
+			Proposal: 2,
+			Voters:   []sdk.AccAddress{myAddr},
+			Choice:   group.Choice_YES,
+		},
+	}
+
+	fee := types.NewStdFee(400000, sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	deliver := func(msgs ...sdk.Msg) abci.ResponseDeliverTx {
+		acc := app.AccountKeeper.GetAccount(ctx, myAddr)
+		tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{myKey}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, fee)
+		return app.DeliverTx(abci.RequestDeliverTx{Tx: app.Codec().MustMarshalBinaryLengthPrefixed(tx)})
+	}
+	resp := deliver(msgs...)
+	require.Equal(t, uint32(0), resp.Code, resp.Log)
+
+	// execute can not be in the same block so start new one
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}})
+
+	// when the child proposal is executed
+	resp = deliver(group.MsgExec{Proposal: 2, Signer: myAddr})
+	require.Equal(t, uint32(0), resp.Code, resp.Log)
+
+	// then the response contains the execution record with the vote result
+	var execResp group.MsgExecResponse
+	require.NoError(t, execResp.Unmarshal(resp.Data))
+	require.NotNil(t, execResp.Execution)
+	assert.Equal(t, group.ProposalExecutorResultSuccess, execResp.Execution.ExecutorResult)
+	require.Len(t, execResp.Execution.MsgResults, 1)
+	assert.Equal(t, "Voted for proposal: 1", execResp.Execution.MsgResults[0].Log)
+	assert.NotEmpty(t, execResp.Execution.MsgResults[0].Events)
+
+	// and the child group account has voted on the parent proposal
+	child, err := app.GroupKeeper.GetProposal(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalExecutorResultSuccess, child.GetBase().ExecutorResult, child.GetBase().ExecutorResult.String())
+
+	vote, err := app.GroupKeeper.GetVote(ctx, 1, childAccountAddr)
+	require.NoError(t, err)
+	assert.Equal(t, group.Choice_YES, vote.Choice)
+	assert.Equal(t, "voted by child group", vote.Comment)
+
+	parent, err := app.GroupKeeper.GetProposal(ctx, 1)
+	require.NoError(t, err)
+	expTally := group.Tally{YesCount: sdk.NewDec(2), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()}
+	assert.Equal(t, expTally, parent.GetBase().VoteState)
+
+	// and the parent proposal is accepted by the child group weight
+	resp = deliver(group.MsgExec{Proposal: 1, Signer: myAddr})
+	require.Equal(t, uint32(0), resp.Code, resp.Log)
+
+	parent, err = app.GroupKeeper.GetProposal(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, group.ProposalResultAccepted, parent.GetBase().Result, parent.GetBase().Result.String())
+	assert.Equal(t, group.ProposalExecutorResultSuccess, parent.GetBase().ExecutorResult, parent.GetBase().ExecutorResult.String())
+
+	// and membership cycles are rejected
+	resp = deliver(group.MsgUpdateGroupMembers{
+		Group:         1,
+		Admin:         myAddr,
+		MemberUpdates: []group.Member{{Address: parentAccountAddr, Power: sdk.OneDec()}},
+	})
+	require.Equal(t, group.ErrInvalid.ABCICode(), resp.Code, resp.Log)
+}
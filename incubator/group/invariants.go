@@ -0,0 +1,284 @@
+package group
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/pkg/errors"
+)
+
+// RegisterInvariants registers all group module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(ModuleName, "group-total-weight", GroupTotalWeightInvariant(k))
+	ir.RegisterRoute(ModuleName, "tally-votes", TallyVotesInvariant(k))
+	ir.RegisterRoute(ModuleName, "index-consistency", IndexConsistencyInvariant(k))
+	ir.RegisterRoute(ModuleName, "tally-not-decreasing", TallyNotDecreasingInvariant(k))
+}
+
+// GroupTotalWeightInvariant checks that the total weight of every group equals the sum of its member weights.
+func GroupTotalWeightInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkGroupTotalWeight(ctx, k)
+		return sdk.FormatInvariant(ModuleName, "group-total-weight", msg), broken
+	}
+}
+
+func checkGroupTotalWeight(ctx sdk.Context, k Keeper) (string, bool) {
+	it, err := k.groupTable.PrefixScan(ctx, 1, math.MaxUint64)
+	if err != nil {
+		return err.Error(), true
+	}
+	defer it.Close()
+	for {
+		var g GroupMetadata
+		_, err := it.LoadNext(&g)
+		switch {
+		case orm.ErrIteratorDone.Is(err):
+			return "", false
+		case err != nil:
+			return err.Error(), true
+		}
+		membersIt, err := k.groupMemberTable.GetByGroup(ctx, g.Group)
+		if err != nil {
+			return err.Error(), true
+		}
+		members, err := membersIt.ReadAll()
+		if err != nil {
+			return err.Error(), true
+		}
+		sum := sdk.ZeroDec()
+		for _, m := range members {
+			sum = sum.Add(m.Weight)
+		}
+		if !sum.Equal(g.TotalWeight) {
+			return fmt.Sprintf("group %d: total weight %s does not match sum of member weights %s", g.Group, g.TotalWeight, sum), true
+		}
+	}
+}
+
+// TallyVotesInvariant checks that the vote state of every submitted proposal equals the weighted sum of its stored
+// votes. Votes are weighted by the electorate snapshot when the proposal has one. Proposals with a modified group are
+// skipped otherwise as they do not accept votes anymore.
+func TallyVotesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkTallyVotes(ctx, k)
+		return sdk.FormatInvariant(ModuleName, "tally-votes", msg), broken
+	}
+}
+
+func checkTallyVotes(ctx sdk.Context, k Keeper) (string, bool) {
+	proposals, err := k.submittedProposals(ctx)
+	if err != nil {
+		return err.Error(), true
+	}
+	for _, p := range proposals {
+		tally, err := k.tallyVotes(ctx, p.ID, p.Base)
+		switch {
+		case ErrModified.Is(err):
+			continue
+		case err != nil:
+			return err.Error(), true
+		}
+		if !tallyEqual(tally, p.Base.VoteState) {
+			return fmt.Sprintf("proposal %d: vote state %s does not match sum of votes %s", p.ID, p.Base.VoteState.String(), tally.String()), true
+		}
+	}
+	return "", false
+}
+
+// tallyVotes returns the weighted sum of the stored votes of a proposal. Returns ErrModified when the group of a
+// proposal without electorate snapshot was modified so that the vote weights are not known anymore.
+func (k Keeper) tallyVotes(ctx sdk.Context, id ProposalID, base ProposalBase) (Tally, error) {
+	account, err := k.GetGroupAccount(ctx, base.GroupAccount)
+	if err != nil {
+		return Tally{}, err
+	}
+	electorate, err := k.loadElectorate(ctx, id, base, account.Base.Group)
+	switch {
+	case ErrModified.Is(err):
+		return Tally{}, err
+	case err != nil:
+		return Tally{}, errors.Wrapf(err, "proposal %d", id)
+	}
+	it, err := k.voteTable.GetByProposal(ctx, id)
+	if err != nil {
+		return Tally{}, err
+	}
+	votes, err := it.ReadAll()
+	if err != nil {
+		return Tally{}, err
+	}
+	tally := Tally{
+		YesCount:     sdk.ZeroDec(),
+		NoCount:      sdk.ZeroDec(),
+		AbstainCount: sdk.ZeroDec(),
+		VetoCount:    sdk.ZeroDec(),
+	}
+	for _, v := range votes {
+		weight, err := k.memberWeight(ctx, electorate, v.Voter)
+		if err != nil {
+			return Tally{}, errors.Wrapf(err, "proposal %d: voter %s", id, v.Voter)
+		}
+		if err := tally.Add(v, weight); err != nil {
+			return Tally{}, errors.Wrapf(err, "proposal %d", id)
+		}
+	}
+	return tally, nil
+}
+
+func tallyEqual(a, b Tally) bool {
+	return a.YesCount.Equal(b.YesCount) && a.NoCount.Equal(b.NoCount) &&
+		a.AbstainCount.Equal(b.AbstainCount) && a.VetoCount.Equal(b.VetoCount)
+}
+
+// IndexConsistencyInvariant checks that every secondary index entry points to an existing row and that every row is
+// indexed.
+func IndexConsistencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkIndexConsistency(ctx, k)
+		return sdk.FormatInvariant(ModuleName, "index-consistency", msg), broken
+	}
+}
+
+func checkIndexConsistency(ctx sdk.Context, k Keeper) (string, bool) {
+	type verifier interface {
+		Verify(ctx orm.HasKVStore, table orm.TableExportable) error
+	}
+	specs := []struct {
+		name  string
+		index verifier
+		table orm.TableExportable
+	}{
+		{"proposal by group account", k.ProposalGroupAccountIndex, k.proposalTable},
+		{"proposal by proposer", k.ProposalByProposerIndex, k.proposalTable},
+		{"proposal by timeout", k.proposalByTimeoutIndex, k.proposalTable},
+		{"finalized proposal", k.finalizedProposalIndex, k.proposalTable},
+	}
+	for _, spec := range specs {
+		if err := spec.index.Verify(ctx, spec.table); err != nil {
+			return errors.Wrap(err, spec.name).Error(), true
+		}
+	}
+	// generated tables verify all their indexes
+	typedSpecs := []struct {
+		name  string
+		table interface {
+			VerifyIndexes(ctx orm.HasKVStore) error
+		}
+	}{
+		{"group", k.groupTable},
+		{"group member", k.groupMemberTable},
+		{"group account", k.groupAccountTable},
+		{"vote", k.voteTable},
+		{"electorate snapshot member", k.electorateSnapshotMemberTable},
+	}
+	for _, spec := range typedSpecs {
+		if err := spec.table.VerifyIndexes(ctx); err != nil {
+			return errors.Wrap(err, spec.name).Error(), true
+		}
+	}
+	return "", false
+}
+
+// TallyNotDecreasingInvariant checks that the total of all tally counters of a submitted proposal is not lower than
+// the total stored by RecordTallyTotals at the beginning of the block. Single counters may decrease when a voter
+// changes the vote. Proposals without a stored total, for example after a genesis import, are not checked.
+func TallyNotDecreasingInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkTallyNotDecreasing(ctx, k)
+		return sdk.FormatInvariant(ModuleName, "tally-not-decreasing", msg), broken
+	}
+}
+
+func checkTallyNotDecreasing(ctx sdk.Context, k Keeper) (string, bool) {
+	proposals, err := k.submittedProposals(ctx)
+	if err != nil {
+		return err.Error(), true
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), []byte{TallyTotalPrefix})
+	for _, p := range proposals {
+		bz := store.Get(orm.EncodeSequence(p.ID.Uint64()))
+		if bz == nil {
+			continue
+		}
+		var last sdk.Dec
+		if err := last.Unmarshal(bz); err != nil {
+			return errors.Wrapf(err, "proposal %d: tally total", p.ID).Error(), true
+		}
+		if total := p.Base.VoteState.TotalCounts(); total.LT(last) {
+			return fmt.Sprintf("proposal %d: tally decreased from %s to %s", p.ID, last, total), true
+		}
+	}
+	return "", false
+}
+
+// RecordTallyTotals stores the total of all tally counters of every submitted proposal for the tally-not-decreasing
+// invariant. Totals of proposals that are not submitted anymore are removed.
+func (k Keeper) RecordTallyTotals(ctx sdk.Context) error {
+	proposals, err := k.submittedProposals(ctx)
+	if err != nil {
+		return err
+	}
+	submitted := make(map[ProposalID]bool, len(proposals))
+	for _, p := range proposals {
+		submitted[p.ID] = true
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), []byte{TallyTotalPrefix})
+
+	// collect keys first as no writes may happen while the iterator is open
+	var stale [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		if !submitted[ProposalID(orm.DecodeSequence(it.Key()))] {
+			stale = append(stale, it.Key())
+		}
+	}
+	it.Close()
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	for _, p := range proposals {
+		bz, err := p.Base.VoteState.TotalCounts().Marshal()
+		if err != nil {
+			return errors.Wrapf(err, "proposal %d: tally total", p.ID)
+		}
+		if key := orm.EncodeSequence(p.ID.Uint64()); !bytes.Equal(store.Get(key), bz) {
+			store.Set(key, bz)
+		}
+	}
+	return nil
+}
+
+// submittedProposal is a proposal base with its id.
+type submittedProposal struct {
+	ID   ProposalID
+	Base ProposalBase
+}
+
+// submittedProposals returns the base of all proposals with status submitted ordered by their ids.
+func (k Keeper) submittedProposals(ctx sdk.Context) ([]submittedProposal, error) {
+	it, err := k.proposalTable.PrefixScan(ctx, 1, math.MaxUint64)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var r []submittedProposal
+	for {
+		p := k.newProposalModel()
+		rowID, err := it.LoadNext(p)
+		switch {
+		case orm.ErrIteratorDone.Is(err):
+			return r, nil
+		case err != nil:
+			return nil, err
+		}
+		if base := p.GetBase(); base.Status == ProposalStatusSubmitted {
+			r = append(r, submittedProposal{ID: ProposalID(orm.DecodeSequence(rowID)), Base: base})
+		}
+	}
+}
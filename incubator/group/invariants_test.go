@@ -0,0 +1,138 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvariants(t *testing.T) {
+	k, parentCtx, groupKey := createTestKeeper(time.Now().UTC())
+
+	myAdmin := sdk.AccAddress([]byte("valid--admin-address"))
+	myMember := sdk.AccAddress([]byte("valid-member-address"))
+	members := []group.Member{
+		{Address: myMember, Power: sdk.OneDec()},
+		{Address: []byte("power-member-address"), Power: sdk.NewDec(2)},
+	}
+	myGroupID, err := k.CreateGroup(parentCtx, myAdmin, members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(3),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(parentCtx, myAdmin, myGroupID, &policy, "test")
+	require.NoError(t, err)
+	myProposalID, err := k.CreateProposal(parentCtx, accountAddr, "test", []sdk.AccAddress{myMember}, nil)
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(parentCtx, myProposalID, []sdk.AccAddress{myMember}, group.Choice_YES, ""))
+	require.NoError(t, k.RecordTallyTotals(parentCtx))
+
+	// overwriteVoteState stores the proposal with a modified vote state without updating any other data
+	overwriteVoteState := func(t *testing.T, ctx sdk.Context, yes, no sdk.Dec) {
+		proposal, err := k.GetProposal(ctx, myProposalID)
+		require.NoError(t, err)
+		base := proposal.GetBase()
+		base.VoteState.YesCount = yes
+		base.VoteState.NoCount = no
+		proposal.SetBase(base)
+		bz, err := proposal.Marshal()
+		require.NoError(t, err)
+		store := prefix.NewStore(ctx.KVStore(groupKey), []byte{group.ProposalBaseTablePrefix})
+		store.Set(orm.EncodeSequence(myProposalID.Uint64()), bz)
+	}
+
+	specs := map[string]struct {
+		doCorrupt func(t *testing.T, ctx sdk.Context)
+		expBroken string
+	}{
+		"consistent": {
+			doCorrupt: func(t *testing.T, ctx sdk.Context) {},
+		},
+		"total weight does not match members": {
+			doCorrupt: func(t *testing.T, ctx sdk.Context) {
+				g, err := k.GetGroup(ctx, myGroupID)
+				require.NoError(t, err)
+				g.TotalWeight = sdk.NewDec(5)
+				require.NoError(t, k.UpdateGroup(ctx, &g))
+			},
+			expBroken: "group-total-weight",
+		},
+		"vote state does not match votes": {
+			doCorrupt: func(t *testing.T, ctx sdk.Context) {
+				// same total with a different choice
+				overwriteVoteState(t, ctx, sdk.ZeroDec(), sdk.OneDec())
+			},
+			expBroken: "tally-votes",
+		},
+		"row without index entry": {
+			doCorrupt: func(t *testing.T, ctx sdk.Context) {
+				store := prefix.NewStore(ctx.KVStore(groupKey), []byte{group.VoteByVoterIndexPrefix})
+				it := store.Iterator(nil, nil)
+				require.True(t, it.Valid())
+				key := it.Key()
+				it.Close()
+				store.Delete(key)
+			},
+			expBroken: "index-consistency",
+		},
+		"tally increased": {
+			doCorrupt: func(t *testing.T, ctx sdk.Context) {
+				voter := sdk.AccAddress([]byte("power-member-address"))
+				require.NoError(t, k.Vote(ctx, myProposalID, []sdk.AccAddress{voter}, group.Choice_NO, ""))
+			},
+		},
+		"tally decreased without recorded total": {
+			doCorrupt: func(t *testing.T, ctx sdk.Context) {
+				ctx.KVStore(groupKey).Delete(append([]byte{group.TallyTotalPrefix}, orm.EncodeSequence(myProposalID.Uint64())...))
+				overwriteVoteState(t, ctx, sdk.ZeroDec(), sdk.ZeroDec())
+			},
+			expBroken: "tally-votes",
+		},
+		"tally decreased": {
+			doCorrupt: func(t *testing.T, ctx sdk.Context) {
+				overwriteVoteState(t, ctx, sdk.ZeroDec(), sdk.ZeroDec())
+			},
+			expBroken: "tally-not-decreasing",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			invariants := map[string]sdk.Invariant{
+				"group-total-weight":   group.GroupTotalWeightInvariant(k),
+				"tally-votes":          group.TallyVotesInvariant(k),
+				"index-consistency":    group.IndexConsistencyInvariant(k),
+				"tally-not-decreasing": group.TallyNotDecreasingInvariant(k),
+			}
+			spec.doCorrupt(t, ctx)
+			for name, inv := range invariants {
+				res, broken := inv(ctx)
+				if name == spec.expBroken {
+					assert.True(t, broken, name)
+					continue
+				}
+				if name == "tally-votes" && spec.expBroken == "tally-not-decreasing" {
+					continue // also broken by the modified vote state
+				}
+				assert.False(t, broken, res)
+			}
+		})
+	}
+
+	t.Run("totals of finalized proposals removed", func(t *testing.T) {
+		ctx, _ := parentCtx.CacheContext()
+		require.NoError(t, k.WithdrawProposal(ctx, myProposalID, myMember))
+		require.NoError(t, k.RecordTallyTotals(ctx))
+		it := prefix.NewStore(ctx.KVStore(groupKey), []byte{group.TallyTotalPrefix}).Iterator(nil, nil)
+		defer it.Close()
+		assert.False(t, it.Valid())
+	})
+}
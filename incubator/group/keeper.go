@@ -3,6 +3,7 @@ package group
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,33 +12,25 @@ import (
 	"github.com/gogo/protobuf/types"
 )
 
+// The prefixes of the group, group member, group account, vote, electorate snapshot and proposal execution tables are
+// generated from the table options in types.proto.
 const (
-	// Group Table
-	GroupTablePrefix        byte = 0x0
-	GroupTableSeqPrefix     byte = 0x1
-	GroupByAdminIndexPrefix byte = 0x2
-
-	// Group Member Table
-	GroupMemberTablePrefix         byte = 0x10
-	GroupMemberByGroupIndexPrefix  byte = 0x11
-	GroupMemberByMemberIndexPrefix byte = 0x12
-
-	// Group Account Table
-	GroupAccountTablePrefix        byte = 0x20
-	GroupAccountTableSeqPrefix     byte = 0x21
-	GroupAccountByGroupIndexPrefix byte = 0x22
-	GroupAccountByAdminIndexPrefix byte = 0x23
+	// Group Account Sequence
+	GroupAccountTableSeqPrefix byte = 0x21
 
 	// ProposalBase Table
 	ProposalBaseTablePrefix               byte = 0x30
 	ProposalBaseTableSeqPrefix            byte = 0x31
 	ProposalBaseByGroupAccountIndexPrefix byte = 0x32
 	ProposalBaseByProposerIndexPrefix     byte = 0x33
+	ProposalBaseByTimeoutIndexPrefix      byte = 0x34
+	ProposalBaseFinalizedByTimeoutPrefix  byte = 0x35
 
-	// Vote Table
-	VoteTablePrefix               byte = 0x40
-	VoteByProposalBaseIndexPrefix byte = 0x41
-	VoteByVoterIndexPrefix        byte = 0x42
+	// Tally totals of the last block for the tally-not-decreasing invariant
+	TallyTotalPrefix byte = 0x70
+
+	// Deprecated: use VoteByProposalIndexPrefix.
+	VoteByProposalBaseIndexPrefix = VoteByProposalIndexPrefix
 )
 
 type ProposalI interface {
@@ -53,30 +46,31 @@ type Keeper struct {
 	proposalModelType reflect.Type
 
 	// Group Table
-	groupSeq          orm.Sequence
-	groupTable        orm.Table
-	groupByAdminIndex orm.Index
+	groupTable GroupTable
 
 	// Group Member Table
-	groupMemberTable         orm.NaturalKeyTable
-	groupMemberByGroupIndex  orm.UInt64Index
-	groupMemberByMemberIndex orm.Index
+	groupMemberTable GroupMemberTable
 
 	// Group Account Table
-	groupAccountSeq          orm.Sequence
-	groupAccountTable        orm.NaturalKeyTable
-	groupAccountByGroupIndex orm.UInt64Index
-	groupAccountByAdminIndex orm.Index
+	groupAccountSeq   orm.Sequence
+	groupAccountTable GroupAccountTable
 
 	// ProposalBase Table
 	proposalTable             orm.AutoUInt64Table
 	ProposalGroupAccountIndex orm.Index
 	ProposalByProposerIndex   orm.Index
+	proposalByTimeoutIndex    orm.Index
+	finalizedProposalIndex    orm.Index
 
 	// Vote Table
-	voteTable               orm.NaturalKeyTable
-	voteByProposalBaseIndex orm.UInt64Index
-	voteByVoterIndex        orm.Index
+	voteTable VoteTable
+
+	// Electorate Snapshot Tables
+	electorateSnapshotTable       ElectorateSnapshotTable
+	electorateSnapshotMemberTable ElectorateSnapshotMemberTable
+
+	// Proposal Execution Table
+	proposalExecutionTable ProposalExecutionTable
 
 	paramSpace params.Subspace
 	router     sdk.Router
@@ -107,41 +101,18 @@ func NewGroupKeeper(storeKey sdk.StoreKey, paramSpace params.Subspace, router sd
 	//
 	// Group Table
 	//
-	groupTableBuilder := orm.NewTableBuilder(GroupTablePrefix, storeKey, &GroupMetadata{}, orm.FixLengthIndexKeys(orm.EncodedSeqLength))
-	k.groupSeq = orm.NewSequence(storeKey, GroupTableSeqPrefix)
-	k.groupByAdminIndex = orm.NewIndex(groupTableBuilder, GroupByAdminIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
-		return []orm.RowID{val.(*GroupMetadata).Admin.Bytes()}, nil
-	})
-	k.groupTable = groupTableBuilder.Build()
+	k.groupTable = NewGroupTable(storeKey)
 
 	//
 	// Group Member Table
 	//
-	groupMemberTableBuilder := orm.NewNaturalKeyTableBuilder(GroupMemberTablePrefix, storeKey, &GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{})
-	k.groupMemberByGroupIndex = orm.NewUInt64Index(groupMemberTableBuilder, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]uint64, error) {
-		group := val.(*GroupMember).Group
-		return []uint64{uint64(group)}, nil
-	})
-	k.groupMemberByMemberIndex = orm.NewIndex(groupMemberTableBuilder, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]orm.RowID, error) {
-		member := val.(*GroupMember).Member
-		return []orm.RowID{member.Bytes()}, nil
-	})
-	k.groupMemberTable = groupMemberTableBuilder.Build()
+	k.groupMemberTable = NewGroupMemberTable(storeKey)
 
 	//
 	// Group Account Table
 	//
 	k.groupAccountSeq = orm.NewSequence(storeKey, GroupAccountTableSeqPrefix)
-	groupAccountTableBuilder := orm.NewNaturalKeyTableBuilder(GroupAccountTablePrefix, storeKey, &StdGroupAccountMetadata{}, orm.Max255DynamicLengthIndexKeyCodec{})
-	k.groupAccountByGroupIndex = orm.NewUInt64Index(groupAccountTableBuilder, GroupAccountByGroupIndexPrefix, func(value interface{}) ([]uint64, error) {
-		group := value.(*StdGroupAccountMetadata).Base.Group
-		return []uint64{uint64(group)}, nil
-	})
-	k.groupAccountByAdminIndex = orm.NewIndex(groupAccountTableBuilder, GroupAccountByAdminIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
-		admin := value.(*StdGroupAccountMetadata).Base.Admin
-		return []orm.RowID{admin.Bytes()}, nil
-	})
-	k.groupAccountTable = groupAccountTableBuilder.Build()
+	k.groupAccountTable = NewGroupAccountTable(storeKey)
 
 	// Proposal Table
 	proposalTableBuilder := orm.NewAutoUInt64TableBuilder(ProposalBaseTablePrefix, ProposalBaseTableSeqPrefix, storeKey, proposalModel)
@@ -158,19 +129,47 @@ func NewGroupKeeper(storeKey sdk.StoreKey, paramSpace params.Subspace, router sd
 		}
 		return r, nil
 	})
+	// only proposals that need to be processed in the EndBlocker are indexed
+	k.proposalByTimeoutIndex = orm.NewIndex(proposalTableBuilder, ProposalBaseByTimeoutIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+		base := value.(ProposalI).GetBase()
+		if !base.pendingEndBlock() {
+			return nil, nil
+		}
+		timeout, err := types.TimestampFromProto(&base.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		return []orm.RowID{sdk.FormatTimeBytes(timeout)}, nil
+	})
+	// finalized proposals are indexed by timeout for pruning
+	k.finalizedProposalIndex = orm.NewIndex(proposalTableBuilder, ProposalBaseFinalizedByTimeoutPrefix, func(value interface{}) ([]orm.RowID, error) {
+		base := value.(ProposalI).GetBase()
+		if !base.finalized() {
+			return nil, nil
+		}
+		timeout, err := types.TimestampFromProto(&base.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		return []orm.RowID{sdk.FormatTimeBytes(timeout)}, nil
+	})
 	k.proposalTable = proposalTableBuilder.Build()
 
 	//
 	// Vote Table
 	//
-	voteTableBuilder := orm.NewNaturalKeyTableBuilder(VoteTablePrefix, storeKey, &Vote{}, orm.Max255DynamicLengthIndexKeyCodec{})
-	k.voteByProposalBaseIndex = orm.NewUInt64Index(voteTableBuilder, VoteByProposalBaseIndexPrefix, func(value interface{}) ([]uint64, error) {
-		return []uint64{uint64(value.(*Vote).Proposal)}, nil
-	})
-	k.voteByVoterIndex = orm.NewIndex(voteTableBuilder, VoteByVoterIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
-		return []orm.RowID{value.(*Vote).Voter.Bytes()}, nil
-	})
-	k.voteTable = voteTableBuilder.Build()
+	k.voteTable = NewVoteTable(storeKey)
+
+	//
+	// Electorate Snapshot Tables
+	//
+	k.electorateSnapshotTable = NewElectorateSnapshotTable(storeKey)
+	k.electorateSnapshotMemberTable = NewElectorateSnapshotMemberTable(storeKey)
+
+	//
+	// Proposal Execution Table
+	//
+	k.proposalExecutionTable = NewProposalExecutionTable(storeKey)
 
 	return k
 }
@@ -182,6 +181,30 @@ func (k Keeper) MaxCommentSize(ctx sdk.Context) int {
 	return int(result)
 }
 
+// MaxVotingWindow returns the upper limit for the timeout of decision policies.
+func (k Keeper) MaxVotingWindow(ctx sdk.Context) time.Duration {
+	var result time.Duration
+	k.paramSpace.Get(ctx, ParamMaxVotingWindow, &result)
+	return result
+}
+
+// assertMaxVotingWindow ensures that the timeout of the decision policy does not exceed the chain wide
+// `MaxVotingWindow` parameter.
+func (k Keeper) assertMaxVotingWindow(ctx sdk.Context, policy DecisionPolicy) error {
+	if policy == nil {
+		return errors.Wrap(ErrEmpty, "decision policy")
+	}
+	timout := policy.GetTimout()
+	timeout, err := types.DurationFromProto(&timout)
+	if err != nil {
+		return errors.Wrap(err, "timeout")
+	}
+	if timeout > k.MaxVotingWindow(ctx) {
+		return errors.Wrap(ErrMaxLimit, "decision policy timeout exceeds max voting window")
+	}
+	return nil
+}
+
 func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members Members, comment string) (GroupID, error) {
 	if err := members.ValidateBasic(); err != nil {
 		return 0, err
@@ -201,20 +224,25 @@ func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members Membe
 		totalWeight = totalWeight.Add(m.Power)
 	}
 
-	groupID := GroupID(k.groupSeq.NextVal(ctx))
-	err := k.groupTable.Create(ctx, groupID.Bytes(), &GroupMetadata{
-		Group:       groupID,
-		Admin:       admin,
-		Comment:     comment,
-		Version:     1,
-		TotalWeight: totalWeight,
-	})
-	if err != nil {
+	// the group stores its own id which is the next value of the table sequence
+	groupID := GroupID(k.groupTable.Sequence().PeekNextVal(ctx))
+	group := GroupMetadata{
+		Group:             groupID,
+		Admin:             admin,
+		Comment:           comment,
+		Version:           1,
+		TotalWeight:       totalWeight,
+		MembershipVersion: 1,
+	}
+	if _, err := k.groupTable.Create(ctx, &group); err != nil {
 		return 0, errors.Wrap(err, "could not create group")
 	}
 
 	for i := range members {
 		m := members[i]
+		if err := k.assertNoMembershipCycle(ctx, groupID, m.Address); err != nil {
+			return 0, err
+		}
 		err := k.groupMemberTable.Create(ctx, &GroupMember{
 			Group:   groupID,
 			Member:  m.Address,
@@ -225,21 +253,113 @@ func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members Membe
 			return 0, errors.Wrapf(err, "could not store member %d", i)
 		}
 	}
+	ctx.EventManager().EmitEvent(newGroupEvent(EventTypeCreateGroup, group))
 	return groupID, nil
 }
 
 func (k Keeper) GetGroup(ctx sdk.Context, id GroupID) (GroupMetadata, error) {
-	var obj GroupMetadata
-	return obj, k.groupTable.GetOne(ctx, id.Bytes(), &obj)
+	return k.groupTable.Get(ctx, uint64(id))
 }
 
 func (k Keeper) HasGroup(ctx sdk.Context, rowID orm.RowID) bool {
-	return k.groupTable.Has(ctx, rowID)
+	if len(rowID) != orm.EncodedSeqLength {
+		return false
+	}
+	return k.groupTable.Has(ctx, orm.DecodeSequence(rowID))
 }
 
+// UpdateGroup persists a metadata change of the group and increments the metadata version. Running proposals are not
+// affected.
 func (k Keeper) UpdateGroup(ctx sdk.Context, g *GroupMetadata) error {
 	g.Version++
-	return k.groupTable.Save(ctx, g.Group.Bytes(), g)
+	return k.groupTable.Save(ctx, uint64(g.Group), g)
+}
+
+// UpdateGroupMembership persists a membership change of the group and increments the membership version. Running
+// proposals without an electorate snapshot are invalidated by this.
+func (k Keeper) UpdateGroupMembership(ctx sdk.Context, g *GroupMetadata) error {
+	g.MembershipVersion++
+	return k.groupTable.Save(ctx, uint64(g.Group), g)
+}
+
+// assertNoMembershipCycle ensures that adding the member to the group does not create a cycle of nested groups. A
+// member that is a group account is resolved to the group behind it, and the members of that group are followed in
+// the same way. It is a cycle when the given group is reached.
+func (k Keeper) assertNoMembershipCycle(ctx sdk.Context, id GroupID, member sdk.AccAddress) error {
+	account, err := k.groupAccountTable.Get(ctx, member.Bytes())
+	switch {
+	case orm.ErrNotFound.Is(err):
+		return nil
+	case err != nil:
+		return errors.Wrap(err, "load group account")
+	}
+	switch reachable, err := k.isGroupReachable(ctx, account.Base.Group, id); {
+	case err != nil:
+		return err
+	case reachable:
+		return errors.Wrapf(ErrInvalid, "member %s would create a group membership cycle", member)
+	}
+	return nil
+}
+
+// assertNoGroupAccountCycle ensures that a new group account of the group does not create a cycle of nested groups.
+// Account addresses are derived from a sequence so that the address can already be a member of a group before the
+// account exists. It is a cycle when such a group can be reached from the group of the account.
+func (k Keeper) assertNoGroupAccountCycle(ctx sdk.Context, id GroupID, accountAddr sdk.AccAddress) error {
+	it, err := k.GetGroupMembershipsByMember(ctx, accountAddr)
+	if err != nil {
+		return errors.Wrap(err, "get group memberships")
+	}
+	var memberships []GroupMember
+	if _, err := orm.ReadAll(it, &memberships); err != nil {
+		return errors.Wrap(err, "read group memberships")
+	}
+	for _, m := range memberships {
+		switch reachable, err := k.isGroupReachable(ctx, id, m.Group); {
+		case err != nil:
+			return err
+		case reachable:
+			return errors.Wrapf(ErrInvalid, "group account %s would create a group membership cycle", accountAddr)
+		}
+	}
+	return nil
+}
+
+// isGroupReachable returns true when the target group is the start group or can be reached from it by following
+// members that are group accounts to the group behind them.
+func (k Keeper) isGroupReachable(ctx sdk.Context, start, target GroupID) (bool, error) {
+	visited := make(map[GroupID]bool)
+	pending := []GroupID{start}
+	for len(pending) != 0 {
+		id := pending[0]
+		pending = pending[1:]
+		if id == target {
+			return true, nil
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		it, err := k.GetGroupMembersByGroup(ctx, id)
+		if err != nil {
+			return false, errors.Wrap(err, "get group members")
+		}
+		var members []GroupMember
+		if _, err := orm.ReadAll(it, &members); err != nil {
+			return false, errors.Wrap(err, "read group members")
+		}
+		for _, m := range members {
+			account, err := k.groupAccountTable.Get(ctx, m.Member.Bytes())
+			switch {
+			case orm.ErrNotFound.Is(err):
+				continue
+			case err != nil:
+				return false, errors.Wrap(err, "load group account")
+			}
+			pending = append(pending, account.Base.Group)
+		}
+	}
+	return false, nil
 }
 
 func (k Keeper) GetParams(ctx sdk.Context) Params {
@@ -252,9 +372,35 @@ func (k Keeper) setParams(ctx sdk.Context, params Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// CreateGroupAccount creates and persists a `StdGroupAccountMetadata`
-//func (k Keeper) CreateGroupAccount(ctx sdk.Context, admin sdk.AccAddress, groupID GroupID, policy DecisionPolicy, comment string) (sdk.AccAddress, error) {
-func (k Keeper) CreateGroupAccount(ctx sdk.Context, admin sdk.AccAddress, groupID GroupID, policy ThresholdDecisionPolicy, comment string) (sdk.AccAddress, error) {
+// GroupAccountOption sets an optional attribute on a new group account.
+type GroupAccountOption func(*GroupAccountMetadataBase)
+
+// WithAutoExec enables or disables the automatic execution of accepted proposals in the EndBlocker. Proposals are
+// executed with the first block on or after their timeout.
+func WithAutoExec(autoExec bool) GroupAccountOption {
+	return func(b *GroupAccountMetadataBase) {
+		b.AutoExec = autoExec
+	}
+}
+
+// WithElectorateSnapshot enables or disables the electorate snapshot for new proposals of the group account.
+func WithElectorateSnapshot(snapshot bool) GroupAccountOption {
+	return func(b *GroupAccountMetadataBase) {
+		b.SnapshotElectorate = snapshot
+	}
+}
+
+// WithMsgTypes restricts the msgs of proposals to the allowed types, when not empty, and rejects the denied types.
+func WithMsgTypes(allowed, denied []MsgType) GroupAccountOption {
+	return func(b *GroupAccountMetadataBase) {
+		b.AllowedMsgTypes = allowed
+		b.DeniedMsgTypes = denied
+	}
+}
+
+// CreateGroupAccount creates and persists a `StdGroupAccountMetadata`. The decision policy must be one of the
+// `StdDecisionPolicy` types.
+func (k Keeper) CreateGroupAccount(ctx sdk.Context, admin sdk.AccAddress, groupID GroupID, policy DecisionPolicy, comment string, opts ...GroupAccountOption) (sdk.AccAddress, error) {
 	maxCommentSize := k.MaxCommentSize(ctx)
 	if len(comment) > maxCommentSize {
 		return nil, errors.Wrap(ErrMaxLimit,
@@ -268,7 +414,17 @@ func (k Keeper) CreateGroupAccount(ctx sdk.Context, admin sdk.AccAddress, groupI
 	if !g.Admin.Equals(admin) {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "not group admin")
 	}
+	if err := k.assertMaxVotingWindow(ctx, policy); err != nil {
+		return nil, err
+	}
+	var stdPolicy StdDecisionPolicy
+	if err := stdPolicy.SetDecisionPolicy(policy); err != nil {
+		return nil, errors.Wrap(ErrType, err.Error())
+	}
 	accountAddr := AccountCondition(k.groupAccountSeq.NextVal(ctx)).Address()
+	if err := k.assertNoGroupAccountCycle(ctx, groupID, accountAddr); err != nil {
+		return nil, err
+	}
 	groupAccount := StdGroupAccountMetadata{
 		Base: GroupAccountMetadataBase{
 			GroupAccount: accountAddr,
@@ -277,11 +433,15 @@ func (k Keeper) CreateGroupAccount(ctx sdk.Context, admin sdk.AccAddress, groupI
 			Comment:      comment,
 			Version:      1,
 		},
-		DecisionPolicy: StdDecisionPolicy{Sum: &StdDecisionPolicy_Threshold{&policy}},
+		DecisionPolicy: stdPolicy,
+	}
+	for _, opt := range opts {
+		opt(&groupAccount.Base)
 	}
 	if err := k.groupAccountTable.Create(ctx, &groupAccount); err != nil {
 		return nil, errors.Wrap(err, "could not create group account")
 	}
+	ctx.EventManager().EmitEvent(newGroupAccountEvent(EventTypeCreateGroupAccount, groupAccount.Base))
 	return accountAddr, nil
 }
 
@@ -290,8 +450,7 @@ func (k Keeper) HasGroupAccount(ctx sdk.Context, address sdk.AccAddress) bool {
 }
 
 func (k Keeper) GetGroupAccount(ctx sdk.Context, accountAddress sdk.AccAddress) (StdGroupAccountMetadata, error) {
-	var obj StdGroupAccountMetadata
-	return obj, k.groupAccountTable.GetOne(ctx, accountAddress.Bytes(), &obj)
+	return k.groupAccountTable.Get(ctx, accountAddress.Bytes())
 }
 
 func (k Keeper) UpdateGroupAccount(ctx sdk.Context, obj *StdGroupAccountMetadata) error {
@@ -308,7 +467,27 @@ func (k Keeper) GetGroupByGroupAccount(ctx sdk.Context, accountAddress sdk.AccAd
 }
 
 func (k Keeper) GetGroupMembersByGroup(ctx sdk.Context, id GroupID) (orm.Iterator, error) {
-	return k.groupMemberByGroupIndex.Get(ctx, id.Uint64())
+	return k.groupMemberTable.GetByGroup(ctx, id)
+}
+
+// GetGroupsByAdmin returns an iterator over all `GroupMetadata` entries with the given admin.
+func (k Keeper) GetGroupsByAdmin(ctx sdk.Context, admin sdk.AccAddress) (orm.Iterator, error) {
+	return k.groupTable.GetByAdmin(ctx, admin)
+}
+
+// GetGroupMembershipsByMember returns an iterator over all `GroupMember` entries of the given address.
+func (k Keeper) GetGroupMembershipsByMember(ctx sdk.Context, member sdk.AccAddress) (orm.Iterator, error) {
+	return k.groupMemberTable.GetByMember(ctx, member)
+}
+
+// GetGroupAccountsByGroup returns an iterator over all `StdGroupAccountMetadata` entries of the given group.
+func (k Keeper) GetGroupAccountsByGroup(ctx sdk.Context, id GroupID) (orm.Iterator, error) {
+	return k.groupAccountTable.GetByGroup(ctx, id)
+}
+
+// GetGroupAccountsByAdmin returns an iterator over all `StdGroupAccountMetadata` entries with the given admin.
+func (k Keeper) GetGroupAccountsByAdmin(ctx sdk.Context, admin sdk.AccAddress) (orm.Iterator, error) {
+	return k.groupAccountTable.GetByAdmin(ctx, admin)
 }
 
 func (k Keeper) Vote(ctx sdk.Context, id ProposalID, voters []sdk.AccAddress, choice Choice, comment string) error {
@@ -339,26 +518,23 @@ func (k Keeper) Vote(ctx sdk.Context, id ProposalID, voters []sdk.AccAddress, ch
 	if votingPeriodEnd.Before(ctx.BlockTime()) || votingPeriodEnd.Equal(ctx.BlockTime()) {
 		return errors.Wrap(ErrExpired, "voting period has ended already")
 	}
-	var accountMetadata StdGroupAccountMetadata
-	if err := k.groupAccountTable.GetOne(ctx, base.GroupAccount.Bytes(), &accountMetadata); err != nil {
+	accountMetadata, err := k.groupAccountTable.Get(ctx, base.GroupAccount.Bytes())
+	if err != nil {
 		return errors.Wrap(err, "load group account")
 	}
 	if base.GroupAccountVersion != accountMetadata.Base.Version {
 		return errors.Wrap(ErrModified, "group account was modified")
 	}
 
-	electorate, err := k.GetGroup(ctx, accountMetadata.Base.Group)
+	electorate, err := k.loadElectorate(ctx, id, base, accountMetadata.Base.Group)
 	if err != nil {
 		return err
 	}
-	if electorate.Version != base.GroupVersion {
-		return errors.Wrap(ErrModified, "group was modified")
-	}
 
 	// count and store votes
 	for _, voterAddr := range voters {
-		voter := GroupMember{Group: electorate.Group, Member: voterAddr}
-		if err := k.groupMemberTable.GetOne(ctx, voter.NaturalKey(), &voter); err != nil {
+		weight, err := k.memberWeight(ctx, electorate, voterAddr)
+		if err != nil {
 			return errors.Wrapf(err, "address: %s", voterAddr)
 		}
 		newVote := Vote{
@@ -368,34 +544,62 @@ func (k Keeper) Vote(ctx sdk.Context, id ProposalID, voters []sdk.AccAddress, ch
 			Comment:     comment,
 			SubmittedAt: *blockTime,
 		}
-		if err := base.VoteState.Add(newVote, voter.Weight); err != nil {
+		if err := base.VoteState.Add(newVote, weight); err != nil {
 			return errors.Wrap(err, "add new vote")
 		}
 
-		if err := k.voteTable.Create(ctx, &newVote); err != nil {
-			return errors.Wrap(err, "store vote")
+		// a previous vote is replaced and removed from the tally
+		switch oldVote, err := k.voteTable.Get(ctx, newVote.NaturalKey()); {
+		case orm.ErrNotFound.Is(err):
+			if err := k.voteTable.Create(ctx, &newVote); err != nil {
+				return errors.Wrap(err, "store vote")
+			}
+		case err != nil:
+			return errors.Wrap(err, "load old vote")
+		default:
+			if err := base.VoteState.Sub(oldVote, weight); err != nil {
+				return errors.Wrap(err, "sub old vote")
+			}
+			if err := k.voteTable.Save(ctx, &newVote); err != nil {
+				return errors.Wrap(err, "store vote")
+			}
 		}
+		ctx.EventManager().EmitEvent(newProposalEvent(EventTypeVote, id, base,
+			sdk.NewAttribute(AttributeKeyVoter, voterAddr.String()),
+			sdk.NewAttribute(AttributeKeyChoice, choice.String()),
+		))
 	}
 
 	// run tally with new votes to close early
-	if err := doTally(ctx, &base, electorate, accountMetadata); err != nil {
+	if err := doTally(ctx, &base, electorate.totalWeight, accountMetadata); err != nil {
 		return err
 	}
+	emitProposalFinalized(ctx, id, ProposalStatusSubmitted, base)
 
 	proposal.SetBase(base)
 	return k.proposalTable.Save(ctx, id.Uint64(), proposal)
 }
 
-func doTally(ctx sdk.Context, base *ProposalBase, electorate GroupMetadata, accountMetadata StdGroupAccountMetadata) error {
-	policy := accountMetadata.DecisionPolicy.GetThreshold()
+func doTally(ctx sdk.Context, base *ProposalBase, totalWeight sdk.Dec, accountMetadata StdGroupAccountMetadata) error {
+	policy := accountMetadata.DecisionPolicy.GetDecisionPolicy()
 	submittedAt, err := types.TimestampFromProto(&base.SubmittedAt)
 	if err != nil {
 		return err
 	}
-	switch result, err := policy.Allow(base.VoteState, electorate.TotalWeight, ctx.BlockTime().Sub(submittedAt)); {
+	minExecutionPeriod := policy.GetMinExecutionPeriod()
+	minPeriod, err := types.DurationFromProto(&minExecutionPeriod)
+	if err != nil {
+		return err
+	}
+	votingDuration := ctx.BlockTime().Sub(submittedAt)
+	switch result, err := policy.Allow(base.VoteState, totalWeight, votingDuration); {
 	case err != nil:
 		return errors.Wrap(err, "policy execution")
 	case result == DecisionPolicyResult{Allow: true, Final: true}:
+		// accepted proposals stay open until the min execution period has passed
+		if votingDuration < minPeriod {
+			return nil
+		}
 		base.Result = ProposalResultAccepted
 		base.Status = ProposalStatusClosed
 	case result == DecisionPolicyResult{Allow: false, Final: true}:
@@ -405,29 +609,182 @@ func doTally(ctx sdk.Context, base *ProposalBase, electorate GroupMetadata, acco
 	return nil
 }
 
+// electorate is the set of weighted members that decides on a proposal. It is either the current group or the
+// snapshot that was taken on proposal submission.
+type electorate struct {
+	group       GroupID
+	proposal    ProposalID
+	snapshot    bool
+	totalWeight sdk.Dec
+}
+
+// loadElectorate returns the electorate of the proposal. Without a snapshot, ErrModified is returned when the group
+// was modified after the proposal submission.
+func (k Keeper) loadElectorate(ctx sdk.Context, id ProposalID, base ProposalBase, groupID GroupID) (electorate, error) {
+	if base.SnapshotElectorate {
+		s, err := k.GetElectorateSnapshot(ctx, id)
+		if err != nil {
+			return electorate{}, errors.Wrap(err, "load electorate snapshot")
+		}
+		return electorate{group: groupID, proposal: id, snapshot: true, totalWeight: s.TotalWeight}, nil
+	}
+	g, err := k.GetGroup(ctx, groupID)
+	if err != nil {
+		return electorate{}, errors.Wrap(err, "load group")
+	}
+	if g.MembershipVersion != base.GroupVersion {
+		return electorate{}, errors.Wrap(ErrModified, "group was modified")
+	}
+	return electorate{group: groupID, proposal: id, totalWeight: g.TotalWeight}, nil
+}
+
+// memberWeight returns the weight of the member within the electorate. ErrNotFound is returned for non members.
+func (k Keeper) memberWeight(ctx sdk.Context, e electorate, member sdk.AccAddress) (sdk.Dec, error) {
+	if e.snapshot {
+		m, err := k.electorateSnapshotMemberTable.Get(ctx, ElectorateSnapshotMember{Proposal: e.proposal, Member: member}.NaturalKey())
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return m.Weight, nil
+	}
+	m, err := k.groupMemberTable.Get(ctx, GroupMember{Group: e.group, Member: member}.NaturalKey())
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return m.Weight, nil
+}
+
+// snapshotElectorate persists the current member weights and total weight of the group for the proposal.
+func (k Keeper) snapshotElectorate(ctx sdk.Context, id ProposalID, g GroupMetadata) error {
+	it, err := k.GetGroupMembersByGroup(ctx, g.Group)
+	if err != nil {
+		return errors.Wrap(err, "get group members")
+	}
+	var members []GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return errors.Wrap(err, "read group members")
+	}
+	for _, m := range members {
+		if err := k.electorateSnapshotMemberTable.Create(ctx, &ElectorateSnapshotMember{
+			Proposal: id,
+			Member:   m.Member,
+			Weight:   m.Weight,
+		}); err != nil {
+			return errors.Wrapf(err, "member %s", m.Member)
+		}
+	}
+	return k.electorateSnapshotTable.Create(ctx, &ElectorateSnapshot{Proposal: id, TotalWeight: g.TotalWeight})
+}
+
+// deleteElectorateSnapshot removes the snapshot and all snapshot members of the proposal.
+func (k Keeper) deleteElectorateSnapshot(ctx sdk.Context, id ProposalID) error {
+	members, err := k.GetElectorateSnapshotMembers(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "electorate snapshot members")
+	}
+	for i := range members {
+		if err := k.electorateSnapshotMemberTable.Delete(ctx, &members[i]); err != nil {
+			return errors.Wrap(err, "delete electorate snapshot member")
+		}
+	}
+	if err := k.electorateSnapshotTable.Delete(ctx, &ElectorateSnapshot{Proposal: id}); err != nil {
+		return errors.Wrap(err, "delete electorate snapshot")
+	}
+	return nil
+}
+
+// GetElectorateSnapshot returns the total weight of the group at the submission of the proposal.
+func (k Keeper) GetElectorateSnapshot(ctx sdk.Context, id ProposalID) (ElectorateSnapshot, error) {
+	return k.electorateSnapshotTable.Get(ctx, ElectorateSnapshot{Proposal: id}.NaturalKey())
+}
+
+// GetElectorateSnapshotMembers returns the member weights of the group at the submission of the proposal.
+func (k Keeper) GetElectorateSnapshotMembers(ctx sdk.Context, id ProposalID) ([]ElectorateSnapshotMember, error) {
+	it, err := k.electorateSnapshotMemberTable.GetByProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := it.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// WithdrawProposal sets the status of a submitted proposal to withdrawn. The signer must be one of the proposers
+// or the admin of the group account. Withdrawn proposals do not accept any votes or execution anymore.
+func (k Keeper) WithdrawProposal(ctx sdk.Context, id ProposalID, signer sdk.AccAddress) error {
+	proposal, err := k.GetProposal(ctx, id)
+	if err != nil {
+		return err
+	}
+	base := proposal.GetBase()
+	if base.Status != ProposalStatusSubmitted {
+		return errors.Wrapf(ErrInvalid, "not possible with proposal status %s", base.Status.String())
+	}
+	timeout, err := types.TimestampFromProto(&base.Timeout)
+	if err != nil {
+		return err
+	}
+	if !ctx.BlockTime().Before(timeout) {
+		return errors.Wrap(ErrExpired, "voting period has ended already")
+	}
+
+	accountMetadata, err := k.groupAccountTable.Get(ctx, base.GroupAccount.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "load group account")
+	}
+	authorized := accountMetadata.Base.Admin.Equals(signer)
+	for _, p := range base.Proposers {
+		if p.Equals(signer) {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		return errors.Wrap(ErrUnauthorized, "not a proposer or group account admin")
+	}
+
+	base.Status = ProposalStatusWithdrawn
+	ctx.EventManager().EmitEvent(newProposalEvent(EventTypeWithdrawProposal, id, base,
+		sdk.NewAttribute(AttributeKeyStatus, base.Status.String()),
+	))
+	proposal.SetBase(base)
+	return k.proposalTable.Save(ctx, id.Uint64(), proposal)
+}
+
 // ExecProposal can be executed n times before the timeout. It will update the proposal status and executes the msg payload.
 // There are no separate transactions for the payload messages so that it is a full atomic operation that
 // would either succeed or fail.
 func (k Keeper) ExecProposal(ctx sdk.Context, id ProposalID) error {
+	_, err := k.execProposal(ctx, id)
+	return err
+}
+
+// execProposal implements ExecProposal and returns the execution record when the proposal payload was executed.
+func (k Keeper) execProposal(ctx sdk.Context, id ProposalID) (*ProposalExecution, error) {
 	proposal, err := k.GetProposal(ctx, id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// check constraints
 	base := proposal.GetBase()
 
 	if base.Status != ProposalStatusSubmitted && base.Status != ProposalStatusClosed {
-		return errors.Wrapf(ErrInvalid, "not possible with proposal status %s", base.Status.String())
+		return nil, errors.Wrapf(ErrInvalid, "not possible with proposal status %s", base.Status.String())
 	}
 
-	var accountMetadata StdGroupAccountMetadata
-	if err := k.groupAccountTable.GetOne(ctx, base.GroupAccount.Bytes(), &accountMetadata); err != nil {
-		return errors.Wrap(err, "load group account")
+	accountMetadata, err := k.groupAccountTable.Get(ctx, base.GroupAccount.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "load group account")
 	}
 
-	storeUpdates := func() error {
+	oldStatus := base.Status
+	var execution *ProposalExecution
+	storeUpdates := func() (*ProposalExecution, error) {
+		emitProposalFinalized(ctx, id, oldStatus, base)
 		proposal.SetBase(base)
-		return k.proposalTable.Save(ctx, id.Uint64(), proposal)
+		return execution, k.proposalTable.Save(ctx, id.Uint64(), proposal)
 	}
 
 	if base.Status == ProposalStatusSubmitted {
@@ -437,40 +794,86 @@ func (k Keeper) ExecProposal(ctx sdk.Context, id ProposalID) error {
 			return storeUpdates()
 		}
 
-		electorate, err := k.GetGroup(ctx, accountMetadata.Base.Group)
-		if err != nil {
-			return errors.Wrap(err, "load group")
-		}
-
-		if electorate.Version != base.GroupVersion {
+		electorate, err := k.loadElectorate(ctx, id, base, accountMetadata.Base.Group)
+		switch {
+		case ErrModified.Is(err):
 			base.Result = ProposalResultUndefined
 			base.Status = ProposalStatusAborted
 			return storeUpdates()
+		case err != nil:
+			return nil, err
 		}
-		if err := doTally(ctx, &base, electorate, accountMetadata); err != nil {
-			return err
+		if err := doTally(ctx, &base, electorate.totalWeight, accountMetadata); err != nil {
+			return nil, err
 		}
 	}
 
 	// execute proposal payload
 	if base.Status == ProposalStatusClosed && base.Result == ProposalResultAccepted && base.ExecutorResult != ProposalExecutorResultSuccess {
-		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
-		ctx, flush := ctx.CacheContext()
-		_, err := doExecuteMsgs(ctx, k.router, accountMetadata.Base.GroupAccount, proposal.GetMsgs())
-		if err != nil {
-			base.ExecutorResult = ProposalExecutorResultFailure
-			proposalType := reflect.TypeOf(proposal).String()
-			logger.Info("proposal execution failed", "cause", err, "type", proposalType, "proposalID", id)
-		} else {
-			base.ExecutorResult = ProposalExecutorResultSuccess
-			flush()
+		e := k.executeProposalMsgs(ctx, id, proposal, &base, accountMetadata.Base)
+		if err := k.saveProposalExecution(ctx, &e); err != nil {
+			return nil, err
 		}
+		execution = &e
 	}
 	return storeUpdates()
 }
 
+// executeProposalMsgs runs the proposal payload in a cached context and sets the executor result. State changes are
+// only persisted on success. The returned execution record contains the msg results or the failure reason.
+func (k Keeper) executeProposalMsgs(ctx sdk.Context, id ProposalID, proposal ProposalI, base *ProposalBase, account GroupAccountMetadataBase) ProposalExecution {
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+	execution := ProposalExecution{Proposal: id}
+	cacheCtx, flush := ctx.CacheContext()
+	results, err := doExecuteMsgs(cacheCtx, k.router, account, proposal.GetMsgs())
+	if err != nil {
+		base.ExecutorResult = ProposalExecutorResultFailure
+		execution.FailedMsgIndex = -1
+		if msgErr, ok := err.(msgExecutionError); ok {
+			execution.FailedMsgIndex = int32(msgErr.index)
+		}
+		execution.FailureReason = err.Error()
+		proposalType := reflect.TypeOf(proposal).String()
+		logger.Info("proposal execution failed", "cause", err, "type", proposalType, "proposalID", id)
+	} else {
+		base.ExecutorResult = ProposalExecutorResultSuccess
+		execution.MsgResults = newMsgExecutionResults(results)
+		flush()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	execution.ExecutorResult = base.ExecutorResult
+	emitProposalExecuted(ctx, id, *base)
+	return execution
+}
+
+// saveProposalExecution stores the execution record of a proposal with the block time. A previous record of a failed
+// execution is replaced.
+func (k Keeper) saveProposalExecution(ctx sdk.Context, execution *ProposalExecution) error {
+	blockTime, err := types.TimestampProto(ctx.BlockTime())
+	if err != nil {
+		return errors.Wrap(err, "block time conversion")
+	}
+	execution.ExecutedAt = *blockTime
+	if k.proposalExecutionTable.Contains(ctx, execution) {
+		return errors.Wrap(k.proposalExecutionTable.Save(ctx, execution), "proposal execution")
+	}
+	return errors.Wrap(k.proposalExecutionTable.Create(ctx, execution), "proposal execution")
+}
+
+// GetProposalExecution returns the record of the last execution of the proposal payload.
+func (k Keeper) GetProposalExecution(ctx sdk.Context, id ProposalID) (ProposalExecution, error) {
+	return k.proposalExecutionTable.Get(ctx, ProposalExecution{Proposal: id}.NaturalKey())
+}
+
+// emitProposalExecuted emits an event with the executor result of the proposal.
+func emitProposalExecuted(ctx sdk.Context, id ProposalID, base ProposalBase) {
+	ctx.EventManager().EmitEvent(newProposalEvent(EventTypeExecProposal, id, base,
+		sdk.NewAttribute(AttributeKeyExecutorResult, base.ExecutorResult.String()),
+	))
+}
+
 func (k Keeper) GetProposal(ctx sdk.Context, id ProposalID) (ProposalI, error) {
-	loaded := reflect.New(k.proposalModelType).Interface().(ProposalI)
+	loaded := k.newProposalModel()
 	if _, err := k.proposalTable.GetOne(ctx, id.Uint64(), loaded); err != nil {
 		return nil, errors.Wrap(err, "load proposal")
 	}
@@ -503,13 +906,17 @@ func (k Keeper) CreateProposal(ctx sdk.Context, accountAddress sdk.AccAddress, c
 	if err := ensureMsgAuthZ(msgs, account.Base.GroupAccount); err != nil {
 		return 0, err
 	}
+	if err := ensureMsgTypesPermitted(msgs, account.Base); err != nil {
+		return 0, err
+	}
 
 	blockTime, err := types.TimestampProto(ctx.BlockTime())
 	if err != nil {
 		return 0, errors.Wrap(err, "block time conversion")
 	}
 	policy := account.GetDecisionPolicy()
-	window, err := types.DurationFromProto(&policy.GetThreshold().Timout)
+	timeout := policy.GetDecisionPolicy().GetTimout()
+	window, err := types.DurationFromProto(&timeout)
 	if err != nil {
 		return 0, errors.Wrap(err, "maxVotingWindow time conversion")
 	}
@@ -523,18 +930,20 @@ func (k Keeper) CreateProposal(ctx sdk.Context, accountAddress sdk.AccAddress, c
 		return 0, errors.Wrap(ErrInvalid, "policy threshold should not be greater than the total group weight")
 	}
 
-	m := reflect.New(k.proposalModelType).Interface().(ProposalI)
+	m := k.newProposalModel()
 	m.SetBase(ProposalBase{
 		GroupAccount:        accountAddress,
 		Comment:             comment,
 		Proposers:           proposers,
 		SubmittedAt:         *blockTime,
-		GroupVersion:        g.Version,
+		GroupVersion:        g.MembershipVersion,
 		GroupAccountVersion: account.Base.Version,
 		Result:              ProposalResultUndefined,
 		Status:              ProposalStatusSubmitted,
 		ExecutorResult:      ProposalExecutorResultNotRun,
 		Timeout:             *endTime,
+		AutoExec:            account.Base.AutoExec,
+		SnapshotElectorate:  account.Base.SnapshotElectorate,
 		VoteState: Tally{
 			YesCount:     sdk.ZeroDec(),
 			NoCount:      sdk.ZeroDec(),
@@ -550,10 +959,225 @@ func (k Keeper) CreateProposal(ctx sdk.Context, accountAddress sdk.AccAddress, c
 	if err != nil {
 		return 0, errors.Wrap(err, "create proposal")
 	}
+	if account.Base.SnapshotElectorate {
+		if err := k.snapshotElectorate(ctx, ProposalID(id), g); err != nil {
+			return 0, errors.Wrap(err, "electorate snapshot")
+		}
+	}
+	ctx.EventManager().EmitEvent(newProposalEvent(EventTypeSubmitProposal, ProposalID(id), m.GetBase()))
 	return ProposalID(id), nil
 }
 
 func (k Keeper) GetVote(ctx sdk.Context, id ProposalID, voter sdk.AccAddress) (Vote, error) {
-	var v Vote
-	return v, k.voteTable.GetOne(ctx, Vote{Proposal: id, Voter: voter}.NaturalKey(), &v)
+	return k.voteTable.Get(ctx, Vote{Proposal: id, Voter: voter}.NaturalKey())
+}
+
+// GetProposalsByGroupAccount returns an iterator over all proposals of the given group account. The proposals are
+// loaded into the app specific proposal type.
+func (k Keeper) GetProposalsByGroupAccount(ctx sdk.Context, accountAddress sdk.AccAddress) (orm.Iterator, error) {
+	return k.ProposalGroupAccountIndex.Get(ctx, accountAddress.Bytes())
+}
+
+// GetProposalsByProposer returns an iterator over all proposals with the given address in their proposers. The
+// proposals are loaded into the app specific proposal type.
+func (k Keeper) GetProposalsByProposer(ctx sdk.Context, proposer sdk.AccAddress) (orm.Iterator, error) {
+	return k.ProposalByProposerIndex.Get(ctx, proposer.Bytes())
+}
+
+// GetVotesByProposal returns an iterator over all `Vote` entries of the given proposal.
+func (k Keeper) GetVotesByProposal(ctx sdk.Context, id ProposalID) (orm.Iterator, error) {
+	return k.voteTable.GetByProposal(ctx, id)
+}
+
+// GetVotesByVoter returns an iterator over all `Vote` entries of the given voter.
+func (k Keeper) GetVotesByVoter(ctx sdk.Context, voter sdk.AccAddress) (orm.Iterator, error) {
+	return k.voteTable.GetByVoter(ctx, voter)
+}
+
+// newProposalModel returns a new empty instance of the configured proposal type.
+func (k Keeper) newProposalModel() ProposalI {
+	return reflect.New(k.proposalModelType).Interface().(ProposalI)
+}
+
+// ProcessExpiredProposals runs the final tally for all submitted proposals with a timeout before or equal to the
+// current block time. Accepted proposals with auto exec enabled are executed with the `MaxAutoExecGas` limit.
+// Not more than `MaxEndBlockProposals` are processed per call. Any remaining are handled in the next blocks.
+// Each proposal is processed in a cached context. When it fails, the changes of this proposal are discarded and
+// the error is logged so that it does not halt the chain. The proposal is retried in the next block.
+func (k Keeper) ProcessExpiredProposals(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	end := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime()))
+	it, err := k.proposalByTimeoutIndex.PrefixScan(ctx, nil, end)
+	if err != nil {
+		return errors.Wrap(err, "expired proposals")
+	}
+	limitedIt := orm.LimitIterator(it, int(params.MaxEndBlockProposals))
+
+	// collect ids first as no writes may happen while the iterator is open
+	var ids []ProposalID
+	for {
+		rowID, err := limitedIt.LoadNext(k.newProposalModel())
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			limitedIt.Close()
+			return errors.Wrap(err, "load expired proposal")
+		}
+		ids = append(ids, ProposalID(orm.DecodeSequence(rowID)))
+	}
+	limitedIt.Close()
+
+	for _, id := range ids {
+		cacheCtx, flush := ctx.CacheContext()
+		if err := k.processExpiredProposal(cacheCtx, id, params.MaxAutoExecGas); err != nil {
+			logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+			logger.Error("processing expired proposal failed", "cause", err, "proposalID", id)
+			continue
+		}
+		flush()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	return nil
+}
+
+func (k Keeper) processExpiredProposal(ctx sdk.Context, id ProposalID, maxGas uint64) error {
+	proposal, err := k.GetProposal(ctx, id)
+	if err != nil {
+		return err
+	}
+	base := proposal.GetBase()
+
+	accountMetadata, err := k.groupAccountTable.Get(ctx, base.GroupAccount.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "load group account")
+	}
+
+	oldStatus := base.Status
+	if base.Status == ProposalStatusSubmitted {
+		electorate, err := k.loadElectorate(ctx, id, base, accountMetadata.Base.Group)
+		switch {
+		case base.GroupAccountVersion != accountMetadata.Base.Version || ErrModified.Is(err):
+			base.Result = ProposalResultUndefined
+			base.Status = ProposalStatusAborted
+		case err != nil:
+			return err
+		default:
+			if err := doTally(ctx, &base, electorate.totalWeight, accountMetadata); err != nil {
+				return err
+			}
+		}
+		// the voting period has ended so that a non final result can not be accepted anymore
+		if base.Status == ProposalStatusSubmitted {
+			base.Result = ProposalResultRejected
+			base.Status = ProposalStatusClosed
+		}
+	}
+	emitProposalFinalized(ctx, id, oldStatus, base)
+
+	if base.pendingEndBlock() {
+		execution := k.executeProposalMsgsWithGasLimit(ctx, id, proposal, &base, accountMetadata.Base, maxGas)
+		if err := k.saveProposalExecution(ctx, &execution); err != nil {
+			return err
+		}
+	}
+	proposal.SetBase(base)
+	return k.proposalTable.Save(ctx, id.Uint64(), proposal)
+}
+
+// executeProposalMsgsWithGasLimit runs the proposal payload with a new gas meter. Running out of gas or any other
+// panic of a msg handler is handled as execution failure. The payload changes are discarded then.
+func (k Keeper) executeProposalMsgsWithGasLimit(ctx sdk.Context, id ProposalID, proposal ProposalI, base *ProposalBase, account GroupAccountMetadataBase, maxGas uint64) (execution ProposalExecution) {
+	defer func() {
+		if r := recover(); r != nil {
+			reason := fmt.Sprintf("panic: %v", r)
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				reason = fmt.Sprintf("out of gas with limit %d", maxGas)
+			}
+			base.ExecutorResult = ProposalExecutorResultFailure
+			logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", ModuleName))
+			logger.Info("proposal execution failed", "cause", reason, "proposalID", id)
+			emitProposalExecuted(ctx, id, *base)
+			execution = ProposalExecution{
+				Proposal:       id,
+				ExecutorResult: ProposalExecutorResultFailure,
+				FailedMsgIndex: -1,
+				FailureReason:  reason,
+			}
+		}
+	}()
+	return k.executeProposalMsgs(ctx.WithGasMeter(sdk.NewGasMeter(maxGas)), id, proposal, base, account)
+}
+
+// PruneProposals deletes finalized proposals and their votes when the proposal timeout plus the `ProposalRetention`
+// period has passed. A `proposal_pruned` event is emitted for every deleted proposal. Not more than
+// `MaxEndBlockProposals` are pruned per call. A zero retention period disables pruning.
+func (k Keeper) PruneProposals(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.ProposalRetention == 0 {
+		return nil
+	}
+	end := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime().Add(-params.ProposalRetention)))
+	it, err := k.finalizedProposalIndex.PrefixScan(ctx, nil, end)
+	if err != nil {
+		return errors.Wrap(err, "finalized proposals")
+	}
+	limitedIt := orm.LimitIterator(it, int(params.MaxEndBlockProposals))
+
+	// collect ids first as no writes may happen while the iterator is open
+	var ids []ProposalID
+	for {
+		rowID, err := limitedIt.LoadNext(k.newProposalModel())
+		if orm.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			limitedIt.Close()
+			return errors.Wrap(err, "load finalized proposal")
+		}
+		ids = append(ids, ProposalID(orm.DecodeSequence(rowID)))
+	}
+	limitedIt.Close()
+
+	for _, id := range ids {
+		if err := k.pruneProposal(ctx, id); err != nil {
+			return errors.Wrapf(err, "proposal %d", id)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) pruneProposal(ctx sdk.Context, id ProposalID) error {
+	proposal, err := k.GetProposal(ctx, id)
+	if err != nil {
+		return err
+	}
+	it, err := k.voteTable.GetByProposal(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "votes by proposal")
+	}
+	votes, err := it.ReadAll()
+	if err != nil {
+		return errors.Wrap(err, "load votes")
+	}
+	for i := range votes {
+		if err := k.voteTable.Delete(ctx, &votes[i]); err != nil {
+			return errors.Wrap(err, "delete vote")
+		}
+	}
+	if proposal.GetBase().SnapshotElectorate {
+		if err := k.deleteElectorateSnapshot(ctx, id); err != nil {
+			return err
+		}
+	}
+	if k.proposalExecutionTable.Has(ctx, ProposalExecution{Proposal: id}.NaturalKey()) {
+		if err := k.proposalExecutionTable.Delete(ctx, &ProposalExecution{Proposal: id}); err != nil {
+			return errors.Wrap(err, "delete proposal execution")
+		}
+	}
+	if err := k.proposalTable.Delete(ctx, id.Uint64()); err != nil {
+		return errors.Wrap(err, "delete proposal")
+	}
+	ctx.EventManager().EmitEvent(newProposalEvent(EventTypeProposalPruned, id, proposal.GetBase()))
+	return nil
 }
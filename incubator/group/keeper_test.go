@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/cosmos/modules/incubator/group"
@@ -123,7 +124,7 @@ func TestCreateGroupAccount(t *testing.T) {
 	specs := map[string]struct {
 		srcAdmin   sdk.AccAddress
 		srcGroupID group.GroupID
-		srcPolicy  group.ThresholdDecisionPolicy
+		srcPolicy  group.DecisionPolicy
 		srcComment string
 		expErr     bool
 	}{
@@ -131,7 +132,7 @@ func TestCreateGroupAccount(t *testing.T) {
 			srcAdmin:   []byte("valid--admin-address"),
 			srcComment: "test",
 			srcGroupID: myGroupID,
-			srcPolicy: group.ThresholdDecisionPolicy{
+			srcPolicy: &group.ThresholdDecisionPolicy{
 				Threshold: sdk.OneDec(),
 				Timout:    types.Duration{Seconds: 1},
 			},
@@ -140,16 +141,56 @@ func TestCreateGroupAccount(t *testing.T) {
 			srcAdmin:   []byte("valid--admin-address"),
 			srcComment: "test",
 			srcGroupID: myGroupID,
-			srcPolicy: group.ThresholdDecisionPolicy{
+			srcPolicy: &group.ThresholdDecisionPolicy{
 				Threshold: sdk.NewDec(math.MaxInt64),
 				Timout:    types.Duration{Seconds: 1},
 			},
 		},
+		"percentage decision policy": {
+			srcAdmin:   []byte("valid--admin-address"),
+			srcComment: "test",
+			srcGroupID: myGroupID,
+			srcPolicy: &group.PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     types.Duration{Seconds: 1},
+			},
+		},
+		"quorum decision policy": {
+			srcAdmin:   []byte("valid--admin-address"),
+			srcComment: "test",
+			srcGroupID: myGroupID,
+			srcPolicy: &group.QuorumDecisionPolicy{
+				Quorum:        sdk.NewDecWithPrec(4, 1),
+				Threshold:     sdk.NewDecWithPrec(5, 1),
+				VetoThreshold: sdk.NewDecWithPrec(334, 3),
+				Timout:        types.Duration{Seconds: 1},
+			},
+		},
+		"invalid percentage decision policy": {
+			srcAdmin:   []byte("valid--admin-address"),
+			srcComment: "test",
+			srcGroupID: myGroupID,
+			srcPolicy: &group.PercentageDecisionPolicy{
+				Percentage: sdk.NewDec(2),
+				Timout:     types.Duration{Seconds: 1},
+			},
+			expErr: true,
+		},
+		"timeout exceeds max voting window": {
+			srcAdmin:   []byte("valid--admin-address"),
+			srcComment: "test",
+			srcGroupID: myGroupID,
+			srcPolicy: &group.ThresholdDecisionPolicy{
+				Threshold: sdk.OneDec(),
+				Timout:    *types.DurationProto(defaultParams.MaxVotingWindow + time.Nanosecond),
+			},
+			expErr: true,
+		},
 		"group id does not exists": {
 			srcAdmin:   []byte("valid--admin-address"),
 			srcComment: "test",
 			srcGroupID: 9999,
-			srcPolicy: group.ThresholdDecisionPolicy{
+			srcPolicy: &group.ThresholdDecisionPolicy{
 				Threshold: sdk.OneDec(),
 				Timout:    types.Duration{Seconds: 1},
 			},
@@ -159,7 +200,7 @@ func TestCreateGroupAccount(t *testing.T) {
 			srcAdmin:   []byte("other--admin-address"),
 			srcComment: "test",
 			srcGroupID: myGroupID,
-			srcPolicy: group.ThresholdDecisionPolicy{
+			srcPolicy: &group.ThresholdDecisionPolicy{
 				Threshold: sdk.OneDec(),
 				Timout:    types.Duration{Seconds: 1},
 			},
@@ -169,7 +210,7 @@ func TestCreateGroupAccount(t *testing.T) {
 			srcAdmin:   []byte("valid--admin-address"),
 			srcComment: strings.Repeat("a", 256),
 			srcGroupID: myGroupID,
-			srcPolicy: group.ThresholdDecisionPolicy{
+			srcPolicy: &group.ThresholdDecisionPolicy{
 				Threshold: sdk.OneDec(),
 				Timout:    types.Duration{Seconds: 1},
 			},
@@ -193,7 +234,7 @@ func TestCreateGroupAccount(t *testing.T) {
 			assert.Equal(t, sdk.AccAddress([]byte(spec.srcAdmin)), groupAccount.Base.Admin)
 			assert.Equal(t, spec.srcComment, groupAccount.Base.Comment)
 			assert.Equal(t, uint64(1), groupAccount.Base.Version)
-			assert.Equal(t, &spec.srcPolicy, groupAccount.DecisionPolicy.GetDecisionPolicy())
+			assert.Equal(t, spec.srcPolicy, groupAccount.DecisionPolicy.GetDecisionPolicy())
 		})
 	}
 }
@@ -221,14 +262,26 @@ func TestCreateProposal(t *testing.T) {
 		Threshold: sdk.OneDec(),
 		Timout:    types.Duration{Seconds: 1},
 	}
-	accountAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, policy, "test")
+	accountAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test")
 	require.NoError(t, err)
 
 	policy = group.ThresholdDecisionPolicy{
 		Threshold: sdk.NewDec(math.MaxInt64),
 		Timout:    types.Duration{Seconds: 1},
 	}
-	bigThresholdAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, policy, "test")
+	bigThresholdAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test")
+	require.NoError(t, err)
+
+	policy = group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	alwaysFailType := group.MsgType{Route: testdata.ModuleName, Type: testdata.MsgAlwaysFail{}.Type()}
+	allowListAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test",
+		group.WithMsgTypes([]group.MsgType{alwaysFailType}, nil))
+	require.NoError(t, err)
+	denyListAddr, err := k.CreateGroupAccount(ctx, []byte("valid--admin-address"), myGroupID, &policy, "test",
+		group.WithMsgTypes(nil, []group.MsgType{alwaysFailType}))
 	require.NoError(t, err)
 
 	specs := map[string]struct {
@@ -303,6 +356,28 @@ func TestCreateProposal(t *testing.T) {
 			srcProposers: []sdk.AccAddress{[]byte("valid-member-address")},
 			expErr:       true,
 		},
+		"all good with msg type in allow list": {
+			srcAccount:   allowListAddr,
+			srcProposers: []sdk.AccAddress{[]byte("valid-member-address")},
+			srcMsgs:      []sdk.Msg{&testdata.MsgAlwaysFail{}},
+		},
+		"reject msgs not in allow list": {
+			srcAccount:   allowListAddr,
+			srcProposers: []sdk.AccAddress{[]byte("valid-member-address")},
+			srcMsgs:      []sdk.Msg{&testdata.MsgAlwaysFail{}, &testdata.MsgAlwaysSucceed{}},
+			expErr:       true,
+		},
+		"all good with msg type not in deny list": {
+			srcAccount:   denyListAddr,
+			srcProposers: []sdk.AccAddress{[]byte("valid-member-address")},
+			srcMsgs:      []sdk.Msg{&testdata.MsgAlwaysSucceed{}},
+		},
+		"reject msgs in deny list": {
+			srcAccount:   denyListAddr,
+			srcProposers: []sdk.AccAddress{[]byte("valid-member-address")},
+			srcMsgs:      []sdk.Msg{&testdata.MsgAlwaysSucceed{}, &testdata.MsgAlwaysFail{}},
+			expErr:       true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -318,7 +393,7 @@ func TestCreateProposal(t *testing.T) {
 			require.NoError(t, err)
 
 			base := proposal.GetBase()
-			assert.Equal(t, accountAddr, base.GroupAccount)
+			assert.Equal(t, spec.srcAccount, base.GroupAccount)
 			assert.Equal(t, spec.srcComment, base.Comment)
 			assert.Equal(t, spec.srcProposers, base.Proposers)
 
@@ -373,7 +448,7 @@ func TestVote(t *testing.T) {
 		Threshold: sdk.NewDec(2),
 		Timout:    types.Duration{Seconds: 1},
 	}
-	accountAddr, err := k.CreateGroupAccount(parentCtx, []byte("valid--admin-address"), myGroupID, policy, "test")
+	accountAddr, err := k.CreateGroupAccount(parentCtx, []byte("valid--admin-address"), myGroupID, &policy, "test")
 	require.NoError(t, err)
 	myProposalID, err := k.CreateProposal(parentCtx, accountAddr, "integration test", []sdk.AccAddress{[]byte("valid-member-address")}, nil)
 	require.NoError(t, err)
@@ -534,7 +609,7 @@ func TestVote(t *testing.T) {
 			},
 			expErr: true,
 		},
-		"voted already": {
+		"change vote": {
 			srcProposalID: myProposalID,
 			srcVoters:     []sdk.AccAddress{[]byte("valid-member-address")},
 			srcChoice:     group.Choice_NO,
@@ -542,9 +617,75 @@ func TestVote(t *testing.T) {
 				err := k.Vote(ctx, myProposalID, []sdk.AccAddress{[]byte("valid-member-address")}, group.Choice_YES, "")
 				require.NoError(t, err)
 			},
-			expErr: true,
+			expVoteState: group.Tally{
+				YesCount:     sdk.ZeroDec(),
+				NoCount:      sdk.OneDec(),
+				AbstainCount: sdk.ZeroDec(),
+				VetoCount:    sdk.ZeroDec(),
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expResult:         group.ProposalResultUndefined,
+		},
+		"vote same choice again": {
+			srcProposalID: myProposalID,
+			srcVoters:     []sdk.AccAddress{[]byte("valid-member-address")},
+			srcChoice:     group.Choice_YES,
+			doBefore: func(t *testing.T, ctx sdk.Context) {
+				err := k.Vote(ctx, myProposalID, []sdk.AccAddress{[]byte("valid-member-address")}, group.Choice_YES, "")
+				require.NoError(t, err)
+			},
+			expVoteState: group.Tally{
+				YesCount:     sdk.OneDec(),
+				NoCount:      sdk.ZeroDec(),
+				AbstainCount: sdk.ZeroDec(),
+				VetoCount:    sdk.ZeroDec(),
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expResult:         group.ProposalResultUndefined,
+		},
+		"change vote multiple times": {
+			srcProposalID: myProposalID,
+			srcVoters:     []sdk.AccAddress{[]byte("valid-member-address")},
+			srcChoice:     group.Choice_ABSTAIN,
+			doBefore: func(t *testing.T, ctx sdk.Context) {
+				voters := []sdk.AccAddress{[]byte("valid-member-address")}
+				require.NoError(t, k.Vote(ctx, myProposalID, voters, group.Choice_YES, ""))
+				require.NoError(t, k.Vote(ctx, myProposalID, voters, group.Choice_VETO, ""))
+			},
+			expVoteState: group.Tally{
+				YesCount:     sdk.ZeroDec(),
+				NoCount:      sdk.ZeroDec(),
+				AbstainCount: sdk.OneDec(),
+				VetoCount:    sdk.ZeroDec(),
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expResult:         group.ProposalResultUndefined,
+		},
+		"same voter twice in one vote": {
+			srcProposalID: myProposalID,
+			srcVoters:     []sdk.AccAddress{[]byte("valid-member-address"), []byte("valid-member-address")},
+			srcChoice:     group.Choice_NO,
+			expVoteState: group.Tally{
+				YesCount:     sdk.ZeroDec(),
+				NoCount:      sdk.OneDec(),
+				AbstainCount: sdk.ZeroDec(),
+				VetoCount:    sdk.ZeroDec(),
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expResult:         group.ProposalResultUndefined,
 		},
 		"with group modified": {
+			srcProposalID: myProposalID,
+			srcVoters:     []sdk.AccAddress{[]byte("valid-member-address")},
+			srcChoice:     group.Choice_NO,
+			doBefore: func(t *testing.T, ctx sdk.Context) {
+				g, err := k.GetGroup(ctx, myGroupID)
+				require.NoError(t, err)
+				require.NoError(t, k.UpdateGroupMembership(ctx, &g))
+			},
+			expErr: true,
+		},
+		"with group metadata modified": {
 			srcProposalID: myProposalID,
 			srcVoters:     []sdk.AccAddress{[]byte("valid-member-address")},
 			srcChoice:     group.Choice_NO,
@@ -554,7 +695,14 @@ func TestVote(t *testing.T) {
 				g.Comment = "modified"
 				require.NoError(t, k.UpdateGroup(ctx, &g))
 			},
-			expErr: true,
+			expVoteState: group.Tally{
+				YesCount:     sdk.ZeroDec(),
+				NoCount:      sdk.OneDec(),
+				AbstainCount: sdk.ZeroDec(),
+				VetoCount:    sdk.ZeroDec(),
+			},
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expResult:         group.ProposalResultUndefined,
 		},
 		"with policy modified": {
 			srcProposalID: myProposalID,
@@ -638,7 +786,7 @@ func TestExecProposal(t *testing.T) {
 		Threshold: sdk.OneDec(),
 		Timout:    types.Duration{Seconds: 1},
 	}
-	accountAddr, err := k.CreateGroupAccount(parentCtx, []byte("valid--admin-address"), myGroupID, policy, "test")
+	accountAddr, err := k.CreateGroupAccount(parentCtx, []byte("valid--admin-address"), myGroupID, &policy, "test")
 	require.NoError(t, err)
 
 	specs := map[string]struct {
@@ -648,6 +796,7 @@ func TestExecProposal(t *testing.T) {
 		expProposalStatus group.ProposalBase_Status
 		expProposalResult group.ProposalBase_Result
 		expExecutorResult group.ProposalBase_ExecutorResult
+		expFailedMsgIndex int32
 		expPayloadCounter uint64
 	}{
 		"proposal executed when accepted": {
@@ -753,14 +902,32 @@ func TestExecProposal(t *testing.T) {
 				// then modify group
 				g, err := k.GetGroup(ctx, myGroupID)
 				require.NoError(t, err)
-				g.Comment = "modified"
-				require.NoError(t, k.UpdateGroup(ctx, &g))
+				require.NoError(t, k.UpdateGroupMembership(ctx, &g))
 				return myProposalID
 			},
 			expProposalStatus: group.ProposalStatusAborted,
 			expProposalResult: group.ProposalResultUndefined,
 			expExecutorResult: group.ProposalExecutorResultNotRun,
 		},
+		"with group metadata modified before tally": {
+			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
+				member := []sdk.AccAddress{[]byte("valid-member-address")}
+				myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, []sdk.Msg{
+					&testdata.MsgAlwaysFail{},
+				})
+				require.NoError(t, err)
+				require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+				// then modify group comment
+				g, err := k.GetGroup(ctx, myGroupID)
+				require.NoError(t, err)
+				g.Comment = "modified"
+				require.NoError(t, k.UpdateGroup(ctx, &g))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultFailure,
+		},
 		"with group account modified before tally": {
 			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
 				member := []sdk.AccAddress{[]byte("valid-member-address")}
@@ -790,8 +957,7 @@ func TestExecProposal(t *testing.T) {
 				// then modify group after tally on vote
 				g, err := k.GetGroup(ctx, myGroupID)
 				require.NoError(t, err)
-				g.Comment = "modified"
-				require.NoError(t, k.UpdateGroup(ctx, &g))
+				require.NoError(t, k.UpdateGroupMembership(ctx, &g))
 				return myProposalID
 			},
 			expProposalStatus: group.ProposalStatusClosed,
@@ -845,6 +1011,7 @@ func TestExecProposal(t *testing.T) {
 			expProposalStatus: group.ProposalStatusClosed,
 			expProposalResult: group.ProposalResultAccepted,
 			expExecutorResult: group.ProposalExecutorResultFailure,
+			expFailedMsgIndex: 1,
 		},
 		"executable when failed before": {
 			setupProposal: func(t *testing.T, ctx sdk.Context) group.ProposalID {
@@ -894,12 +1061,288 @@ func TestExecProposal(t *testing.T) {
 			got = group.ProposalBase_ExecutorResult_name[int32(proposal.GetBase().ExecutorResult)]
 			assert.Equal(t, exp, got)
 
+			// and execution recorded
+			execution, err := k.GetProposalExecution(ctx, proposalID)
+			switch spec.expExecutorResult {
+			case group.ProposalExecutorResultNotRun:
+				assert.True(t, orm.ErrNotFound.Is(err))
+			case group.ProposalExecutorResultSuccess:
+				require.NoError(t, err)
+				assert.Equal(t, spec.expExecutorResult, execution.ExecutorResult)
+				assert.Len(t, execution.MsgResults, len(proposal.GetMsgs()))
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, spec.expExecutorResult, execution.ExecutorResult)
+				assert.Equal(t, spec.expFailedMsgIndex, execution.FailedMsgIndex)
+				assert.NotEmpty(t, execution.FailureReason)
+				assert.Empty(t, execution.MsgResults)
+			}
+
 			// and proposal messages executed
 			assert.Equal(t, spec.expPayloadCounter, testdataKeeper.GetCounter(ctx), "counter")
 		})
 	}
 }
 
+func TestMinExecutionPeriod(t *testing.T) {
+	k, pCtx, _ := createTestKeeper(time.Now().UTC())
+	member := []sdk.AccAddress{[]byte("valid-member-address")}
+	members := []group.Member{{Address: member[0], Power: sdk.OneDec()}}
+	myGroupID, err := k.CreateGroup(pCtx, []byte("valid--admin-address"), members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold:          sdk.OneDec(),
+		Timout:             types.Duration{Seconds: 2},
+		MinExecutionPeriod: types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(pCtx, []byte("valid--admin-address"), myGroupID, &policy, "test")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcBlockTime      time.Time
+		expProposalStatus group.ProposalBase_Status
+		expProposalResult group.ProposalBase_Result
+	}{
+		"not accepted before min execution period": {
+			srcBlockTime:      pCtx.BlockTime().Add(time.Second - time.Nanosecond),
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expProposalResult: group.ProposalResultUndefined,
+		},
+		"accepted with min execution period": {
+			srcBlockTime:      pCtx.BlockTime().Add(time.Second),
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+		},		"accepted on timeout": {
+			srcBlockTime:      pCtx.BlockTime().Add(2 * time.Second),
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+		},
+		"accepted after timeout": {
+			srcBlockTime:      pCtx.BlockTime().Add(3 * time.Second),
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", member, nil)
+			require.NoError(t, err)
+			// vote does not close the proposal early
+			require.NoError(t, k.Vote(ctx, myProposalID, member, group.Choice_YES, ""))
+			proposal, err := k.GetProposal(ctx, myProposalID)
+			require.NoError(t, err)
+			require.Equal(t, group.ProposalStatusSubmitted, proposal.GetBase().Status)
+
+			// when
+			ctx = ctx.WithBlockTime(spec.srcBlockTime)
+			require.NoError(t, k.ExecProposal(ctx, myProposalID))
+
+			// then
+			proposal, err = k.GetProposal(ctx, myProposalID)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expProposalStatus, proposal.GetBase().Status)
+			assert.Equal(t, spec.expProposalResult, proposal.GetBase().Result)
+		})
+	}
+}
+
+func TestWithdrawProposal(t *testing.T) {
+	k, pCtx, _ := createTestKeeper(time.Now().UTC())
+	var (
+		admin    = sdk.AccAddress("valid--admin-address")
+		proposer = sdk.AccAddress("valid-member-address")
+		member   = sdk.AccAddress("power-member-address")
+	)
+	members := []group.Member{
+		{Address: proposer, Power: sdk.OneDec()},
+		{Address: member, Power: sdk.OneDec()},
+	}
+	myGroupID, err := k.CreateGroup(pCtx, admin, members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(2),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(pCtx, admin, myGroupID, &policy, "test")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcSigner    sdk.AccAddress
+		srcBlockTime time.Time
+		doBefore     func(t *testing.T, ctx sdk.Context, id group.ProposalID)
+		expErr       *errors.Error
+	}{
+		"by proposer": {
+			srcSigner: proposer,
+		},
+		"by group account admin": {
+			srcSigner: admin,
+		},
+		"by group member that is not a proposer": {
+			srcSigner: member,
+			expErr:    group.ErrUnauthorized,
+		},
+		"closed already": {
+			srcSigner: proposer,
+			doBefore: func(t *testing.T, ctx sdk.Context, id group.ProposalID) {
+				require.NoError(t, k.Vote(ctx, id, []sdk.AccAddress{proposer, member}, group.Choice_YES, ""))
+			},
+			expErr: group.ErrInvalid,
+		},
+		"withdrawn already": {
+			srcSigner: proposer,
+			doBefore: func(t *testing.T, ctx sdk.Context, id group.ProposalID) {
+				require.NoError(t, k.WithdrawProposal(ctx, id, admin))
+			},
+			expErr: group.ErrInvalid,
+		},
+		"on timeout": {
+			srcSigner:    proposer,
+			srcBlockTime: pCtx.BlockTime().Add(time.Second),
+			expErr:       group.ErrExpired,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", []sdk.AccAddress{proposer}, nil)
+			require.NoError(t, err)
+			if spec.doBefore != nil {
+				spec.doBefore(t, ctx, myProposalID)
+			}
+			if !spec.srcBlockTime.IsZero() {
+				ctx = ctx.WithBlockTime(spec.srcBlockTime)
+			}
+
+			// when
+			err = k.WithdrawProposal(ctx, myProposalID, spec.srcSigner)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+
+			// then
+			proposal, err := k.GetProposal(ctx, myProposalID)
+			require.NoError(t, err)
+			assert.Equal(t, group.ProposalStatusWithdrawn, proposal.GetBase().Status)
+
+			// and no further votes or execution accepted
+			assert.Error(t, k.Vote(ctx, myProposalID, []sdk.AccAddress{member}, group.Choice_YES, ""))
+			assert.Error(t, k.ExecProposal(ctx, myProposalID))
+		})
+	}
+}
+
+func TestElectorateSnapshot(t *testing.T) {
+	k, pCtx, _ := createTestKeeper(time.Now().UTC())
+	var (
+		admin     = sdk.AccAddress("valid--admin-address")
+		member    = sdk.AccAddress("valid-member-address")
+		oldMember = sdk.AccAddress("power-member-address")
+		newMember = sdk.AccAddress("newly-added-address-")
+	)
+	members := []group.Member{
+		{Address: member, Power: sdk.OneDec()},
+		{Address: oldMember, Power: sdk.NewDec(2)},
+	}
+	myGroupID, err := k.CreateGroup(pCtx, admin, members, "test")
+	require.NoError(t, err)
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.NewDec(2),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	snapshotAccountAddr, err := k.CreateGroupAccount(pCtx, admin, myGroupID, &policy, "test", group.WithElectorateSnapshot(true))
+	require.NoError(t, err)
+	liveAccountAddr, err := k.CreateGroupAccount(pCtx, admin, myGroupID, &policy, "test")
+	require.NoError(t, err)
+
+	snapshotProposalID, err := k.CreateProposal(pCtx, snapshotAccountAddr, "test", []sdk.AccAddress{member}, nil)
+	require.NoError(t, err)
+	liveProposalID, err := k.CreateProposal(pCtx, liveAccountAddr, "test", []sdk.AccAddress{member}, nil)
+	require.NoError(t, err)
+
+	// snapshot is taken on submission
+	snapshot, err := k.GetElectorateSnapshot(pCtx, snapshotProposalID)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(3), snapshot.TotalWeight)
+	snapshotMembers, err := k.GetElectorateSnapshotMembers(pCtx, snapshotProposalID)
+	require.NoError(t, err)
+	assert.Len(t, snapshotMembers, 2)
+	_, err = k.GetElectorateSnapshot(pCtx, liveProposalID)
+	require.True(t, orm.ErrNotFound.Is(err), err)
+
+	// when group membership is changed
+	_, err = group.NewHandler(k)(pCtx, group.MsgUpdateGroupMembers{
+		Group: myGroupID,
+		Admin: admin,
+		MemberUpdates: []group.Member{
+			{Address: oldMember, Power: sdk.ZeroDec()},
+			{Address: newMember, Power: sdk.NewDec(5)},
+		},
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		srcProposal group.ProposalID
+		srcVoters   []sdk.AccAddress
+		expErr      *errors.Error
+		expTally    group.Tally
+		expStatus   group.ProposalBase_Status
+	}{
+		"removed member votes with snapshot weight": {
+			srcProposal: snapshotProposalID,
+			srcVoters:   []sdk.AccAddress{oldMember},
+			expTally:    group.Tally{YesCount: sdk.NewDec(2), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			expStatus:   group.ProposalStatusClosed,
+		},
+		"member votes with snapshot weight": {
+			srcProposal: snapshotProposalID,
+			srcVoters:   []sdk.AccAddress{member},
+			expTally:    group.Tally{YesCount: sdk.OneDec(), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			expStatus:   group.ProposalStatusSubmitted,
+		},
+		"new member not in snapshot": {
+			srcProposal: snapshotProposalID,
+			srcVoters:   []sdk.AccAddress{newMember},
+			expErr:      orm.ErrNotFound,
+		},
+		"without snapshot": {
+			srcProposal: liveProposalID,
+			srcVoters:   []sdk.AccAddress{member},
+			expErr:      group.ErrModified,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			err := k.Vote(ctx, spec.srcProposal, spec.srcVoters, group.Choice_YES, "")
+			require.True(t, spec.expErr.Is(err), err)
+			if spec.expErr != nil {
+				return
+			}
+			proposal, err := k.GetProposal(ctx, spec.srcProposal)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expTally, proposal.GetBase().VoteState)
+			assert.Equal(t, spec.expStatus, proposal.GetBase().Status, proposal.GetBase().Status.String())
+		})
+	}
+
+	t.Run("exec", func(t *testing.T) {
+		ctx, _ := pCtx.CacheContext()
+		require.NoError(t, k.ExecProposal(ctx, snapshotProposalID))
+		require.NoError(t, k.ExecProposal(ctx, liveProposalID))
+
+		proposal, err := k.GetProposal(ctx, snapshotProposalID)
+		require.NoError(t, err)
+		assert.Equal(t, group.ProposalStatusSubmitted, proposal.GetBase().Status, proposal.GetBase().Status.String())
+		proposal, err = k.GetProposal(ctx, liveProposalID)
+		require.NoError(t, err)
+		assert.Equal(t, group.ProposalStatusAborted, proposal.GetBase().Status, proposal.GetBase().Status.String())
+	})
+}
+
 func TestLoadParam(t *testing.T) {
 	amino := codec.New()
 	pKey, pTKey := sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)
@@ -0,0 +1,34 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/pkg/errors"
+	"math"
+)
+
+// MigrateGroupMembershipVersions sets the membership version of all groups that were stored before the membership
+// version was split from the metadata version. The old version tracked membership changes so that it is copied to
+// keep running proposals valid. Apps should call this from the upgrade handler. It is also run on genesis import.
+func (k Keeper) MigrateGroupMembershipVersions(ctx sdk.Context) error {
+	it, err := k.groupTable.PrefixScan(ctx, 1, math.MaxUint64)
+	if err != nil {
+		return errors.Wrap(err, "groups")
+	}
+	// collect first as no writes may happen while the iterator is open
+	var groups []GroupMetadata
+	if _, err := orm.ReadAll(it, &groups); err != nil {
+		return errors.Wrap(err, "load groups")
+	}
+	for i := range groups {
+		g := groups[i]
+		if g.MembershipVersion != 0 {
+			continue
+		}
+		g.MembershipVersion = g.Version
+		if err := k.groupTable.Save(ctx, uint64(g.Group), &g); err != nil {
+			return errors.Wrapf(err, "group %d", g.Group)
+		}
+	}
+	return nil
+}
@@ -10,13 +10,17 @@ import (
 )
 
 const (
-	msgTypeCreateGroup           = "create_group"
-	msgTypeUpdateGroupAdmin      = "update_group_admin"
-	msgTypeUpdateGroupComment    = "update_group_comment"
-	msgTypeUpdateGroupMembers    = "update_group_members"
-	msgTypeCreateGroupAccountStd = "create_group_account"
-	msgTypeVote                  = "vote"
-	msgTypeExecProposal          = "exec_proposal"
+	msgTypeCreateGroup                      = "create_group"
+	msgTypeUpdateGroupAdmin                 = "update_group_admin"
+	msgTypeUpdateGroupComment               = "update_group_comment"
+	msgTypeUpdateGroupMembers               = "update_group_members"
+	msgTypeCreateGroupAccountStd            = "create_group_account"
+	msgTypeUpdateGroupAccountAdmin          = "update_group_account_admin"
+	msgTypeUpdateGroupAccountDecisionPolicy = "update_group_account_decision_policy"
+	msgTypeUpdateGroupAccountComment        = "update_group_account_comment"
+	msgTypeVote                             = "vote"
+	msgTypeExecProposal                     = "exec_proposal"
+	msgTypeWithdrawProposal                 = "withdraw_proposal"
 )
 
 type MsgCreateGroupAccountI interface {
@@ -24,6 +28,11 @@ type MsgCreateGroupAccountI interface {
 	GetDecisionPolicy() StdDecisionPolicy
 }
 
+type MsgUpdateGroupAccountDecisionPolicyI interface {
+	GetBase() MsgUpdateGroupAccountBase
+	GetDecisionPolicy() StdDecisionPolicy
+}
+
 var _ sdk.Msg = &MsgCreateGroup{}
 
 func (m MsgCreateGroup) Route() string { return ModuleName }
@@ -212,6 +221,12 @@ func (m *MsgCreateGroupAccountBase) ValidateBasic() error {
 	if m.Group == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "group")
 	}
+	if err := MsgTypes(m.AllowedMsgTypes).ValidateBasic(); err != nil {
+		return errors.Wrap(err, "allowed msg types")
+	}
+	if err := MsgTypes(m.DeniedMsgTypes).ValidateBasic(); err != nil {
+		return errors.Wrap(err, "denied msg types")
+	}
 	return nil
 }
 
@@ -275,6 +290,156 @@ func (m MsgCreateGroupAccountStd) GetDecisionPolicy() StdDecisionPolicy {
 	return m.DecisionPolicy
 }
 
+var _ sdk.Msg = &MsgUpdateGroupAccountAdmin{}
+
+func (m MsgUpdateGroupAccountAdmin) Route() string { return ModuleName }
+func (m MsgUpdateGroupAccountAdmin) Type() string  { return msgTypeUpdateGroupAccountAdmin }
+
+// GetSigners returns the addresses that must sign over msg.GetSignBytes()
+func (m MsgUpdateGroupAccountAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Admin}
+}
+
+// GetSignBytes returns the bytes for the message signer to sign on
+func (m MsgUpdateGroupAccountAdmin) GetSignBytes() []byte {
+	var buf bytes.Buffer
+	enc := jsonpb.Marshaler{}
+	if err := enc.Marshal(&buf, &m); err != nil {
+		panic(errors.Wrap(err, "get sign bytes"))
+	}
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateGroupAccountAdmin) ValidateBasic() error {
+	if m.GroupAccount.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "group account")
+	}
+	if err := sdk.VerifyAddressFormat(m.GroupAccount); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+
+	if m.Admin.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "admin")
+	}
+	if err := sdk.VerifyAddressFormat(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if m.NewAdmin.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "new admin")
+	}
+	if err := sdk.VerifyAddressFormat(m.NewAdmin); err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+
+	if m.Admin.Equals(m.NewAdmin) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+	return nil
+}
+
+func (m *MsgUpdateGroupAccountBase) ValidateBasic() error {
+	if m.Admin.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "admin")
+	}
+	if err := sdk.VerifyAddressFormat(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	if m.GroupAccount.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "group account")
+	}
+	if err := sdk.VerifyAddressFormat(m.GroupAccount); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateGroupAccountDecisionPolicyStd{}
+
+func (m MsgUpdateGroupAccountDecisionPolicyStd) Route() string { return ModuleName }
+func (m MsgUpdateGroupAccountDecisionPolicyStd) Type() string {
+	return msgTypeUpdateGroupAccountDecisionPolicy
+}
+
+// GetSigners returns the addresses that must sign over msg.GetSignBytes()
+func (m MsgUpdateGroupAccountDecisionPolicyStd) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Base.Admin}
+}
+
+// GetSignBytes returns the bytes for the message signer to sign on
+func (m MsgUpdateGroupAccountDecisionPolicyStd) GetSignBytes() []byte {
+	var buf bytes.Buffer
+	enc := jsonpb.Marshaler{}
+	if err := enc.Marshal(&buf, &m); err != nil {
+		panic(errors.Wrap(err, "get sign bytes"))
+	}
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateGroupAccountDecisionPolicyStd) ValidateBasic() error {
+	if err := m.Base.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "base")
+	}
+	if m.DecisionPolicy.GetDecisionPolicy() == nil {
+		return errors.Wrap(ErrEmpty, "decision policy")
+	}
+	if err := m.DecisionPolicy.GetDecisionPolicy().ValidateBasic(); err != nil {
+		return errors.Wrap(err, "decision policy")
+	}
+	return nil
+}
+
+var _ MsgUpdateGroupAccountDecisionPolicyI = MsgUpdateGroupAccountDecisionPolicyStd{}
+
+func (m MsgUpdateGroupAccountDecisionPolicyStd) GetBase() MsgUpdateGroupAccountBase {
+	return m.Base
+}
+
+func (m MsgUpdateGroupAccountDecisionPolicyStd) GetDecisionPolicy() StdDecisionPolicy {
+	return m.DecisionPolicy
+}
+
+var _ sdk.Msg = &MsgUpdateGroupAccountComment{}
+
+func (m MsgUpdateGroupAccountComment) Route() string { return ModuleName }
+func (m MsgUpdateGroupAccountComment) Type() string  { return msgTypeUpdateGroupAccountComment }
+
+// GetSigners returns the addresses that must sign over msg.GetSignBytes()
+func (m MsgUpdateGroupAccountComment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Admin}
+}
+
+// GetSignBytes returns the bytes for the message signer to sign on
+func (m MsgUpdateGroupAccountComment) GetSignBytes() []byte {
+	var buf bytes.Buffer
+	enc := jsonpb.Marshaler{}
+	if err := enc.Marshal(&buf, &m); err != nil {
+		panic(errors.Wrap(err, "get sign bytes"))
+	}
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateGroupAccountComment) ValidateBasic() error {
+	if m.GroupAccount.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "group account")
+	}
+	if err := sdk.VerifyAddressFormat(m.GroupAccount); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+
+	if m.Admin.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "admin")
+	}
+	if err := sdk.VerifyAddressFormat(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	return nil
+}
+
 var _ sdk.Msg = &MsgVote{}
 
 func (m MsgVote) Route() string { return ModuleName }
@@ -348,3 +513,37 @@ func (m MsgExec) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgWithdrawProposal{}
+
+func (m MsgWithdrawProposal) Route() string { return ModuleName }
+func (m MsgWithdrawProposal) Type() string  { return msgTypeWithdrawProposal }
+
+// GetSigners returns the addresses that must sign over msg.GetSignBytes()
+func (m MsgWithdrawProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+// GetSignBytes returns the bytes for the message signer to sign on
+func (m MsgWithdrawProposal) GetSignBytes() []byte {
+	var buf bytes.Buffer
+	enc := jsonpb.Marshaler{}
+	if err := enc.Marshal(&buf, &m); err != nil {
+		panic(errors.Wrap(err, "get sign bytes"))
+	}
+	return sdk.MustSortJSON(buf.Bytes())
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgWithdrawProposal) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return errors.Wrap(ErrEmpty, "signer")
+	}
+	if err := sdk.VerifyAddressFormat(m.Signer); err != nil {
+		return errors.Wrap(ErrInvalid, "signer")
+	}
+	if m.Proposal == 0 {
+		return errors.Wrap(ErrEmpty, "proposal")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package group
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// msgServer implements the Msg service. It is a separate type as the rpc method names clash with the Keeper
+// methods.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the Msg service for the given keeper. The sdk.Context must be
+// wrapped into the context.Context with WrapSDKContext. The msgs are validated with ValidateBasic but the signers
+// are not verified, so that the server must only be called for msgs of authenticated transactions.
+func NewMsgServerImpl(k Keeper) MsgServer {
+	return msgServer{Keeper: k}
+}
+
+var _ MsgServer = msgServer{}
+
+func (s msgServer) Vote(goCtx context.Context, msg *MsgVote) (*MsgVoteResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := s.Keeper.Vote(UnwrapSDKContext(goCtx), msg.Proposal, msg.Voters, msg.Choice, msg.Comment); err != nil {
+		return nil, err
+	}
+	return &MsgVoteResponse{}, nil
+}
+
+// Exec returns the execution record when the proposal payload was executed by this msg.
+func (s msgServer) Exec(goCtx context.Context, msg *MsgExec) (*MsgExecResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	execution, err := s.execProposal(UnwrapSDKContext(goCtx), msg.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgExecResponse{Execution: execution}, nil
+}
+
+func (s msgServer) WithdrawProposal(goCtx context.Context, msg *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := s.Keeper.WithdrawProposal(UnwrapSDKContext(goCtx), msg.Proposal, msg.Signer); err != nil {
+		return nil, err
+	}
+	return &MsgWithdrawProposalResponse{}, nil
+}
+
+func emitMessageEvent(ctx sdk.Context, sender sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+}
@@ -136,6 +136,48 @@ func TestMsgCreateGroupAccountStd(t *testing.T) {
 				},
 			},
 		},
+		"all good with msg types": {
+			src: MsgCreateGroupAccountStd{
+				Base: MsgCreateGroupAccountBase{Admin: myAddr, Group: 1,
+					AllowedMsgTypes: []MsgType{{Route: "bank", Type: "send"}},
+					DeniedMsgTypes:  []MsgType{{Route: "staking"}},
+				},
+				DecisionPolicy: StdDecisionPolicy{
+					Sum: &StdDecisionPolicy_Threshold{&ThresholdDecisionPolicy{
+						Threshold: sdk.OneDec(),
+						Timout:    proto.Duration{Seconds: 1},
+					}},
+				},
+			},
+		},
+		"msg type route required": {
+			src: MsgCreateGroupAccountStd{
+				Base: MsgCreateGroupAccountBase{Admin: myAddr, Group: 1,
+					AllowedMsgTypes: []MsgType{{Type: "send"}},
+				},
+				DecisionPolicy: StdDecisionPolicy{
+					Sum: &StdDecisionPolicy_Threshold{&ThresholdDecisionPolicy{
+						Threshold: sdk.OneDec(),
+						Timout:    proto.Duration{Seconds: 1},
+					}},
+				},
+			},
+			expErr: true,
+		},
+		"duplicate msg types not allowed": {
+			src: MsgCreateGroupAccountStd{
+				Base: MsgCreateGroupAccountBase{Admin: myAddr, Group: 1,
+					DeniedMsgTypes: []MsgType{{Route: "staking"}, {Route: "staking"}},
+				},
+				DecisionPolicy: StdDecisionPolicy{
+					Sum: &StdDecisionPolicy_Threshold{&ThresholdDecisionPolicy{
+						Threshold: sdk.OneDec(),
+						Timout:    proto.Duration{Seconds: 1},
+					}},
+				},
+			},
+			expErr: true,
+		},
 		"zero threshold not allowed": {
 			src: MsgCreateGroupAccountStd{
 				Base: MsgCreateGroupAccountBase{Admin: myAddr, Group: 1},
@@ -383,3 +425,191 @@ func TestMsgVote(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateGroupAccountAdminValidation(t *testing.T) {
+	specs := map[string]struct {
+		src    MsgUpdateGroupAccountAdmin
+		expErr bool
+	}{
+		"all good with minimum fields set": {
+			src: MsgUpdateGroupAccountAdmin{
+				Admin:        []byte("valid--admin-address"),
+				GroupAccount: []byte("valid--group-account"),
+				NewAdmin:     []byte("new----admin-address"),
+			},
+		},
+		"group account required": {
+			src: MsgUpdateGroupAccountAdmin{
+				Admin:    []byte("valid--admin-address"),
+				NewAdmin: []byte("new----admin-address"),
+			},
+			expErr: true,
+		},
+		"new admin required": {
+			src: MsgUpdateGroupAccountAdmin{
+				Admin:        []byte("valid--admin-address"),
+				GroupAccount: []byte("valid--group-account"),
+			},
+			expErr: true,
+		},
+		"new and old admin must not be the same": {
+			src: MsgUpdateGroupAccountAdmin{
+				Admin:        []byte("valid--admin-address"),
+				GroupAccount: []byte("valid--group-account"),
+				NewAdmin:     []byte("valid--admin-address"),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateGroupAccountDecisionPolicyStdValidation(t *testing.T) {
+	validPolicy := StdDecisionPolicy{
+		Sum: &StdDecisionPolicy_Threshold{&ThresholdDecisionPolicy{
+			Threshold: sdk.OneDec(),
+			Timout:    proto.Duration{Seconds: 1},
+		}},
+	}
+	specs := map[string]struct {
+		src    MsgUpdateGroupAccountDecisionPolicyStd
+		expErr bool
+	}{
+		"all good with minimum fields set": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base:           MsgUpdateGroupAccountBase{Admin: []byte("valid--admin-address"), GroupAccount: []byte("valid--group-account")},
+				DecisionPolicy: validPolicy,
+			},
+		},
+		"admin required": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base:           MsgUpdateGroupAccountBase{GroupAccount: []byte("valid--group-account")},
+				DecisionPolicy: validPolicy,
+			},
+			expErr: true,
+		},
+		"group account required": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base:           MsgUpdateGroupAccountBase{Admin: []byte("valid--admin-address")},
+				DecisionPolicy: validPolicy,
+			},
+			expErr: true,
+		},
+		"decision policy required": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base: MsgUpdateGroupAccountBase{Admin: []byte("valid--admin-address"), GroupAccount: []byte("valid--group-account")},
+			},
+			expErr: true,
+		},
+		"zero threshold not allowed": {
+			src: MsgUpdateGroupAccountDecisionPolicyStd{
+				Base: MsgUpdateGroupAccountBase{Admin: []byte("valid--admin-address"), GroupAccount: []byte("valid--group-account")},
+				DecisionPolicy: StdDecisionPolicy{
+					Sum: &StdDecisionPolicy_Threshold{&ThresholdDecisionPolicy{
+						Threshold: sdk.ZeroDec(),
+						Timout:    proto.Duration{Seconds: 1},
+					}},
+				},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateGroupAccountCommentValidation(t *testing.T) {
+	specs := map[string]struct {
+		src    MsgUpdateGroupAccountComment
+		expErr bool
+	}{
+		"all good with minimum fields set": {
+			src: MsgUpdateGroupAccountComment{
+				Admin:        []byte("valid--admin-address"),
+				GroupAccount: []byte("valid--group-account"),
+			},
+		},
+		"admin required": {
+			src: MsgUpdateGroupAccountComment{
+				GroupAccount: []byte("valid--group-account"),
+			},
+			expErr: true,
+		},
+		"group account required": {
+			src: MsgUpdateGroupAccountComment{
+				Admin: []byte("valid--admin-address"),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    MsgWithdrawProposal
+		expErr bool
+	}{
+		"all good with minimum fields set": {
+			src: MsgWithdrawProposal{
+				Proposal: 1,
+				Signer:   []byte("valid-member-address"),
+			},
+		},
+		"proposal required": {
+			src: MsgWithdrawProposal{
+				Signer: []byte("valid-member-address"),
+			},
+			expErr: true,
+		},
+		"signer required": {
+			src: MsgWithdrawProposal{
+				Proposal: 1,
+			},
+			expErr: true,
+		},
+		"valid signer address required": {
+			src: MsgWithdrawProposal{
+				Proposal: 1,
+				Signer:   []byte("invalid-member-address"),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
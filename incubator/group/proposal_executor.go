@@ -1,6 +1,8 @@
 package group
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -17,21 +19,51 @@ func ensureMsgAuthZ(msgs []sdk.Msg, groupAccount sdk.AccAddress) error {
 	return nil
 }
 
+// ensureMsgTypesPermitted checks that all message types are permitted by the allow and deny list of the group account.
+func ensureMsgTypesPermitted(msgs []sdk.Msg, account GroupAccountMetadataBase) error {
+	for i := range msgs {
+		if !account.PermitsMsg(msgs[i]) {
+			return errors.Wrapf(errors.ErrUnauthorized, "msg type %s/%s not permitted for group account", msgs[i].Route(), msgs[i].Type())
+		}
+	}
+	return nil
+}
+
+// msgExecutionError is the failure of the msg at the given index of a proposal payload.
+type msgExecutionError struct {
+	index int
+	err   error
+}
+
+func (e msgExecutionError) Error() string {
+	return fmt.Sprintf("message at position %d: %s", e.index, e.err)
+}
+
+func (e msgExecutionError) Cause() error {
+	return e.err
+}
+
 // doExecuteMsgs routes the messages to the registered handlers. Messages are limited to those that require no authZ or
-// by the group account only. Otherwise this gives access to other peoples accounts as the sdk ant handler is bypassed
-func doExecuteMsgs(ctx sdk.Context, router sdk.Router, groupAccount sdk.AccAddress, msgs []sdk.Msg) ([]sdk.Result, error) {
+// by the group account only. Otherwise this gives access to other peoples accounts as the sdk ant handler is bypassed.
+// The message types must be permitted by the group account. A failure is returned as msgExecutionError.
+func doExecuteMsgs(ctx sdk.Context, router sdk.Router, account GroupAccountMetadataBase, msgs []sdk.Msg) ([]sdk.Result, error) {
 	results := make([]sdk.Result, len(msgs))
-	if err := ensureMsgAuthZ(msgs, groupAccount); err != nil {
-		return nil, err
+	for i := range msgs {
+		if err := ensureMsgAuthZ(msgs[i:i+1], account.GroupAccount); err != nil {
+			return nil, msgExecutionError{index: i, err: err}
+		}
+		if err := ensureMsgTypesPermitted(msgs[i:i+1], account); err != nil {
+			return nil, msgExecutionError{index: i, err: err}
+		}
 	}
 	for i, msg := range msgs {
 		handler := router.Route(ctx, msg.Route())
 		if handler == nil {
-			return nil, errors.Wrapf(ErrInvalid, "no message handler found for %q", msg.Route())
+			return nil, msgExecutionError{index: i, err: errors.Wrapf(ErrInvalid, "no message handler found for %q", msg.Route())}
 		}
 		r, err := handler(ctx, msg)
 		if err != nil {
-			return nil, errors.Wrapf(err, "message %q at position %d", msg.Type(), i)
+			return nil, msgExecutionError{index: i, err: errors.Wrapf(err, "message %q", msg.Type())}
 		}
 		if r != nil {
 			results[i] = *r
@@ -39,3 +71,19 @@ func doExecuteMsgs(ctx sdk.Context, router sdk.Router, groupAccount sdk.AccAddre
 	}
 	return results, nil
 }
+
+// newMsgExecutionResults converts the handler results for the proposal execution record.
+func newMsgExecutionResults(results []sdk.Result) []MsgExecutionResult {
+	r := make([]MsgExecutionResult, len(results))
+	for i := range results {
+		r[i] = MsgExecutionResult{Data: results[i].Data, Log: results[i].Log}
+		for _, e := range results[i].Events {
+			event := ExecutionEvent{Type: e.Type}
+			for _, a := range e.Attributes {
+				event.Attributes = append(event.Attributes, ExecutionEventAttribute{Key: string(a.Key), Value: string(a.Value)})
+			}
+			r[i].Events = append(r[i].Events, event)
+		}
+	}
+	return r
+}
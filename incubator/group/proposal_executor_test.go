@@ -5,44 +5,83 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestDoExecuteMsgs(t *testing.T) {
+	myAccount := GroupAccountMetadataBase{GroupAccount: []byte("my-group-acct-addrss")}
+	myMsg := MyMsg{[]sdk.AccAddress{[]byte("my-group-acct-addrss")}}
 	specs := map[string]struct {
-		srcAccount sdk.AccAddress
+		srcAccount GroupAccountMetadataBase
 		srcMsgs    []sdk.Msg
 		srcHandler sdk.Handler
 		expErr     bool
+		expErrPos  int
 	}{
 		"all good": {
-			srcAccount: []byte("my-group-acct-addrss"),
+			srcAccount: myAccount,
 			srcMsgs:    []sdk.Msg{MyMsg{[]sdk.AccAddress{[]byte("my-group-acct-addrss")}}},
 			srcHandler: mockHandler(&sdk.Result{}, nil),
 		},
 		"not authz by group account": {
-			srcAccount: []byte("my-group-acct-addrss"),
+			srcAccount: myAccount,
 			srcMsgs:    []sdk.Msg{MyMsg{[]sdk.AccAddress{[]byte("any--other---address")}}},
 			srcHandler: alwaysPanicHandler(),
 			expErr:     true,
 		},
 		"mixed group account msgs": {
-			srcAccount: []byte("my-group-acct-addrss"),
+			srcAccount: myAccount,
 			srcMsgs: []sdk.Msg{
 				MyMsg{[]sdk.AccAddress{[]byte("my-group-acct-addrss")}},
 				MyMsg{[]sdk.AccAddress{[]byte("any--other---address")}},
 			},
 			srcHandler: alwaysPanicHandler(),
 			expErr:     true,
+			expErrPos:  1,
 		},
 		"no handler": {
-			srcAccount: []byte("my-group-acct-addrss"),
+			srcAccount: myAccount,
 			srcMsgs:    []sdk.Msg{NonRoutableMsg{}},
 			srcHandler: alwaysPanicHandler(),
 			expErr:     true,
 		},
+		"allowed msg type": {
+			srcAccount: GroupAccountMetadataBase{
+				GroupAccount:    []byte("my-group-acct-addrss"),
+				AllowedMsgTypes: []MsgType{{Route: "myRoute", Type: "my test message type"}},
+			},
+			srcMsgs:    []sdk.Msg{myMsg},
+			srcHandler: mockHandler(&sdk.Result{}, nil),
+		},
+		"allowed msg route": {
+			srcAccount: GroupAccountMetadataBase{
+				GroupAccount:    []byte("my-group-acct-addrss"),
+				AllowedMsgTypes: []MsgType{{Route: "myRoute"}},
+			},
+			srcMsgs:    []sdk.Msg{myMsg},
+			srcHandler: mockHandler(&sdk.Result{}, nil),
+		},
+		"msg type not in allow list": {
+			srcAccount: GroupAccountMetadataBase{
+				GroupAccount:    []byte("my-group-acct-addrss"),
+				AllowedMsgTypes: []MsgType{{Route: "myRoute", Type: "other"}},
+			},
+			srcMsgs:    []sdk.Msg{myMsg},
+			srcHandler: alwaysPanicHandler(),
+			expErr:     true,
+		},
+		"msg type in deny list": {
+			srcAccount: GroupAccountMetadataBase{
+				GroupAccount:   []byte("my-group-acct-addrss"),
+				DeniedMsgTypes: []MsgType{{Route: "myRoute"}},
+			},
+			srcMsgs:    []sdk.Msg{myMsg},
+			srcHandler: alwaysPanicHandler(),
+			expErr:     true,
+		},
 		"not panic on nil result": {
-			srcAccount: []byte("my-group-acct-addrss"),
+			srcAccount: myAccount,
 			srcMsgs:    []sdk.Msg{MyMsg{[]sdk.AccAddress{[]byte("my-group-acct-addrss")}}},
 			srcHandler: mockHandler(nil, nil),
 		},
@@ -52,7 +91,8 @@ func TestDoExecuteMsgs(t *testing.T) {
 			router := baseapp.NewRouter().AddRoute("myRoute", spec.srcHandler)
 			_, err := doExecuteMsgs(NewContext(), router, spec.srcAccount, spec.srcMsgs)
 			if spec.expErr {
-				require.Error(t, err)
+				require.IsType(t, msgExecutionError{}, err)
+				assert.Equal(t, spec.expErrPos, err.(msgExecutionError).index)
 				return
 			}
 			require.NoError(t, err)
@@ -6,6 +6,7 @@ protoc \
 -I=. \
 -I=$(go list -f "{{ .Dir }}" -m github.com/gogo/protobuf) \
 -I=$(go list -f "{{ .Dir }}" -m github.com/cosmos/cosmos-sdk) \
+-I=$(go list -f "{{ .Dir }}" -m github.com/cosmos/modules/incubator/orm)/.. \
 --gocosmos_out=\
 Mgoogle/protobuf/any.proto=github.com/gogo/protobuf/types,\
 Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types,\
@@ -16,6 +17,15 @@ Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types,\
 plugins=interfacetype+grpc,paths=source_relative:. \
 types.proto
 
+# generate typed tables, requires protoc-gen-orm from the orm module
+protoc \
+-I=. \
+-I=$(go list -f "{{ .Dir }}" -m github.com/gogo/protobuf) \
+-I=$(go list -f "{{ .Dir }}" -m github.com/cosmos/cosmos-sdk) \
+-I=$(go list -f "{{ .Dir }}" -m github.com/cosmos/modules/incubator/orm)/.. \
+--orm_out=. \
+types.proto
+
 # generate testdata types
 protoc \
 -I=.. \
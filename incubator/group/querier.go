@@ -1,17 +1,367 @@
 package group
 
 import (
+	"bytes"
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// query endpoints supported by the group Querier
+const (
+	QueryGroup                   = "group"
+	QueryGroupsByAdmin           = "groups_by_admin"
+	QueryGroupMembers            = "group_members"
+	QueryGroupsByMember          = "groups_by_member"
+	QueryGroupAccount            = "group_account"
+	QueryGroupAccountsByGroup    = "group_accounts_by_group"
+	QueryGroupAccountsByAdmin    = "group_accounts_by_admin"
+	QueryProposal                = "proposal"
+	QueryProposalExecution       = "proposal_execution"
+	QueryProposalsByGroupAccount = "proposals_by_group_account"
+	QueryProposalsByProposer     = "proposals_by_proposer"
+	QueryVote                    = "vote"
+	QueryVotesByProposal         = "votes_by_proposal"
+	QueryVotesByVoter            = "votes_by_voter"
+)
+
+const (
+	// DefaultQueryLimit is used when no limit is set in the pagination params.
+	DefaultQueryLimit = 100
+	// MaxQueryLimit is the upper bound for the number of elements returned by a list query.
+	MaxQueryLimit = 1000
+	// MaxQueryOffset is the upper bound for the number of elements skipped for the requested page of a list query.
+	MaxQueryOffset = 10000
+)
+
+// QueryPagination defines the page to return for list queries. Pages start with 1.
+// Zero values are replaced by defaults. The elements before the page must not exceed MaxQueryOffset.
+type QueryPagination struct {
+	Page  int `json:"page,omitempty"`
+	Limit int `json:"limit,omitempty"`
+}
+
+// QueryGroupParams defines the params for queries by group id.
+type QueryGroupParams struct {
+	Group GroupID `json:"group"`
+	QueryPagination
+}
+
+// QueryAddressParams defines the params for queries by an address like admin, member, group account, proposer
+// or voter.
+type QueryAddressParams struct {
+	Address sdk.AccAddress `json:"address"`
+	QueryPagination
+}
+
+// QueryProposalParams defines the params for queries by proposal id.
+type QueryProposalParams struct {
+	Proposal ProposalID `json:"proposal"`
+	QueryPagination
+}
+
+// QueryVoteParams defines the params to query a single vote.
+type QueryVoteParams struct {
+	Proposal ProposalID     `json:"proposal"`
+	Voter    sdk.AccAddress `json:"voter"`
+}
+
+// NewQuerier returns the legacy querier for the group module. Single objects are returned as protobuf JSON, list
+// results as JSON array of protobuf JSON elements.
 func NewQuerier(k Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
-		// TODO: define supported queries and return types
+		case QueryGroup:
+			return queryGroup(ctx, req, k)
+		case QueryGroupsByAdmin:
+			return queryGroupsByAdmin(ctx, req, k)
+		case QueryGroupMembers:
+			return queryGroupMembers(ctx, req, k)
+		case QueryGroupsByMember:
+			return queryGroupsByMember(ctx, req, k)
+		case QueryGroupAccount:
+			return queryGroupAccount(ctx, req, k)
+		case QueryGroupAccountsByGroup:
+			return queryGroupAccountsByGroup(ctx, req, k)
+		case QueryGroupAccountsByAdmin:
+			return queryGroupAccountsByAdmin(ctx, req, k)
+		case QueryProposal:
+			return queryProposal(ctx, req, k)
+		case QueryProposalExecution:
+			return queryProposalExecution(ctx, req, k)
+		case QueryProposalsByGroupAccount:
+			return queryProposalsByGroupAccount(ctx, req, k)
+		case QueryProposalsByProposer:
+			return queryProposalsByProposer(ctx, req, k)
+		case QueryVote:
+			return queryVote(ctx, req, k)
+		case QueryVotesByProposal:
+			return queryVotesByProposal(ctx, req, k)
+		case QueryVotesByVoter:
+			return queryVotesByVoter(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
 	}
 }
+
+func queryGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryGroupParams
+	if err := unmarshalQueryParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	obj, err := k.GetGroup(ctx, params.Group)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(&obj)
+}
+
+func queryGroupsByAdmin(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryAddressParams
+	if err := unmarshalAddressParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetGroupsByAdmin(ctx, params.Address)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return &GroupMetadata{} })
+}
+
+func queryGroupMembers(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryGroupParams
+	if err := unmarshalQueryParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetGroupMembersByGroup(ctx, params.Group)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return &GroupMember{} })
+}
+
+// queryGroupsByMember returns the `GroupMember` entries of the address which contain the group id and weight.
+func queryGroupsByMember(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryAddressParams
+	if err := unmarshalAddressParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetGroupMembershipsByMember(ctx, params.Address)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return &GroupMember{} })
+}
+
+func queryGroupAccount(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryAddressParams
+	if err := unmarshalAddressParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	obj, err := k.GetGroupAccount(ctx, params.Address)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(&obj)
+}
+
+func queryGroupAccountsByGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryGroupParams
+	if err := unmarshalQueryParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetGroupAccountsByGroup(ctx, params.Group)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return &StdGroupAccountMetadata{} })
+}
+
+func queryGroupAccountsByAdmin(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryAddressParams
+	if err := unmarshalAddressParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetGroupAccountsByAdmin(ctx, params.Address)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return &StdGroupAccountMetadata{} })
+}
+
+func queryProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryProposalParams
+	if err := unmarshalQueryParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	obj, err := k.GetProposal(ctx, params.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(obj)
+}
+
+func queryProposalExecution(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryProposalParams
+	if err := unmarshalQueryParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	obj, err := k.GetProposalExecution(ctx, params.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(&obj)
+}
+
+func queryProposalsByGroupAccount(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryAddressParams
+	if err := unmarshalAddressParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetProposalsByGroupAccount(ctx, params.Address)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return k.newProposalModel() })
+}
+
+func queryProposalsByProposer(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryAddressParams
+	if err := unmarshalAddressParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetProposalsByProposer(ctx, params.Address)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return k.newProposalModel() })
+}
+
+func queryVote(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryVoteParams
+	if err := unmarshalQueryParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	if params.Voter.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "voter")
+	}
+	obj, err := k.GetVote(ctx, params.Proposal, params.Voter)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(&obj)
+}
+
+func queryVotesByProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryProposalParams
+	if err := unmarshalQueryParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetVotesByProposal(ctx, params.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return &Vote{} })
+}
+
+func queryVotesByVoter(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params QueryAddressParams
+	if err := unmarshalAddressParams(req.Data, &params); err != nil {
+		return nil, err
+	}
+	it, err := k.GetVotesByVoter(ctx, params.Address)
+	if err != nil {
+		return nil, err
+	}
+	return marshalPage(it, params.QueryPagination, func() orm.Persistent { return &Vote{} })
+}
+
+func unmarshalQueryParams(bz []byte, params interface{}) error {
+	if err := json.Unmarshal(bz, params); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	return nil
+}
+
+func unmarshalAddressParams(bz []byte, params *QueryAddressParams) error {
+	if err := unmarshalQueryParams(bz, params); err != nil {
+		return err
+	}
+	if params.Address.Empty() {
+		return sdkerrors.Wrap(ErrEmpty, "address")
+	}
+	return nil
+}
+
+func marshalQueryResult(obj orm.Persistent) ([]byte, error) {
+	msg, ok := obj.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrType, "not a proto message: %T", obj)
+	}
+	var buf bytes.Buffer
+	marshaler := jsonpb.Marshaler{}
+	if err := marshaler.Marshal(&buf, msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return buf.Bytes(), nil
+}
+
+// marshalPage skips all elements before the requested page and returns the elements of the page as JSON array.
+// The iterator is closed afterwards.
+func marshalPage(it orm.Iterator, p QueryPagination, newModel func() orm.Persistent) ([]byte, error) {
+	objs, err := loadPage(it, p, newModel)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]json.RawMessage, len(objs))
+	for i, obj := range objs {
+		if result[i], err = marshalQueryResult(obj); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(result)
+}
+
+// loadPage skips all elements before the requested page and returns the elements of the page. The iterator is
+// closed afterwards.
+func loadPage(it orm.Iterator, p QueryPagination, newModel func() orm.Persistent) ([]orm.Persistent, error) {
+	defer it.Close()
+	page, limit := p.Page, p.Limit
+	switch {
+	case page < 0 || limit < 0:
+		return nil, sdkerrors.Wrap(ErrInvalid, "pagination")
+	case limit > MaxQueryLimit:
+		return nil, sdkerrors.Wrapf(ErrMaxLimit, "limit: %d", limit)
+	}
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = DefaultQueryLimit
+	}
+	if page-1 > MaxQueryOffset/limit {
+		return nil, sdkerrors.Wrapf(ErrMaxLimit, "page: %d", page)
+	}
+
+	var result []orm.Persistent
+	for i := 0; i < page*limit; i++ {
+		obj := newModel()
+		switch _, err := it.LoadNext(obj); {
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		case err != nil:
+			return nil, err
+		}
+		if i < (page-1)*limit {
+			continue
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
@@ -0,0 +1,243 @@
+package group_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/group/testdata"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQuerier(t *testing.T) {
+	k, ctx, _ := createTestKeeper(time.Now().UTC())
+
+	var (
+		admin      = sdk.AccAddress("valid--admin-address")
+		otherAdmin = sdk.AccAddress("other--admin-address")
+		member     = sdk.AccAddress("valid-member-address")
+		nonMember  = sdk.AccAddress("nonmember----address")
+	)
+	members := []group.Member{{Address: member, Power: sdk.OneDec()}}
+	myGroupID, err := k.CreateGroup(ctx, admin, members, "first")
+	require.NoError(t, err)
+	_, err = k.CreateGroup(ctx, admin, members, "second")
+	require.NoError(t, err)
+	otherGroupID, err := k.CreateGroup(ctx, otherAdmin, members, "third")
+	require.NoError(t, err)
+
+	policy := group.ThresholdDecisionPolicy{
+		Threshold: sdk.OneDec(),
+		Timout:    types.Duration{Seconds: 1},
+	}
+	accountAddr, err := k.CreateGroupAccount(ctx, admin, myGroupID, &policy, "test")
+	require.NoError(t, err)
+	myProposalID, err := k.CreateProposal(ctx, accountAddr, "test", []sdk.AccAddress{member}, nil)
+	require.NoError(t, err)
+	require.NoError(t, k.Vote(ctx, myProposalID, []sdk.AccAddress{member}, group.Choice_YES, "yes"))
+
+	querier := group.NewQuerier(k)
+
+	specs := map[string]struct {
+		srcPath   string
+		srcParams interface{}
+		expCount  int
+		expErr    bool
+	}{
+		"groups by admin": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin},
+			expCount:  2,
+		},
+		"groups by admin with limit": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin, QueryPagination: group.QueryPagination{Limit: 1}},
+			expCount:  1,
+		},
+		"groups by admin second page": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin, QueryPagination: group.QueryPagination{Page: 2, Limit: 1}},
+			expCount:  1,
+		},
+		"groups by admin beyond last page": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin, QueryPagination: group.QueryPagination{Page: 3, Limit: 1}},
+			expCount:  0,
+		},
+		"groups by admin without address": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{},
+			expErr:    true,
+		},
+		"groups by admin with limit exceeded": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin, QueryPagination: group.QueryPagination{Limit: group.MaxQueryLimit + 1}},
+			expErr:    true,
+		},
+		"groups by admin with max offset": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin, QueryPagination: group.QueryPagination{Page: group.MaxQueryOffset + 1, Limit: 1}},
+			expCount:  0,
+		},
+		"groups by admin with offset exceeded": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin, QueryPagination: group.QueryPagination{Page: group.MaxQueryOffset + 2, Limit: 1}},
+			expErr:    true,
+		},
+		"groups by admin with overflowing page": {
+			srcPath:   group.QueryGroupsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin, QueryPagination: group.QueryPagination{Page: math.MaxInt32, Limit: group.MaxQueryLimit}},
+			expErr:    true,
+		},
+		"group members": {
+			srcPath:   group.QueryGroupMembers,
+			srcParams: group.QueryGroupParams{Group: otherGroupID},
+			expCount:  1,
+		},
+		"groups by member": {
+			srcPath:   group.QueryGroupsByMember,
+			srcParams: group.QueryAddressParams{Address: member},
+			expCount:  3,
+		},
+		"groups by non member": {
+			srcPath:   group.QueryGroupsByMember,
+			srcParams: group.QueryAddressParams{Address: nonMember},
+			expCount:  0,
+		},
+		"group accounts by group": {
+			srcPath:   group.QueryGroupAccountsByGroup,
+			srcParams: group.QueryGroupParams{Group: myGroupID},
+			expCount:  1,
+		},
+		"group accounts by other group": {
+			srcPath:   group.QueryGroupAccountsByGroup,
+			srcParams: group.QueryGroupParams{Group: otherGroupID},
+			expCount:  0,
+		},
+		"group accounts by admin": {
+			srcPath:   group.QueryGroupAccountsByAdmin,
+			srcParams: group.QueryAddressParams{Address: admin},
+			expCount:  1,
+		},
+		"proposals by group account": {
+			srcPath:   group.QueryProposalsByGroupAccount,
+			srcParams: group.QueryAddressParams{Address: accountAddr},
+			expCount:  1,
+		},
+		"proposals by proposer": {
+			srcPath:   group.QueryProposalsByProposer,
+			srcParams: group.QueryAddressParams{Address: member},
+			expCount:  1,
+		},
+		"votes by proposal": {
+			srcPath:   group.QueryVotesByProposal,
+			srcParams: group.QueryProposalParams{Proposal: myProposalID},
+			expCount:  1,
+		},
+		"votes by voter": {
+			srcPath:   group.QueryVotesByVoter,
+			srcParams: group.QueryAddressParams{Address: member},
+			expCount:  1,
+		},
+		"votes by non voter": {
+			srcPath:   group.QueryVotesByVoter,
+			srcParams: group.QueryAddressParams{Address: nonMember},
+			expCount:  0,
+		},
+		"unknown path": {
+			srcPath:   "unknown",
+			srcParams: group.QueryAddressParams{Address: member},
+			expErr:    true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			bz, err := json.Marshal(spec.srcParams)
+			require.NoError(t, err)
+			res, err := querier(ctx, []string{spec.srcPath}, abci.RequestQuery{Data: bz})
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var loaded []json.RawMessage
+			require.NoError(t, json.Unmarshal(res, &loaded))
+			assert.Len(t, loaded, spec.expCount)
+		})
+	}
+
+	t.Run("group", func(t *testing.T) {
+		bz, err := json.Marshal(group.QueryGroupParams{Group: myGroupID})
+		require.NoError(t, err)
+		res, err := querier(ctx, []string{group.QueryGroup}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+		var loaded group.GroupMetadata
+		require.NoError(t, jsonpb.Unmarshal(bytes.NewReader(res), &loaded))
+		exp, err := k.GetGroup(ctx, myGroupID)
+		require.NoError(t, err)
+		assert.Equal(t, exp, loaded)
+	})
+	t.Run("unknown group", func(t *testing.T) {
+		bz, err := json.Marshal(group.QueryGroupParams{Group: 999})
+		require.NoError(t, err)
+		_, err = querier(ctx, []string{group.QueryGroup}, abci.RequestQuery{Data: bz})
+		require.Error(t, err)
+	})
+	t.Run("group account", func(t *testing.T) {
+		bz, err := json.Marshal(group.QueryAddressParams{Address: accountAddr})
+		require.NoError(t, err)
+		res, err := querier(ctx, []string{group.QueryGroupAccount}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+		var loaded group.StdGroupAccountMetadata
+		require.NoError(t, jsonpb.Unmarshal(bytes.NewReader(res), &loaded))
+		exp, err := k.GetGroupAccount(ctx, accountAddr)
+		require.NoError(t, err)
+		assert.Equal(t, exp, loaded)
+	})
+	t.Run("proposal", func(t *testing.T) {
+		bz, err := json.Marshal(group.QueryProposalParams{Proposal: myProposalID})
+		require.NoError(t, err)
+		res, err := querier(ctx, []string{group.QueryProposal}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+		var loaded testdata.MyAppProposal
+		require.NoError(t, jsonpb.Unmarshal(bytes.NewReader(res), &loaded))
+		exp, err := k.GetProposal(ctx, myProposalID)
+		require.NoError(t, err)
+		assert.Equal(t, exp.GetBase(), loaded.GetBase())
+	})
+	t.Run("vote", func(t *testing.T) {
+		bz, err := json.Marshal(group.QueryVoteParams{Proposal: myProposalID, Voter: member})
+		require.NoError(t, err)
+		res, err := querier(ctx, []string{group.QueryVote}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+		var loaded group.Vote
+		require.NoError(t, jsonpb.Unmarshal(bytes.NewReader(res), &loaded))
+		exp, err := k.GetVote(ctx, myProposalID, member)
+		require.NoError(t, err)
+		assert.Equal(t, exp, loaded)
+	})
+	t.Run("proposal execution", func(t *testing.T) {
+		bz, err := json.Marshal(group.QueryProposalParams{Proposal: myProposalID})
+		require.NoError(t, err)
+		_, err = querier(ctx, []string{group.QueryProposalExecution}, abci.RequestQuery{Data: bz})
+		require.Error(t, err, "not executed")
+
+		require.NoError(t, k.ExecProposal(ctx, myProposalID))
+		res, err := querier(ctx, []string{group.QueryProposalExecution}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+		var loaded group.ProposalExecution
+		require.NoError(t, jsonpb.Unmarshal(bytes.NewReader(res), &loaded))
+		exp, err := k.GetProposalExecution(ctx, myProposalID)
+		require.NoError(t, err)
+		assert.Equal(t, exp, loaded)
+		assert.Equal(t, group.ProposalExecutorResultSuccess, loaded.ExecutorResult)
+	})
+}
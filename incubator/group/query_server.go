@@ -0,0 +1,259 @@
+package group
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+var _ QueryServer = Keeper{}
+
+func (k Keeper) Group(goCtx context.Context, req *QueryGroupRequest) (*QueryGroupResponse, error) {
+	obj, err := k.GetGroup(UnwrapSDKContext(goCtx), req.Group)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryGroupResponse{Group: obj}, nil
+}
+
+func (k Keeper) GroupMembers(goCtx context.Context, req *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error) {
+	it, err := k.GetGroupMembersByGroup(UnwrapSDKContext(goCtx), req.Group)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := loadPage(it, req.Pagination.queryPagination(), func() orm.Persistent { return &GroupMember{} })
+	if err != nil {
+		return nil, err
+	}
+	res := QueryGroupMembersResponse{Members: make([]GroupMember, len(objs))}
+	for i, obj := range objs {
+		res.Members[i] = *obj.(*GroupMember)
+	}
+	return &res, nil
+}
+
+func (k Keeper) GroupsByAdmin(goCtx context.Context, req *QueryGroupsByAdminRequest) (*QueryGroupsByAdminResponse, error) {
+	if req.Admin.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "admin")
+	}
+	it, err := k.GetGroupsByAdmin(UnwrapSDKContext(goCtx), req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := loadPage(it, req.Pagination.queryPagination(), func() orm.Persistent { return &GroupMetadata{} })
+	if err != nil {
+		return nil, err
+	}
+	res := QueryGroupsByAdminResponse{Groups: make([]GroupMetadata, len(objs))}
+	for i, obj := range objs {
+		res.Groups[i] = *obj.(*GroupMetadata)
+	}
+	return &res, nil
+}
+
+func (k Keeper) GroupsByMember(goCtx context.Context, req *QueryGroupsByMemberRequest) (*QueryGroupsByMemberResponse, error) {
+	if req.Member.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "member")
+	}
+	it, err := k.GetGroupMembershipsByMember(UnwrapSDKContext(goCtx), req.Member)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := loadPage(it, req.Pagination.queryPagination(), func() orm.Persistent { return &GroupMember{} })
+	if err != nil {
+		return nil, err
+	}
+	res := QueryGroupsByMemberResponse{Memberships: make([]GroupMember, len(objs))}
+	for i, obj := range objs {
+		res.Memberships[i] = *obj.(*GroupMember)
+	}
+	return &res, nil
+}
+
+func (k Keeper) GroupAccount(goCtx context.Context, req *QueryGroupAccountRequest) (*QueryGroupAccountResponse, error) {
+	if req.GroupAccount.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "group account")
+	}
+	obj, err := k.GetGroupAccount(UnwrapSDKContext(goCtx), req.GroupAccount)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryGroupAccountResponse{GroupAccount: obj}, nil
+}
+
+func (k Keeper) GroupAccountsByGroup(goCtx context.Context, req *QueryGroupAccountsByGroupRequest) (*QueryGroupAccountsByGroupResponse, error) {
+	it, err := k.GetGroupAccountsByGroup(UnwrapSDKContext(goCtx), req.Group)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := loadGroupAccountsPage(it, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryGroupAccountsByGroupResponse{GroupAccounts: accounts}, nil
+}
+
+func (k Keeper) GroupAccountsByAdmin(goCtx context.Context, req *QueryGroupAccountsByAdminRequest) (*QueryGroupAccountsByAdminResponse, error) {
+	if req.Admin.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "admin")
+	}
+	it, err := k.GetGroupAccountsByAdmin(UnwrapSDKContext(goCtx), req.Admin)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := loadGroupAccountsPage(it, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryGroupAccountsByAdminResponse{GroupAccounts: accounts}, nil
+}
+
+func loadGroupAccountsPage(it orm.Iterator, p *PageRequest) ([]StdGroupAccountMetadata, error) {
+	objs, err := loadPage(it, p.queryPagination(), func() orm.Persistent { return &StdGroupAccountMetadata{} })
+	if err != nil {
+		return nil, err
+	}
+	r := make([]StdGroupAccountMetadata, len(objs))
+	for i, obj := range objs {
+		r[i] = *obj.(*StdGroupAccountMetadata)
+	}
+	return r, nil
+}
+
+func (k Keeper) Proposal(goCtx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	obj, err := k.GetProposal(UnwrapSDKContext(goCtx), req.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	any, err := marshalAny(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryProposalResponse{Proposal: any}, nil
+}
+
+func (k Keeper) ProposalExecution(goCtx context.Context, req *QueryProposalExecutionRequest) (*QueryProposalExecutionResponse, error) {
+	obj, err := k.GetProposalExecution(UnwrapSDKContext(goCtx), req.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryProposalExecutionResponse{Execution: obj}, nil
+}
+
+func (k Keeper) ProposalsByGroupAccount(goCtx context.Context, req *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error) {
+	if req.GroupAccount.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "group account")
+	}
+	it, err := k.GetProposalsByGroupAccount(UnwrapSDKContext(goCtx), req.GroupAccount)
+	if err != nil {
+		return nil, err
+	}
+	proposals, err := k.loadProposalsPage(it, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryProposalsByGroupAccountResponse{Proposals: proposals}, nil
+}
+
+func (k Keeper) ProposalsByProposer(goCtx context.Context, req *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error) {
+	if req.Proposer.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "proposer")
+	}
+	it, err := k.GetProposalsByProposer(UnwrapSDKContext(goCtx), req.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	proposals, err := k.loadProposalsPage(it, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryProposalsByProposerResponse{Proposals: proposals}, nil
+}
+
+func (k Keeper) loadProposalsPage(it orm.Iterator, p *PageRequest) ([]*types.Any, error) {
+	objs, err := loadPage(it, p.queryPagination(), func() orm.Persistent { return k.newProposalModel() })
+	if err != nil {
+		return nil, err
+	}
+	r := make([]*types.Any, len(objs))
+	for i, obj := range objs {
+		if r[i], err = marshalAny(obj); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// marshalAny packs the app specific proposal type.
+func marshalAny(obj orm.Persistent) (*types.Any, error) {
+	msg, ok := obj.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrType, "not a proto message: %T", obj)
+	}
+	any, err := types.MarshalAny(msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return any, nil
+}
+
+func (k Keeper) VoteByProposalVoter(goCtx context.Context, req *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error) {
+	if req.Voter.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "voter")
+	}
+	obj, err := k.GetVote(UnwrapSDKContext(goCtx), req.Proposal, req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryVoteByProposalVoterResponse{Vote: obj}, nil
+}
+
+func (k Keeper) VotesByProposal(goCtx context.Context, req *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error) {
+	it, err := k.GetVotesByProposal(UnwrapSDKContext(goCtx), req.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	votes, err := loadVotesPage(it, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryVotesByProposalResponse{Votes: votes}, nil
+}
+
+func (k Keeper) VotesByVoter(goCtx context.Context, req *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error) {
+	if req.Voter.Empty() {
+		return nil, sdkerrors.Wrap(ErrEmpty, "voter")
+	}
+	it, err := k.GetVotesByVoter(UnwrapSDKContext(goCtx), req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	votes, err := loadVotesPage(it, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryVotesByVoterResponse{Votes: votes}, nil
+}
+
+func loadVotesPage(it orm.Iterator, p *PageRequest) ([]Vote, error) {
+	objs, err := loadPage(it, p.queryPagination(), func() orm.Persistent { return &Vote{} })
+	if err != nil {
+		return nil, err
+	}
+	r := make([]Vote, len(objs))
+	for i, obj := range objs {
+		r[i] = *obj.(*Vote)
+	}
+	return r, nil
+}
+
+// queryPagination converts the page request. Nil is replaced by defaults.
+func (p *PageRequest) queryPagination() QueryPagination {
+	if p == nil {
+		return QueryPagination{}
+	}
+	return QueryPagination{Page: int(p.Page), Limit: int(p.Limit)}
+}
@@ -0,0 +1,98 @@
+package group
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+)
+
+// NewStoreDecoder returns a function that unmarshals the KVPair's values of the group store to the corresponding
+// group types. Proposals are decoded into the app specific proposal model of the keeper.
+func NewStoreDecoder(k Keeper) func(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
+	return func(_ *codec.Codec, kvA, kvB tmkv.Pair) string {
+		switch kvA.Key[0] {
+		case GroupTablePrefix:
+			var a, b GroupMetadata
+			mustUnmarshalSimValue(kvA.Value, &a)
+			mustUnmarshalSimValue(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case GroupMemberTablePrefix:
+			var a, b GroupMember
+			mustUnmarshalSimValue(kvA.Value, &a)
+			mustUnmarshalSimValue(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case GroupAccountTablePrefix:
+			var a, b StdGroupAccountMetadata
+			mustUnmarshalSimValue(kvA.Value, &a)
+			mustUnmarshalSimValue(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case ProposalBaseTablePrefix:
+			a, b := k.newProposalModel(), k.newProposalModel()
+			mustUnmarshalSimValue(kvA.Value, a)
+			mustUnmarshalSimValue(kvB.Value, b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case VoteTablePrefix:
+			var a, b Vote
+			mustUnmarshalSimValue(kvA.Value, &a)
+			mustUnmarshalSimValue(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case ElectorateSnapshotTablePrefix:
+			var a, b ElectorateSnapshot
+			mustUnmarshalSimValue(kvA.Value, &a)
+			mustUnmarshalSimValue(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case ElectorateSnapshotMemberTablePrefix:
+			var a, b ElectorateSnapshotMember
+			mustUnmarshalSimValue(kvA.Value, &a)
+			mustUnmarshalSimValue(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case ProposalExecutionTablePrefix:
+			var a, b ProposalExecution
+			mustUnmarshalSimValue(kvA.Value, &a)
+			mustUnmarshalSimValue(kvB.Value, &b)
+			return fmt.Sprintf("%v\n%v", a, b)
+
+		case TallyTotalPrefix:
+			var a, b sdk.Dec
+			if err := a.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := b.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%s\n%s", a, b)
+
+		case GroupTableSeqPrefix, GroupAccountTableSeqPrefix, ProposalBaseTableSeqPrefix:
+			return fmt.Sprintf("%d\n%d", orm.DecodeSequence(kvA.Value), orm.DecodeSequence(kvB.Value))
+
+		case GroupByAdminIndexPrefix,
+			GroupMemberByGroupIndexPrefix, GroupMemberByMemberIndexPrefix,
+			GroupAccountByGroupIndexPrefix, GroupAccountByAdminIndexPrefix,
+			ProposalBaseByGroupAccountIndexPrefix, ProposalBaseByProposerIndexPrefix,
+			ProposalBaseByTimeoutIndexPrefix, ProposalBaseFinalizedByTimeoutPrefix,
+			VoteByProposalIndexPrefix, VoteByVoterIndexPrefix,
+			ElectorateSnapshotMemberByProposalIndexPrefix:
+			// index entries carry their data in the key only
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid group key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
+
+func mustUnmarshalSimValue(bz []byte, obj orm.Persistent) {
+	if err := obj.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+}
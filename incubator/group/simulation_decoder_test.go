@@ -0,0 +1,92 @@
+package group_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/group/testdata"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	amino := codec.New()
+	pKey, pTKey := sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)
+	paramSpace := subspace.NewSubspace(amino, pKey, pTKey, group.DefaultParamspace)
+	k := group.NewGroupKeeper(sdk.NewKVStoreKey(group.StoreKeyName), paramSpace, baseapp.NewRouter(), &testdata.MyAppProposal{})
+	dec := group.NewStoreDecoder(k)
+
+	myAddr := sdk.AccAddress([]byte("my-address----------"))
+	g := group.GroupMetadata{Group: 1, Admin: myAddr, Comment: "foo", Version: 1, TotalWeight: sdk.OneDec()}
+	groupBz, err := g.Marshal()
+	require.NoError(t, err)
+	v := group.Vote{Proposal: 1, Voter: myAddr, Choice: group.Choice_YES}
+	voteBz, err := v.Marshal()
+	require.NoError(t, err)
+	s := group.ElectorateSnapshotMember{Proposal: 1, Member: myAddr, Weight: sdk.OneDec()}
+	snapshotBz, err := s.Marshal()
+	require.NoError(t, err)
+	e := group.ProposalExecution{Proposal: 1, ExecutorResult: group.ProposalExecutorResultFailure, FailedMsgIndex: -1, FailureReason: "foo"}
+	executionBz, err := e.Marshal()
+	require.NoError(t, err)
+	totalBz, err := sdk.NewDec(2).Marshal()
+	require.NoError(t, err)
+	p := testdata.MyAppProposal{Base: group.ProposalBase{GroupAccount: myAddr, Comment: "bar"}}
+	proposalBz, err := p.Marshal()
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		kv     tmkv.Pair
+		expStr string
+	}{
+		"group": {
+			kv:     tmkv.Pair{Key: append([]byte{group.GroupTablePrefix}, g.Group.Bytes()...), Value: groupBz},
+			expStr: fmt.Sprintf("%v\n%v", g, g),
+		},
+		"vote": {
+			kv:     tmkv.Pair{Key: append([]byte{group.VoteTablePrefix}, v.NaturalKey()...), Value: voteBz},
+			expStr: fmt.Sprintf("%v\n%v", v, v),
+		},
+		"proposal": {
+			kv:     tmkv.Pair{Key: append([]byte{group.ProposalBaseTablePrefix}, orm.EncodeSequence(1)...), Value: proposalBz},
+			expStr: fmt.Sprintf("%v\n%v", &p, &p),
+		},
+		"electorate snapshot member": {
+			kv:     tmkv.Pair{Key: append([]byte{group.ElectorateSnapshotMemberTablePrefix}, s.NaturalKey()...), Value: snapshotBz},
+			expStr: fmt.Sprintf("%v\n%v", s, s),
+		},
+		"proposal execution": {
+			kv:     tmkv.Pair{Key: append([]byte{group.ProposalExecutionTablePrefix}, e.NaturalKey()...), Value: executionBz},
+			expStr: fmt.Sprintf("%v\n%v", e, e),
+		},
+		"tally total": {
+			kv:     tmkv.Pair{Key: append([]byte{group.TallyTotalPrefix}, orm.EncodeSequence(1)...), Value: totalBz},
+			expStr: "2.000000000000000000\n2.000000000000000000",
+		},
+		"sequence": {
+			kv:     tmkv.Pair{Key: []byte{group.GroupTableSeqPrefix, 0x1}, Value: orm.EncodeSequence(7)},
+			expStr: "7\n7",
+		},
+		"index": {
+			kv:     tmkv.Pair{Key: []byte{group.VoteByVoterIndexPrefix, 0x1}, Value: []byte{}},
+			expStr: "4201\n4201",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.expStr, dec(amino, spec.kv, spec.kv))
+		})
+	}
+	t.Run("unknown prefix", func(t *testing.T) {
+		kv := tmkv.Pair{Key: []byte{0xff}, Value: []byte{}}
+		assert.Panics(t, func() { dec(amino, kv, kv) })
+	})
+}
@@ -0,0 +1,234 @@
+package group
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+)
+
+// Simulation parameter constants
+const (
+	SimParamMaxCommentLength     = "max_comment_length"
+	SimParamMaxEndBlockProposals = "max_end_block_proposals"
+	SimParamMaxAutoExecGas       = "max_auto_exec_gas"
+	SimParamProposalRetention    = "proposal_retention"
+	SimParamMaxVotingWindow      = "max_voting_window"
+	SimParamNumGroups            = "num_groups"
+)
+
+const maxSimGroupMembers = 5
+
+// GenMaxCommentLength randomized MaxCommentLength
+func GenMaxCommentLength(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 10, defaultMaxCommentLength+1))
+}
+
+// GenMaxEndBlockProposals randomized MaxEndBlockProposals
+func GenMaxEndBlockProposals(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 1, 2*defaultMaxEndBlockProposals))
+}
+
+// GenMaxAutoExecGas randomized MaxAutoExecGas
+func GenMaxAutoExecGas(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 10000, 2*int(defaultMaxAutoExecGas)))
+}
+
+// GenProposalRetention randomized ProposalRetention
+func GenProposalRetention(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*2)) * time.Second
+}
+
+// GenMaxVotingWindow randomized MaxVotingWindow
+func GenMaxVotingWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*2)) * time.Second
+}
+
+// RandomizedGenState generates a random GenesisState for the group module with groups of the simulation accounts.
+// Every group has a single group account with a threshold decision policy.
+func RandomizedGenState(simState *module.SimulationState) {
+	var params Params
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SimParamMaxCommentLength, &params.MaxCommentLength, simState.Rand,
+		func(r *rand.Rand) { params.MaxCommentLength = GenMaxCommentLength(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SimParamMaxEndBlockProposals, &params.MaxEndBlockProposals, simState.Rand,
+		func(r *rand.Rand) { params.MaxEndBlockProposals = GenMaxEndBlockProposals(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SimParamMaxAutoExecGas, &params.MaxAutoExecGas, simState.Rand,
+		func(r *rand.Rand) { params.MaxAutoExecGas = GenMaxAutoExecGas(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SimParamProposalRetention, &params.ProposalRetention, simState.Rand,
+		func(r *rand.Rand) { params.ProposalRetention = GenProposalRetention(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SimParamMaxVotingWindow, &params.MaxVotingWindow, simState.Rand,
+		func(r *rand.Rand) { params.MaxVotingWindow = GenMaxVotingWindow(r) },
+	)
+
+	var numGroups int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SimParamNumGroups, &numGroups, simState.Rand,
+		func(r *rand.Rand) { numGroups = r.Intn(10) },
+	)
+	if len(simState.Accounts) == 0 {
+		numGroups = 0
+	}
+
+	groups := make([]orm.Model, 0, numGroups)
+	members := make([]orm.Model, 0)
+	accounts := make([]orm.Model, 0, numGroups)
+	for i := 1; i <= numGroups; i++ {
+		r := simState.Rand
+		admin, _ := simulation.RandomAcc(r, simState.Accounts)
+		g := GroupMetadata{
+			Group:             GroupID(i),
+			Admin:             admin.Address,
+			Comment:           simulation.RandStringOfLength(r, r.Intn(int(params.MaxCommentLength)+1)),
+			Version:           1,
+			TotalWeight:       sdk.ZeroDec(),
+			MembershipVersion: 1,
+		}
+		for _, m := range randomSimMembers(r, simState.Accounts, params.MaxCommentLength) {
+			member := GroupMember{
+				Group:   g.Group,
+				Member:  m.Address,
+				Weight:  m.Power,
+				Comment: m.Comment,
+			}
+			members = appendGenesisModel(members, member.NaturalKey(), &member)
+			g.TotalWeight = g.TotalWeight.Add(m.Power)
+		}
+		groups = appendGenesisModel(groups, g.Group.Bytes(), &g)
+
+		var policy StdDecisionPolicy
+		if err := policy.SetDecisionPolicy(randomThresholdPolicy(r, g.TotalWeight, params.MaxVotingWindow)); err != nil {
+			panic(err)
+		}
+		account := StdGroupAccountMetadata{
+			Base: GroupAccountMetadataBase{
+				GroupAccount: AccountCondition(uint64(i)).Address(),
+				Group:        g.Group,
+				Admin:        g.Admin,
+				Comment:      simulation.RandStringOfLength(r, r.Intn(int(params.MaxCommentLength)+1)),
+				Version:      1,
+				AutoExec:     r.Intn(2) == 0,
+
+				SnapshotElectorate: r.Intn(2) == 0,
+			},
+			DecisionPolicy: policy,
+		}
+		accounts = appendGenesisModel(accounts, account.NaturalKey(), &account)
+	}
+
+	genesis := GenesisState{
+		Params:          params,
+		Groups:          mustMarshalGenesisModels(groups),
+		GroupSeq:        uint64(numGroups),
+		GroupMembers:    mustMarshalGenesisModels(members),
+		GroupAccounts:   mustMarshalGenesisModels(accounts),
+		GroupAccountSeq: uint64(numGroups),
+		Proposals:       json.RawMessage(`[]`),
+		Votes:           json.RawMessage(`[]`),
+
+		ElectorateSnapshots:       json.RawMessage(`[]`),
+		ElectorateSnapshotMembers: json.RawMessage(`[]`),
+		ProposalExecutions:        json.RawMessage(`[]`),
+	}
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, &genesis); err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated group parameters:\n%s\n", params)
+	simState.GenState[ModuleName] = buf.Bytes()
+}
+
+// RandomizedParams creates randomized group param changes for the simulator.
+func RandomizedParams(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(DefaultParamspace, string(ParamMaxCommentLength),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxCommentLength(r))
+			},
+		),
+		simulation.NewSimParamChange(DefaultParamspace, string(ParamMaxEndBlockProposals),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxEndBlockProposals(r))
+			},
+		),
+		simulation.NewSimParamChange(DefaultParamspace, string(ParamProposalRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf(`"%d"`, GenProposalRetention(r))
+			},
+		),
+	}
+}
+
+// randomSimMembers returns a random, non empty set of distinct simulation accounts with random weights.
+func randomSimMembers(r *rand.Rand, accs []simulation.Account, maxCommentLength uint32) []Member {
+	n := simulation.RandIntBetween(r, 1, maxSimGroupMembers+1)
+	if n > len(accs) {
+		n = len(accs)
+	}
+	members := make([]Member, n)
+	for i, j := range r.Perm(len(accs))[:n] {
+		members[i] = Member{
+			Address: accs[j].Address,
+			Power:   sdk.NewDec(int64(simulation.RandIntBetween(r, 1, 10))),
+			Comment: simulation.RandStringOfLength(r, r.Intn(int(maxCommentLength)+1)),
+		}
+	}
+	return members
+}
+
+// randomThresholdPolicy returns a threshold decision policy that can be reached with the given total weight and
+// a timeout within the max voting window.
+func randomThresholdPolicy(r *rand.Rand, totalWeight sdk.Dec, maxVotingWindow time.Duration) *ThresholdDecisionPolicy {
+	maxThreshold := totalWeight.TruncateInt64()
+	if maxThreshold < 1 {
+		maxThreshold = 1
+	}
+	timeout, minExecutionPeriod := randomVotingPeriods(r, maxVotingWindow)
+	return &ThresholdDecisionPolicy{
+		Threshold:          sdk.NewDec(r.Int63n(maxThreshold) + 1),
+		Timout:             timeout,
+		MinExecutionPeriod: minExecutionPeriod,
+	}
+}
+
+// randomVotingPeriods returns a random timeout within the max voting window and a min execution period that
+// ends before the timeout.
+func randomVotingPeriods(r *rand.Rand, maxVotingWindow time.Duration) (types.Duration, types.Duration) {
+	timeout := time.Duration(simulation.RandIntBetween(r, 1, int(maxVotingWindow/time.Second)+1)) * time.Second
+	minExecutionPeriod := time.Duration(r.Int63n(int64(timeout / 2)))
+	return *types.DurationProto(timeout), *types.DurationProto(minExecutionPeriod)
+}
+
+// appendGenesisModel appends the json encoded object with the given row id in the format of `orm.ExportTableData`.
+func appendGenesisModel(models []orm.Model, rowID orm.RowID, obj proto.Message) []orm.Model {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, obj); err != nil {
+		panic(err)
+	}
+	return append(models, orm.Model{Key: rowID, Value: buf.Bytes()})
+}
+
+func mustMarshalGenesisModels(models []orm.Model) json.RawMessage {
+	bz, err := json.Marshal(models)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
@@ -0,0 +1,412 @@
+package group
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateGroup        = "op_weight_msg_create_group"
+	OpWeightMsgUpdateGroupMembers = "op_weight_msg_update_group_members"
+	OpWeightMsgCreateGroupAccount = "op_weight_msg_create_group_account"
+	OpWeightMsgVote               = "op_weight_msg_group_vote"
+	OpWeightMsgExec               = "op_weight_msg_group_exec"
+	OpWeightMsgWithdrawProposal   = "op_weight_msg_group_withdraw_proposal"
+)
+
+// Default simulation operation weights
+const (
+	DefaultWeightMsgCreateGroup        = 20
+	DefaultWeightMsgUpdateGroupMembers = 10
+	DefaultWeightMsgCreateGroupAccount = 20
+	DefaultWeightMsgVote               = 80
+	DefaultWeightMsgExec               = 20
+	DefaultWeightMsgWithdrawProposal   = 5
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights. Proposals are
+// app specific and must be simulated by the app module that defines the `MsgPropose` type.
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak AccountKeeper, bk BankKeeper, k Keeper) simulation.WeightedOperations {
+	var (
+		weightMsgCreateGroup        int
+		weightMsgUpdateGroupMembers int
+		weightMsgCreateGroupAccount int
+		weightMsgVote               int
+		weightMsgExec               int
+		weightMsgWithdrawProposal   int
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroup, &weightMsgCreateGroup, nil,
+		func(_ *rand.Rand) { weightMsgCreateGroup = DefaultWeightMsgCreateGroup },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateGroupMembers, &weightMsgUpdateGroupMembers, nil,
+		func(_ *rand.Rand) { weightMsgUpdateGroupMembers = DefaultWeightMsgUpdateGroupMembers },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroupAccount, &weightMsgCreateGroupAccount, nil,
+		func(_ *rand.Rand) { weightMsgCreateGroupAccount = DefaultWeightMsgCreateGroupAccount },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) { weightMsgVote = DefaultWeightMsgVote },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) { weightMsgExec = DefaultWeightMsgExec },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawProposal, &weightMsgWithdrawProposal, nil,
+		func(_ *rand.Rand) { weightMsgWithdrawProposal = DefaultWeightMsgWithdrawProposal },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateGroup, SimulateMsgCreateGroup(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgUpdateGroupMembers, SimulateMsgUpdateGroupMembers(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgCreateGroupAccount, SimulateMsgCreateGroupAccountStd(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgVote, SimulateMsgVote(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgExec, SimulateMsgExec(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgWithdrawProposal, SimulateMsgWithdrawProposal(ak, bk, k)),
+	}
+}
+
+// SimulateMsgCreateGroup generates a MsgCreateGroup with a random admin and random members.
+func SimulateMsgCreateGroup(ak AccountKeeper, bk BankKeeper, k Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		admin, _ := simulation.RandomAcc(r, accs)
+		maxCommentSize := k.MaxCommentSize(ctx)
+		msg := MsgCreateGroup{
+			Admin:   admin.Address,
+			Members: randomSimMembers(r, accs, uint32(maxCommentSize)),
+			Comment: simulation.RandStringOfLength(r, r.Intn(maxCommentSize+1)),
+		}
+		return deliverSimTx(r, app, ctx, ak, bk, msg, admin, chainID)
+	}
+}
+
+// SimulateMsgUpdateGroupMembers generates a MsgUpdateGroupMembers for a random group of a simulation account that
+// adds, updates or removes random members.
+func SimulateMsgUpdateGroupMembers(ak AccountKeeper, bk BankKeeper, k Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		g, admin, ok := randomSimGroup(r, ctx, k, accs)
+		if !ok {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		it, err := k.GetGroupMembersByGroup(ctx, g.Group)
+		if err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		var members []GroupMember
+		if _, err := orm.ReadAll(it, &members); err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+
+		maxCommentSize := k.MaxCommentSize(ctx)
+		updates := randomSimMembers(r, accs, uint32(maxCommentSize))
+		if len(members) != 0 && r.Intn(3) == 0 {
+			// remove an existing member that is not part of the other updates
+			removed := members[r.Intn(len(members))]
+			updates = append(removeSimMember(updates, removed.Member), Member{
+				Address: removed.Member,
+				Power:   sdk.ZeroDec(),
+			})
+		}
+		msg := MsgUpdateGroupMembers{
+			Admin:         admin.Address,
+			Group:         g.Group,
+			MemberUpdates: updates,
+		}
+		return deliverSimTx(r, app, ctx, ak, bk, msg, admin, chainID)
+	}
+}
+
+// SimulateMsgCreateGroupAccountStd generates a MsgCreateGroupAccountStd with a random decision policy for a random
+// group of a simulation account.
+func SimulateMsgCreateGroupAccountStd(ak AccountKeeper, bk BankKeeper, k Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		g, admin, ok := randomSimGroup(r, ctx, k, accs)
+		if !ok {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		var policy StdDecisionPolicy
+		if err := policy.SetDecisionPolicy(randomDecisionPolicy(r, g.TotalWeight, k.MaxVotingWindow(ctx))); err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		msg := MsgCreateGroupAccountStd{
+			Base: MsgCreateGroupAccountBase{
+				Admin:              admin.Address,
+				Group:              g.Group,
+				Comment:            simulation.RandStringOfLength(r, r.Intn(k.MaxCommentSize(ctx)+1)),
+				AutoExec:           r.Intn(2) == 0,
+				SnapshotElectorate: r.Intn(2) == 0,
+			},
+			DecisionPolicy: policy,
+		}
+		return deliverSimTx(r, app, ctx, ak, bk, msg, admin, chainID)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote with a random choice by a group member of a random open proposal.
+func SimulateMsgVote(ak AccountKeeper, bk BankKeeper, k Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		id, base, ok := randomSimProposal(r, ctx, k, func(base ProposalBase) bool {
+			return base.Status == ProposalStatusSubmitted && ctx.BlockTime().Before(simTimestamp(base.Timeout))
+		})
+		if !ok {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		account, err := k.GetGroupAccount(ctx, base.GroupAccount)
+		if err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		electorate, err := k.loadElectorate(ctx, id, base, account.Base.Group)
+		if account.Base.Version != base.GroupAccountVersion || ErrModified.Is(err) {
+			// modified electorate or decision policy, proposal does not accept votes anymore
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		if err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		members, err := simElectorateMembers(ctx, k, electorate)
+		if err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		voters := make([]simulation.Account, 0, len(members))
+		for _, m := range members {
+			if acc, ok := simulation.FindAccount(accs, m); ok {
+				voters = append(voters, acc)
+			}
+		}
+		if len(voters) == 0 {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		voter := voters[r.Intn(len(voters))]
+		msg := MsgVote{
+			Proposal: id,
+			Voters:   []sdk.AccAddress{voter.Address},
+			Choice:   Choice(simulation.RandIntBetween(r, int(Choice_NO), int(Choice_VETO)+1)),
+			Comment:  simulation.RandStringOfLength(r, r.Intn(k.MaxCommentSize(ctx)+1)),
+		}
+		return deliverSimTx(r, app, ctx, ak, bk, msg, voter, chainID)
+	}
+}
+
+// SimulateMsgExec generates a MsgExec by a random simulation account for a random proposal that was not
+// finalized, yet.
+func SimulateMsgExec(ak AccountKeeper, bk BankKeeper, k Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		id, _, ok := randomSimProposal(r, ctx, k, func(base ProposalBase) bool {
+			return base.Status == ProposalStatusSubmitted || base.Status == ProposalStatusClosed
+		})
+		if !ok {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		signer, _ := simulation.RandomAcc(r, accs)
+		msg := MsgExec{
+			Proposal: id,
+			Signer:   signer.Address,
+		}
+		return deliverSimTx(r, app, ctx, ak, bk, msg, signer, chainID)
+	}
+}
+
+// SimulateMsgWithdrawProposal generates a MsgWithdrawProposal for a random open proposal signed by one of the
+// proposers or the group account admin.
+func SimulateMsgWithdrawProposal(ak AccountKeeper, bk BankKeeper, k Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		id, base, ok := randomSimProposal(r, ctx, k, func(base ProposalBase) bool {
+			return base.Status == ProposalStatusSubmitted && ctx.BlockTime().Before(simTimestamp(base.Timeout))
+		})
+		if !ok {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		account, err := k.GetGroupAccount(ctx, base.GroupAccount)
+		if err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		candidates := make([]simulation.Account, 0, len(base.Proposers)+1)
+		for _, addr := range append([]sdk.AccAddress{account.Base.Admin}, base.Proposers...) {
+			if acc, ok := simulation.FindAccount(accs, addr); ok {
+				candidates = append(candidates, acc)
+			}
+		}
+		if len(candidates) == 0 {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		signer := candidates[r.Intn(len(candidates))]
+		msg := MsgWithdrawProposal{
+			Proposal: id,
+			Signer:   signer.Address,
+		}
+		return deliverSimTx(r, app, ctx, ak, bk, msg, signer, chainID)
+	}
+}
+
+// deliverSimTx signs the msg with the simulation account, pays random fees and delivers the tx.
+func deliverSimTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak AccountKeeper, bk BankKeeper,
+	msg sdk.Msg, simAccount simulation.Account, chainID string,
+) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return simulation.NoOpMsg(ModuleName), nil, nil
+	}
+	fees, err := simulation.RandomFees(r, ctx, bk.SpendableCoins(ctx, account.GetAddress()))
+	if err != nil {
+		return simulation.NoOpMsg(ModuleName), nil, err
+	}
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if _, _, err := app.Deliver(tx); err != nil {
+		return simulation.NoOpMsg(ModuleName), nil, err
+	}
+	return simulation.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// randomSimGroup returns a random group that is administrated by one of the simulation accounts.
+func randomSimGroup(r *rand.Rand, ctx sdk.Context, k Keeper, accs []simulation.Account) (GroupMetadata, simulation.Account, bool) {
+	it, err := k.groupTable.PrefixScan(ctx, 1, math.MaxUint64)
+	if err != nil {
+		panic(err)
+	}
+	var all []GroupMetadata
+	if _, err := orm.ReadAll(it, &all); err != nil {
+		panic(err)
+	}
+	var groups []GroupMetadata
+	var admins []simulation.Account
+	for _, g := range all {
+		if acc, ok := simulation.FindAccount(accs, g.Admin); ok {
+			groups = append(groups, g)
+			admins = append(admins, acc)
+		}
+	}
+	if len(groups) == 0 {
+		return GroupMetadata{}, simulation.Account{}, false
+	}
+	i := r.Intn(len(groups))
+	return groups[i], admins[i], true
+}
+
+// randomSimProposal returns a random proposal that matches the filter.
+func randomSimProposal(r *rand.Rand, ctx sdk.Context, k Keeper, filter func(ProposalBase) bool) (ProposalID, ProposalBase, bool) {
+	it, err := k.proposalTable.PrefixScan(ctx, 1, math.MaxUint64)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+	var ids []ProposalID
+	var bases []ProposalBase
+	for {
+		p := k.newProposalModel()
+		rowID, err := it.LoadNext(p)
+		switch {
+		case orm.ErrIteratorDone.Is(err):
+			if len(ids) == 0 {
+				return 0, ProposalBase{}, false
+			}
+			i := r.Intn(len(ids))
+			return ids[i], bases[i], true
+		case err != nil:
+			panic(err)
+		}
+		if base := p.GetBase(); filter(base) {
+			ids = append(ids, ProposalID(orm.DecodeSequence(rowID)))
+			bases = append(bases, base)
+		}
+	}
+}
+
+// randomDecisionPolicy returns one of the `StdDecisionPolicy` types with random values.
+func randomDecisionPolicy(r *rand.Rand, totalWeight sdk.Dec, maxVotingWindow time.Duration) DecisionPolicy {
+	switch r.Intn(3) {
+	case 0:
+		return randomThresholdPolicy(r, totalWeight, maxVotingWindow)
+	case 1:
+		timeout, minExecutionPeriod := randomVotingPeriods(r, maxVotingWindow)
+		return &PercentageDecisionPolicy{
+			Percentage:         sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2),
+			Timout:             timeout,
+			MinExecutionPeriod: minExecutionPeriod,
+		}
+	default:
+		timeout, minExecutionPeriod := randomVotingPeriods(r, maxVotingWindow)
+		return &QuorumDecisionPolicy{
+			Quorum:             sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2),
+			Threshold:          sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 100)), 2),
+			VetoThreshold:      sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2),
+			Timout:             timeout,
+			MinExecutionPeriod: minExecutionPeriod,
+		}
+	}
+}
+
+// removeSimMember returns the members without the given address.
+func removeSimMember(members []Member, addr sdk.AccAddress) []Member {
+	r := make([]Member, 0, len(members))
+	for _, m := range members {
+		if !m.Address.Equals(addr) {
+			r = append(r, m)
+		}
+	}
+	return r
+}
+
+func simTimestamp(t types.Timestamp) time.Time {
+	r, err := types.TimestampFromProto(&t)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// simElectorateMembers returns the addresses of all members of the electorate.
+func simElectorateMembers(ctx sdk.Context, k Keeper, e electorate) ([]sdk.AccAddress, error) {
+	if e.snapshot {
+		members, err := k.GetElectorateSnapshotMembers(ctx, e.proposal)
+		if err != nil {
+			return nil, err
+		}
+		r := make([]sdk.AccAddress, len(members))
+		for i := range members {
+			r[i] = members[i].Member
+		}
+		return r, nil
+	}
+	it, err := k.GetGroupMembersByGroup(ctx, e.group)
+	if err != nil {
+		return nil, err
+	}
+	var members []GroupMember
+	if _, err := orm.ReadAll(it, &members); err != nil {
+		return nil, err
+	}
+	r := make([]sdk.AccAddress, len(members))
+	for i := range members {
+		r[i] = members[i].Member
+	}
+	return r, nil
+}
@@ -0,0 +1,42 @@
+package group_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/modules/incubator/group/testdata"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation runs the multi block simulation with the group module operations. It is skipped unless
+// enabled:
+//
+//	go test -run TestFullAppSimulation -Enabled=true -Commit=true -NumBlocks=100 -BlockSize=100 -Period=1 -v
+func TestFullAppSimulation(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-group-sim", "Simulation")
+	if skip {
+		t.Skip("skipping group simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	app := testdata.NewSimApp(logger, db, nil, true, map[int64]bool{}, simapp.FlagPeriodValue, func(bapp *baseapp.BaseApp) {
+		bapp.SetFauxMerkleMode()
+	})
+	_, _, simErr := simulation.SimulateFromSeed(
+		t, os.Stdout, app.BaseApp, simapp.AppStateFn(app.Codec(), app.SimulationManager()),
+		simapp.SimulationOperations(app, app.Codec(), config),
+		app.ModuleAccountAddrs(), config,
+	)
+	require.NoError(t, simErr)
+}
@@ -28,6 +28,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgAlwaysFail{}, "testdata/MsgAlwaysFail", nil)
 	cdc.RegisterConcrete(&MyAppMsg_A{}, "testdata/MyAppMsg_A", nil)
 	cdc.RegisterConcrete(&MyAppMsg_B{}, "testdata/MyAppMsg_B", nil)
+	cdc.RegisterConcrete(&MyAppMsg_G{}, "testdata/MyAppMsg_G", nil)
 }
 
 // generic sealed codec to be used throughout module
@@ -2,10 +2,14 @@ package testdata
 
 import (
 	"encoding/json"
+	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/modules/incubator/group"
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -13,13 +17,19 @@ import (
 
 const ModuleName = "testdata"
 
+var _ module.AppModuleSimulation = AppModule{}
+
 type AppModule struct {
-	keeper Keeper
+	keeper        Keeper
+	accountKeeper group.AccountKeeper
+	bankKeeper    group.BankKeeper
 }
 
-func NewAppModule(keeper Keeper) AppModule {
+func NewAppModule(keeper Keeper, accountKeeper group.AccountKeeper, bankKeeper group.BankKeeper) AppModule {
 	return AppModule{
-		keeper: keeper,
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
 
@@ -84,3 +94,19 @@ func (a AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
 func (a AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return nil
 }
+
+func (a AppModule) GenerateGenesisState(*module.SimulationState) {}
+
+func (a AppModule) ProposalContents(module.SimulationState) []simulation.WeightedProposalContent {
+	return nil
+}
+
+func (a AppModule) RandomizedParams(*rand.Rand) []simulation.ParamChange {
+	return nil
+}
+
+func (a AppModule) RegisterStoreDecoder(sdk.StoreDecoderRegistry) {}
+
+func (a AppModule) WeightedOperations(simState module.SimulationState) []simulation.WeightedOperation {
+	return WeightedOperations(simState.AppParams, simState.Cdc, a.accountKeeper, a.bankKeeper, a.keeper)
+}
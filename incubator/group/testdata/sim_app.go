@@ -243,8 +243,8 @@ func NewSimApp(
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
-		group.NewAppModule(app.GroupKeeper),
-		NewAppModule(app.TestdataKeeper),
+		group.NewAppModule(app.GroupKeeper, app.AccountKeeper, app.BankKeeper),
+		NewAppModule(app.TestdataKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -279,6 +279,8 @@ func NewSimApp(
 		distr.NewAppModule(app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.StakingKeeper),
 		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(), // NOTE: only used for simulation to generate randomized param change proposals
+		group.NewAppModule(app.GroupKeeper, app.AccountKeeper, app.BankKeeper),
+		NewAppModule(app.TestdataKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -0,0 +1,128 @@
+package testdata
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/modules/incubator/group"
+	"github.com/cosmos/modules/incubator/orm"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgPropose = "op_weight_msg_testdata_propose"
+
+	DefaultWeightMsgPropose = 50
+)
+
+// WeightedOperations returns the proposal operation of the testdata module.
+func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak group.AccountKeeper, bk group.BankKeeper, k Keeper) simulation.WeightedOperations {
+	var weightMsgPropose int
+	appParams.GetOrGenerate(cdc, OpWeightMsgPropose, &weightMsgPropose, nil,
+		func(_ *rand.Rand) { weightMsgPropose = DefaultWeightMsgPropose },
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgPropose, SimulateMsgPropose(ak, bk, k)),
+	}
+}
+
+// SimulateMsgPropose generates a MsgPropose by a random group member for one of the accounts of its groups. The
+// payload randomly succeeds or fails on execution.
+func SimulateMsgPropose(ak group.AccountKeeper, bk group.BankKeeper, k Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		var proposer simulation.Account
+		var accounts []sdk.AccAddress
+		for _, i := range r.Perm(len(accs)) {
+			var err error
+			if accounts, err = proposableGroupAccounts(ctx, k.groupKeeper, accs[i].Address); err != nil {
+				return simulation.NoOpMsg(ModuleName), nil, err
+			}
+			if len(accounts) != 0 {
+				proposer = accs[i]
+				break
+			}
+		}
+		if len(accounts) == 0 {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		msgs := make([]MyAppMsg, simulation.RandIntBetween(r, 1, 4))
+		for i := range msgs {
+			if r.Intn(4) == 0 {
+				msgs[i] = MyAppMsg{Sum: &MyAppMsg_B{B: &MsgAlwaysFail{}}}
+			} else {
+				msgs[i] = MyAppMsg{Sum: &MyAppMsg_A{A: &MsgAlwaysSucceed{}}}
+			}
+		}
+		msg := MsgPropose{
+			Base: group.MsgProposeBase{
+				GroupAccount: accounts[r.Intn(len(accounts))],
+				Proposers:    []sdk.AccAddress{proposer.Address},
+				Comment:      simulation.RandStringOfLength(r, r.Intn(k.groupKeeper.MaxCommentSize(ctx)+1)),
+			},
+			Msgs: msgs,
+		}
+
+		account := ak.GetAccount(ctx, proposer.Address)
+		if account == nil {
+			return simulation.NoOpMsg(ModuleName), nil, nil
+		}
+		fees, err := simulation.RandomFees(r, ctx, bk.SpendableCoins(ctx, account.GetAddress()))
+		if err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			proposer.PrivKey,
+		)
+		if _, _, err := app.Deliver(tx); err != nil {
+			return simulation.NoOpMsg(ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// proposableGroupAccounts returns the addresses of all group accounts of the groups the member belongs to. Accounts
+// with a threshold that can not be reached by the group are excluded.
+func proposableGroupAccounts(ctx sdk.Context, k group.Keeper, member sdk.AccAddress) ([]sdk.AccAddress, error) {
+	it, err := k.GetGroupMembershipsByMember(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+	var memberships []group.GroupMember
+	if _, err := orm.ReadAll(it, &memberships); err != nil {
+		return nil, err
+	}
+	var r []sdk.AccAddress
+	for _, m := range memberships {
+		g, err := k.GetGroup(ctx, m.Group)
+		if err != nil {
+			return nil, err
+		}
+		it, err := k.GetGroupAccountsByGroup(ctx, m.Group)
+		if err != nil {
+			return nil, err
+		}
+		var accounts []group.StdGroupAccountMetadata
+		if _, err := orm.ReadAll(it, &accounts); err != nil {
+			return nil, err
+		}
+		for _, a := range accounts {
+			if p := a.DecisionPolicy.GetThreshold(); p != nil && p.Threshold.GT(g.TotalWeight) {
+				continue
+			}
+			r = append(r, a.Base.GroupAccount)
+		}
+	}
+	return r, nil
+}
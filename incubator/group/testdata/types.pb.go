@@ -35,6 +35,7 @@ type MyAppMsg struct {
 	//	*MyAppMsg_D
 	//	*MyAppMsg_E
 	//	*MyAppMsg_F
+	//	*MyAppMsg_G
 	Sum isMyAppMsg_Sum `protobuf_oneof:"sum"`
 }
 
@@ -95,6 +96,9 @@ type MyAppMsg_E struct {
 type MyAppMsg_F struct {
 	F *MsgAuthenticate `protobuf:"bytes,6,opt,name=F,proto3,oneof" json:"F,omitempty"`
 }
+type MyAppMsg_G struct {
+	G *group.MsgVote `protobuf:"bytes,7,opt,name=G,proto3,oneof" json:"G,omitempty"`
+}
 
 func (*MyAppMsg_A) isMyAppMsg_Sum() {}
 func (*MyAppMsg_B) isMyAppMsg_Sum() {}
@@ -102,6 +106,7 @@ func (*MyAppMsg_C) isMyAppMsg_Sum() {}
 func (*MyAppMsg_D) isMyAppMsg_Sum() {}
 func (*MyAppMsg_E) isMyAppMsg_Sum() {}
 func (*MyAppMsg_F) isMyAppMsg_Sum() {}
+func (*MyAppMsg_G) isMyAppMsg_Sum() {}
 
 func (m *MyAppMsg) GetSum() isMyAppMsg_Sum {
 	if m != nil {
@@ -152,6 +157,13 @@ func (m *MyAppMsg) GetF() *MsgAuthenticate {
 	return nil
 }
 
+func (m *MyAppMsg) GetG() *group.MsgVote {
+	if x, ok := m.GetSum().(*MyAppMsg_G); ok {
+		return x.G
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MyAppMsg) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -161,6 +173,7 @@ func (*MyAppMsg) XXX_OneofWrappers() []interface{} {
 		(*MyAppMsg_D)(nil),
 		(*MyAppMsg_E)(nil),
 		(*MyAppMsg_F)(nil),
+		(*MyAppMsg_G)(nil),
 	}
 }
 
@@ -515,43 +528,44 @@ func init() {
 func init() { proto.RegisterFile("testdata/types.proto", fileDescriptor_2447ab8d7bf628b8) }
 
 var fileDescriptor_2447ab8d7bf628b8 = []byte{
-	// 570 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0x8d, 0x53, 0xe0, 0x42, 0x09, 0x9c, 0x32, 0x58, 0x1d, 0x9c, 0xc8, 0x0c, 0x44,
-	0x54, 0x71, 0x68, 0x10, 0x42, 0x8a, 0x84, 0xc0, 0x4e, 0x1b, 0x81, 0xc0, 0x6a, 0xeb, 0x22, 0x06,
-	0x06, 0xa2, 0x8b, 0x7d, 0x72, 0x2c, 0x1c, 0x9f, 0xe5, 0x3b, 0x17, 0xf2, 0x06, 0x8c, 0x3c, 0x02,
-	0x23, 0x0f, 0x00, 0x1b, 0x0f, 0x50, 0x31, 0x75, 0x64, 0xaa, 0x50, 0xf2, 0x16, 0x4c, 0xc8, 0x97,
-	0x0b, 0x4d, 0xc2, 0x12, 0x52, 0x89, 0xcd, 0xf7, 0xc9, 0xf7, 0xfb, 0xfe, 0xdf, 0xf7, 0xff, 0xeb,
-	0x60, 0x85, 0x13, 0xc6, 0x7d, 0xcc, 0x71, 0x93, 0x8f, 0x12, 0xc2, 0xcc, 0x24, 0xa5, 0x9c, 0xa2,
-	0x7b, 0x1e, 0x65, 0x43, 0xca, 0x7a, 0x43, 0xea, 0x67, 0x11, 0x61, 0x66, 0x18, 0x7b, 0x59, 0x1f,
-	0x73, 0x9a, 0x9a, 0x41, 0x4a, 0xb3, 0xc4, 0x3c, 0xd9, 0xed, 0xe1, 0x28, 0x19, 0x60, 0x73, 0x76,
-	0x7b, 0x7b, 0x87, 0x0f, 0xc2, 0xd4, 0xef, 0x25, 0x38, 0xe5, 0xa3, 0xa6, 0x80, 0x34, 0xa7, 0x8c,
-	0xc6, 0xfc, 0x61, 0x8a, 0xdf, 0xae, 0x04, 0x34, 0xa0, 0xd3, 0x7a, 0xfe, 0x25, 0xab, 0xb7, 0x04,
-	0x7b, 0x5e, 0x87, 0xf1, 0x59, 0x85, 0x57, 0x9d, 0x91, 0x95, 0x24, 0x0e, 0x0b, 0x90, 0x0b, 0x81,
-	0xa5, 0x81, 0x1a, 0xa8, 0x97, 0x5a, 0xb6, 0xf9, 0xaf, 0x02, 0x4d, 0x87, 0x05, 0x56, 0xf4, 0x0e,
-	0x8f, 0xd8, 0x71, 0xe6, 0x79, 0x84, 0xf8, 0x4f, 0x15, 0x17, 0x58, 0xe8, 0x00, 0x02, 0x5b, 0xdb,
-	0x10, 0xcc, 0xc7, 0x97, 0x60, 0x76, 0x71, 0x18, 0xe5, 0x40, 0x1b, 0x39, 0x10, 0x74, 0xb4, 0x82,
-	0x00, 0x3e, 0x5a, 0x0b, 0x78, 0x4c, 0xf8, 0x2b, 0x1c, 0x65, 0x24, 0xc7, 0x75, 0x72, 0x7d, 0x7b,
-	0x9a, 0x7a, 0x09, 0x7d, 0xcf, 0x62, 0xaf, 0x43, 0xb3, 0x98, 0x93, 0x34, 0x07, 0xee, 0xa1, 0x43,
-	0x08, 0xf6, 0xb5, 0xa2, 0x00, 0x3e, 0x59, 0x0b, 0xd8, 0xa1, 0xb1, 0x1f, 0xf2, 0x90, 0xc6, 0x58,
-	0x4c, 0xbc, 0x8f, 0x8e, 0x20, 0xe8, 0x6a, 0x9b, 0x82, 0x68, 0xad, 0xb7, 0xc2, 0x8c, 0x0f, 0x48,
-	0xcc, 0x43, 0x0f, 0x73, 0x31, 0x75, 0xb7, 0xbd, 0xf3, 0xfd, 0x4b, 0xe3, 0xce, 0xdd, 0x20, 0xe4,
-	0x83, 0xac, 0x6f, 0x7a, 0x74, 0x28, 0xd3, 0x33, 0x4b, 0x14, 0xf3, 0xdf, 0xca, 0x94, 0x38, 0x2c,
-	0xb0, 0x8b, 0xb0, 0xc0, 0xb2, 0xa1, 0xf1, 0x0d, 0xc0, 0x2d, 0x11, 0x95, 0xc3, 0x94, 0x26, 0x94,
-	0xe1, 0x08, 0x1d, 0x40, 0xb5, 0x8f, 0x19, 0x91, 0x91, 0x79, 0xb0, 0xb2, 0xb6, 0x19, 0xc0, 0xc6,
-	0x8c, 0xd8, 0xea, 0xe9, 0x79, 0x55, 0x71, 0x05, 0x08, 0xbd, 0x84, 0xea, 0x90, 0x05, 0x4c, 0xdb,
-	0xa8, 0x15, 0xea, 0xa5, 0x56, 0x7b, 0x8d, 0x61, 0x65, 0x94, 0x67, 0xd4, 0x9c, 0xd6, 0x56, 0x3f,
-	0x7c, 0xaa, 0x2a, 0x06, 0x82, 0x37, 0x97, 0x13, 0x6a, 0x94, 0xe1, 0xd6, 0x42, 0xc2, 0x8c, 0xdb,
-	0xb0, 0x34, 0x97, 0x10, 0x54, 0x81, 0xc5, 0x93, 0xfc, 0x43, 0x4c, 0x78, 0xcd, 0x9d, 0x1e, 0xe4,
-	0xad, 0x0b, 0xdf, 0x8d, 0x36, 0xbc, 0xb1, 0xe8, 0x1b, 0xaa, 0xc3, 0x32, 0x79, 0x9f, 0x10, 0x8f,
-	0x13, 0x5f, 0xfe, 0x24, 0x10, 0xaa, 0xbb, 0x5c, 0x36, 0xde, 0xc0, 0xf2, 0x92, 0x43, 0xe8, 0x39,
-	0xbc, 0xc2, 0xc2, 0x20, 0x26, 0x29, 0xd3, 0x40, 0xad, 0x50, 0xbf, 0x6e, 0xef, 0xfe, 0x3a, 0xaf,
-	0x36, 0x56, 0x30, 0xcb, 0xf2, 0x3c, 0xcb, 0xf7, 0x53, 0xc2, 0x98, 0x3b, 0x23, 0x18, 0x5f, 0x01,
-	0x84, 0x0e, 0x0b, 0xa6, 0x2b, 0x27, 0xe8, 0x68, 0xc1, 0xb2, 0x87, 0x2b, 0x6f, 0xf8, 0x02, 0xf1,
-	0x7f, 0x4c, 0xb3, 0x5f, 0x9c, 0x8e, 0x75, 0x70, 0x36, 0xd6, 0xc1, 0xcf, 0xb1, 0x0e, 0x3e, 0x4e,
-	0x74, 0xe5, 0x6c, 0xa2, 0x2b, 0x3f, 0x26, 0xba, 0xf2, 0xba, 0xf5, 0xf7, 0x26, 0x64, 0xaf, 0xe6,
-	0x9f, 0x5e, 0x4d, 0xf9, 0xd2, 0xc9, 0x16, 0xfd, 0x4d, 0xf1, 0xda, 0xdd, 0xff, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0x1a, 0xc9, 0xa3, 0xb8, 0x8d, 0x05, 0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xd4, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0x07, 0x70, 0x5f, 0xe3, 0xb6, 0x70, 0xa1, 0x04, 0x4e, 0x19, 0xac, 0x0e, 0x4e, 0x64, 0x06,
+	0x22, 0xaa, 0x38, 0x6d, 0x10, 0x42, 0x8a, 0x84, 0xa8, 0x9d, 0x36, 0x05, 0x81, 0xd5, 0xd6, 0x45,
+	0x1d, 0x18, 0x88, 0x2e, 0xf6, 0xc9, 0xb1, 0x70, 0x7c, 0x96, 0xef, 0x5c, 0xc8, 0x1b, 0x30, 0xf2,
+	0x08, 0x3c, 0x04, 0x6c, 0x3c, 0x40, 0xc5, 0xd4, 0x91, 0xa9, 0x42, 0xc9, 0xc6, 0x23, 0x30, 0x21,
+	0x9f, 0x1d, 0x48, 0xc2, 0x92, 0xa6, 0x12, 0x9b, 0x7d, 0x8a, 0x7f, 0xf7, 0xdd, 0xf7, 0xfd, 0x73,
+	0xb0, 0xcc, 0x09, 0xe3, 0x2e, 0xe6, 0xb8, 0xc1, 0x87, 0x11, 0x61, 0x7a, 0x14, 0x53, 0x4e, 0xd1,
+	0xb6, 0x43, 0xd9, 0x80, 0xb2, 0xee, 0x80, 0xba, 0x49, 0x40, 0x98, 0xee, 0x87, 0x4e, 0xd2, 0xc3,
+	0x9c, 0xc6, 0xba, 0x17, 0xd3, 0x24, 0xd2, 0xcf, 0x76, 0xba, 0x38, 0x88, 0xfa, 0x58, 0x9f, 0x7c,
+	0xbd, 0xb9, 0xc5, 0xfb, 0x7e, 0xec, 0x76, 0x23, 0x1c, 0xf3, 0x61, 0x43, 0x20, 0x8d, 0xcc, 0xa8,
+	0x4f, 0xbf, 0x64, 0xfc, 0x66, 0xd9, 0xa3, 0x1e, 0xcd, 0xd6, 0xd3, 0xa7, 0x7c, 0xf5, 0xae, 0xb0,
+	0xa7, 0xeb, 0xd0, 0x7e, 0xca, 0xf0, 0x86, 0x35, 0x34, 0xa2, 0xc8, 0x62, 0x1e, 0xb2, 0x21, 0x30,
+	0x14, 0x50, 0x05, 0xb5, 0x62, 0xd3, 0xd4, 0xaf, 0x5a, 0xa0, 0x6e, 0x31, 0xcf, 0x08, 0xde, 0xe1,
+	0x21, 0x3b, 0x49, 0x1c, 0x87, 0x10, 0xf7, 0x99, 0x64, 0x03, 0x03, 0x1d, 0x42, 0x60, 0x2a, 0x2b,
+	0xc2, 0x7c, 0x7a, 0x0d, 0xb3, 0x83, 0xfd, 0x20, 0x05, 0x4d, 0x64, 0x41, 0xd0, 0x56, 0x0a, 0x02,
+	0x7c, 0xb2, 0x14, 0x78, 0x42, 0xf8, 0x29, 0x0e, 0x12, 0x92, 0x72, 0xed, 0xb4, 0xbe, 0x3d, 0x45,
+	0xbe, 0x46, 0x7d, 0xcf, 0x43, 0xa7, 0x4d, 0x93, 0x90, 0x93, 0x38, 0x05, 0xf7, 0xd0, 0x11, 0x04,
+	0xfb, 0xca, 0xaa, 0x00, 0x77, 0x97, 0x02, 0xdb, 0x34, 0x74, 0x7d, 0xee, 0xd3, 0x10, 0x8b, 0x13,
+	0xef, 0xa3, 0x63, 0x08, 0x3a, 0xca, 0x9a, 0x10, 0x8d, 0xe5, 0x5a, 0x98, 0xf0, 0x3e, 0x09, 0xb9,
+	0xef, 0x60, 0x2e, 0x4e, 0xdd, 0x41, 0xbb, 0x10, 0x1c, 0x28, 0xeb, 0x82, 0xdc, 0x5e, 0x98, 0xb4,
+	0x98, 0x77, 0x4a, 0x33, 0xe1, 0xa0, 0xb5, 0xf5, 0xed, 0x73, 0xfd, 0xfe, 0x03, 0xcf, 0xe7, 0xfd,
+	0xa4, 0xa7, 0x3b, 0x74, 0x90, 0xe7, 0x6f, 0x92, 0x49, 0xe6, 0xbe, 0xcd, 0x73, 0x66, 0x31, 0xcf,
+	0x5c, 0x85, 0x05, 0x96, 0x0c, 0xb4, 0xaf, 0x00, 0x6e, 0x88, 0xb0, 0x1d, 0xc5, 0x34, 0xa2, 0x0c,
+	0x07, 0xe8, 0x10, 0xca, 0x3d, 0xcc, 0x48, 0x1e, 0xba, 0x47, 0x0b, 0x97, 0x32, 0x01, 0x4c, 0xcc,
+	0x88, 0x29, 0x9f, 0x5f, 0x56, 0x24, 0x5b, 0x40, 0xe8, 0x15, 0x94, 0x07, 0xcc, 0x63, 0xca, 0x4a,
+	0xb5, 0x50, 0x2b, 0x36, 0x5b, 0x4b, 0xb4, 0x2b, 0xff, 0x33, 0x4c, 0xd4, 0x54, 0x6b, 0xc9, 0x1f,
+	0x3e, 0x55, 0x24, 0x0d, 0xc1, 0x3b, 0xf3, 0x19, 0xd7, 0x4a, 0x70, 0x63, 0x26, 0xa3, 0xda, 0x3d,
+	0x58, 0x9c, 0xca, 0x18, 0x2a, 0xc3, 0xd5, 0xb3, 0xf4, 0x41, 0x9c, 0xf0, 0xa6, 0x9d, 0xbd, 0xe4,
+	0x5f, 0xfd, 0x4d, 0x8e, 0xd6, 0x82, 0xb7, 0x67, 0x27, 0x8f, 0x6a, 0xb0, 0x44, 0xde, 0x47, 0xc4,
+	0xe1, 0xc4, 0xcd, 0x7f, 0x24, 0x08, 0xd9, 0x9e, 0x5f, 0xd6, 0xde, 0xc0, 0xd2, 0xdc, 0x8c, 0xd1,
+	0x0b, 0xb8, 0xce, 0x7c, 0x2f, 0x24, 0x31, 0x53, 0x40, 0xb5, 0x50, 0xbb, 0x65, 0xee, 0xfc, 0xba,
+	0xac, 0xd4, 0x17, 0x18, 0x96, 0xe1, 0x38, 0x86, 0xeb, 0xc6, 0x84, 0x31, 0x7b, 0x22, 0x68, 0x5f,
+	0x00, 0x84, 0x16, 0xf3, 0xb2, 0x96, 0x13, 0x74, 0x3c, 0x33, 0xb2, 0xc7, 0x57, 0x49, 0x4f, 0x4e,
+	0xfc, 0x9f, 0xa1, 0x99, 0x2f, 0xcf, 0x47, 0x2a, 0xb8, 0x18, 0xa9, 0xe0, 0xc7, 0x48, 0x05, 0x1f,
+	0xc7, 0xaa, 0x74, 0x31, 0x56, 0xa5, 0xef, 0x63, 0x55, 0x7a, 0xdd, 0xfc, 0xb7, 0x13, 0xf9, 0x5e,
+	0x8d, 0x3f, 0x7b, 0x35, 0xf2, 0xbb, 0x32, 0xdf, 0xa2, 0xb7, 0x26, 0xee, 0xcb, 0x87, 0xbf, 0x07,
+	0x00, 0x74, 0x1b, 0x90, 0xab, 0xcf, 0x05, 0x00, 0x00,
 }
 
 func (this *MyAppMsg) GetMsg() github_com_cosmos_cosmos_sdk_types.Msg {
@@ -573,6 +587,9 @@ func (this *MyAppMsg) GetMsg() github_com_cosmos_cosmos_sdk_types.Msg {
 	if x := this.GetF(); x != nil {
 		return x
 	}
+	if x := this.GetG(); x != nil {
+		return x
+	}
 	return nil
 }
 
@@ -600,6 +617,9 @@ func (this *MyAppMsg) SetMsg(value github_com_cosmos_cosmos_sdk_types.Msg) error
 	case *MsgAuthenticate:
 		this.Sum = &MyAppMsg_F{vt}
 		return nil
+	case *group.MsgVote:
+		this.Sum = &MyAppMsg_G{vt}
+		return nil
 	}
 	return fmt.Errorf("can't encode value of type %T as message MyAppMsg", value)
 }
@@ -762,6 +782,27 @@ func (m *MyAppMsg_F) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MyAppMsg_G) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MyAppMsg_G) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.G != nil {
+		{
+			size, err := m.G.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MyAppProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1110,6 +1151,18 @@ func (m *MyAppMsg_F) Size() (n int) {
 	}
 	return n
 }
+func (m *MyAppMsg_G) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.G != nil {
+		l = m.G.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *MyAppProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1456,6 +1509,41 @@ func (m *MyAppMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &MyAppMsg_F{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field G", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &group.MsgVote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &MyAppMsg_G{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
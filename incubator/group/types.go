@@ -50,20 +50,26 @@ type DecisionPolicy interface {
 	orm.Persistent
 	orm.Validateable
 	Allow(tally Tally, totalPower sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error)
+	// GetTimout returns the duration from submission of a proposal to the end of the voting period.
+	GetTimout() types.Duration
+	// GetMinExecutionPeriod returns the duration from submission of a proposal until it can be accepted.
+	GetMinExecutionPeriod() types.Duration
 }
 
-// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold. As votes are only
+// accepted before the timeout, a proposal that reached the threshold is also allowed on or after the timeout so that
+// it can be accepted after a min execution period.
 func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
 	timeout, err := types.DurationFromProto(&p.Timout)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if timeout <= votingDuration {
-		return DecisionPolicyResult{Allow: false, Final: true}, nil
-	}
 	if tally.YesCount.GTE(p.Threshold) {
 		return DecisionPolicyResult{Allow: true, Final: true}, nil
 	}
+	if timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
 	undecided := totalPower.Sub(tally.TotalCounts())
 	if tally.YesCount.Add(undecided).LT(p.Threshold) {
 		return DecisionPolicyResult{Allow: false, Final: true}, nil
@@ -78,24 +84,130 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if p.Threshold.LT(sdk.OneDec()) {
 		return errors.Wrap(ErrInvalid, "threshold")
 	}
+	return validateVotingPeriods(p.Timout, p.MinExecutionPeriod)
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the configured percentage of the
+// total group weight. Like with the ThresholdDecisionPolicy, a proposal that reached the percentage is also allowed
+// on or after the timeout.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
 	timeout, err := types.DurationFromProto(&p.Timout)
 	if err != nil {
-		return errors.Wrap(err, "timeout")
+		return DecisionPolicyResult{}, err
+	}
+	if !totalPower.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
 	}
+	if tally.YesCount.Quo(totalPower).GTE(p.Percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	if timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	undecided := totalPower.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).Quo(totalPower).LT(p.Percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
 
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if p.Percentage.IsNil() {
+		return errors.Wrap(ErrEmpty, "percentage")
+	}
+	if !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalid, "percentage")
+	}
+	return validateVotingPeriods(p.Timout, p.MinExecutionPeriod)
+}
+
+// Allow applies the tally rules of the chain governance module. Before the timeout a proposal is only accepted or
+// rejected when the remaining undecided weight can not change the result anymore. With the timeout the
+// final tally is run on the submitted votes:
+//  * the participating weight must reach the quorum of the total weight
+//  * the veto weight must not exceed the veto threshold of the participating weight
+//  * the yes weight must exceed the threshold of all non abstain votes
+func (p QuorumDecisionPolicy) Allow(tally Tally, totalPower sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	timeout, err := types.DurationFromProto(&p.Timout)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if !totalPower.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	participating := tally.TotalCounts()
+	if timeout <= votingDuration {
+		switch {
+		case participating.LT(p.Quorum.Mul(totalPower)):
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		case tally.VetoCount.GT(p.VetoThreshold.Mul(participating)):
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		case tally.YesCount.GT(p.Threshold.Mul(participating.Sub(tally.AbstainCount))):
+			return DecisionPolicyResult{Allow: true, Final: true}, nil
+		}
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	// the denominator for the yes threshold is the same for all possible outcomes when undecided votes
+	// are either yes, no or veto
+	undecided := totalPower.Sub(participating)
+	nonAbstain := totalPower.Sub(tally.AbstainCount)
+
+	// rejected when veto can not be outvoted or not enough yes votes possible
+	if tally.VetoCount.GT(p.VetoThreshold.Mul(totalPower)) ||
+		tally.YesCount.Add(undecided).LTE(p.Threshold.Mul(nonAbstain)) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	// accepted when all undecided votes would be veto and the result still passes
+	if participating.GTE(p.Quorum.Mul(totalPower)) &&
+		tally.VetoCount.Add(undecided).LTE(p.VetoThreshold.Mul(totalPower)) &&
+		tally.YesCount.GT(p.Threshold.Mul(nonAbstain)) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+func (p QuorumDecisionPolicy) ValidateBasic() error {
+	if p.Quorum.IsNil() {
+		return errors.Wrap(ErrEmpty, "quorum")
+	}
+	if !p.Quorum.IsPositive() || p.Quorum.GT(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalid, "quorum")
+	}
+	if p.Threshold.IsNil() {
+		return errors.Wrap(ErrEmpty, "threshold")
+	}
+	if !p.Threshold.IsPositive() || p.Threshold.GTE(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalid, "threshold")
+	}
+	if p.VetoThreshold.IsNil() {
+		return errors.Wrap(ErrEmpty, "veto threshold")
+	}
+	if !p.VetoThreshold.IsPositive() || p.VetoThreshold.GT(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalid, "veto threshold")
+	}
+	return validateVotingPeriods(p.Timout, p.MinExecutionPeriod)
+}
+
+// validateVotingPeriods ensures that the timeout is positive and the min execution period ends before the timeout.
+func validateVotingPeriods(timout, minExecutionPeriod types.Duration) error {
+	timeout, err := types.DurationFromProto(&timout)
+	if err != nil {
+		return errors.Wrap(err, "timeout")
+	}
 	if timeout <= time.Nanosecond {
 		return errors.Wrap(ErrInvalid, "timeout")
 	}
+	minPeriod, err := types.DurationFromProto(&minExecutionPeriod)
+	if err != nil {
+		return errors.Wrap(err, "min execution period")
+	}
+	if minPeriod < 0 || minPeriod >= timeout {
+		return errors.Wrap(ErrInvalid, "min execution period must be lower than timeout")
+	}
 	return nil
 }
 
-func (g GroupMember) NaturalKey() []byte {
-	result := make([]byte, 8, 8+len(g.Member))
-	copy(result[0:8], g.Group.Bytes())
-	result = append(result, g.Member...)
-	return result
-}
-
 func (g GroupAccountMetadataBase) NaturalKey() []byte {
 	return g.GroupAccount
 }
@@ -120,11 +232,34 @@ func (g GroupAccountMetadataBase) ValidateBasic() error {
 	if g.Version == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "version")
 	}
+	if err := MsgTypes(g.AllowedMsgTypes).ValidateBasic(); err != nil {
+		return errors.Wrap(err, "allowed msg types")
+	}
+	if err := MsgTypes(g.DeniedMsgTypes).ValidateBasic(); err != nil {
+		return errors.Wrap(err, "denied msg types")
+	}
+
+	return nil
+}
+
+// PermitsMsg returns true when the msg type is on the allow list, if any, and not on the deny list.
+func (g GroupAccountMetadataBase) PermitsMsg(msg sdk.Msg) bool {
+	if len(g.AllowedMsgTypes) != 0 && !MsgTypes(g.AllowedMsgTypes).Match(msg) {
+		return false
+	}
+	return !MsgTypes(g.DeniedMsgTypes).Match(msg)
+}
 
+func (m MsgType) ValidateBasic() error {
+	if len(m.Route) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "route")
+	}
 	return nil
 }
-func (s StdGroupAccountMetadata) NaturalKey() []byte {
-	return s.Base.NaturalKey()
+
+// Match returns true when the route and, if set, the type equal the msg's.
+func (m MsgType) Match(msg sdk.Msg) bool {
+	return m.Route == msg.Route() && (len(m.Type) == 0 || m.Type == msg.Type())
 }
 
 var _ orm.Validateable = StdGroupAccountMetadata{}
@@ -143,13 +278,6 @@ func (s StdGroupAccountMetadata) ValidateBasic() error {
 	return nil
 }
 
-func (v Vote) NaturalKey() []byte {
-	result := make([]byte, 8, 8+len(v.Voter))
-	copy(result[0:8], v.Proposal.Bytes())
-	result = append(result, v.Voter...)
-	return result
-}
-
 var _ orm.Validateable = Vote{}
 
 func (v Vote) ValidateBasic() error {
@@ -178,17 +306,82 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
-const defaultMaxCommentLength = 255
+var _ orm.Validateable = ElectorateSnapshot{}
+
+func (s ElectorateSnapshot) ValidateBasic() error {
+	if s.Proposal.Empty() {
+		return errors.Wrap(ErrEmpty, "proposal")
+	}
+	if s.TotalWeight.IsNil() || s.TotalWeight.IsNegative() {
+		return errors.Wrap(ErrInvalid, "total weight")
+	}
+	return nil
+}
+
+var _ orm.Validateable = ElectorateSnapshotMember{}
+
+func (m ElectorateSnapshotMember) ValidateBasic() error {
+	if m.Proposal.Empty() {
+		return errors.Wrap(ErrEmpty, "proposal")
+	}
+	if m.Member.Empty() {
+		return errors.Wrap(ErrEmpty, "member")
+	}
+	if err := sdk.VerifyAddressFormat(m.Member); err != nil {
+		return sdkerrors.Wrap(err, "member")
+	}
+	if m.Weight.IsNil() || m.Weight.LTE(sdk.ZeroDec()) {
+		return errors.Wrap(ErrInvalid, "weight")
+	}
+	return nil
+}
+
+var _ orm.Validateable = ProposalExecution{}
+
+func (e ProposalExecution) ValidateBasic() error {
+	if e.Proposal.Empty() {
+		return errors.Wrap(ErrEmpty, "proposal")
+	}
+	switch e.ExecutorResult {
+	case ProposalExecutorResultSuccess:
+	case ProposalExecutorResultFailure:
+		if len(e.FailureReason) == 0 {
+			return errors.Wrap(ErrEmpty, "failure reason")
+		}
+		if e.FailedMsgIndex < -1 {
+			return errors.Wrap(ErrInvalid, "failed msg index")
+		}
+	default:
+		return errors.Wrap(ErrInvalid, "executor result")
+	}
+	return nil
+}
+
+const (
+	defaultMaxCommentLength            = 255
+	defaultMaxEndBlockProposals        = 100
+	defaultMaxAutoExecGas       uint64 = 1000000
+	defaultProposalRetention           = 30 * 24 * time.Hour
+	defaultMaxVotingWindow             = 30 * 24 * time.Hour
+)
 
 // Parameter keys
 var (
-	ParamMaxCommentLength = []byte("MaxCommentLength")
+	ParamMaxCommentLength     = []byte("MaxCommentLength")
+	ParamMaxEndBlockProposals = []byte("MaxEndBlockProposals")
+	ParamMaxAutoExecGas       = []byte("MaxAutoExecGas")
+	ParamProposalRetention    = []byte("ProposalRetention")
+	ParamMaxVotingWindow      = []byte("MaxVotingWindow")
 )
 
 // DefaultParams returns the default parameters for the group module.
 func DefaultParams() Params {
 	return Params{
-		MaxCommentLength: defaultMaxCommentLength,
+		MaxCommentLength:     defaultMaxCommentLength,
+		MaxEndBlockProposals: defaultMaxEndBlockProposals,
+		MaxAutoExecGas:       defaultMaxAutoExecGas,
+		ProposalRetention:    defaultProposalRetention,
+		MaxVotingWindow:      defaultMaxVotingWindow,
 	}
 }
 
@@ -201,9 +394,25 @@ func (p Params) String() string {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(ParamMaxCommentLength, &p.MaxCommentLength, noopValidator()),
+		params.NewParamSetPair(ParamMaxEndBlockProposals, &p.MaxEndBlockProposals, noopValidator()),
+		params.NewParamSetPair(ParamMaxAutoExecGas, &p.MaxAutoExecGas, noopValidator()),
+		params.NewParamSetPair(ParamProposalRetention, &p.ProposalRetention, noopValidator()),
+		params.NewParamSetPair(ParamMaxVotingWindow, &p.MaxVotingWindow, noopValidator()),
 	}
 }
 func (p Params) Validate() error {
+	if p.MaxEndBlockProposals == 0 {
+		return errors.Wrap(ErrEmpty, "max end block proposals")
+	}
+	if p.MaxAutoExecGas == 0 {
+		return errors.Wrap(ErrEmpty, "max auto exec gas")
+	}
+	if p.ProposalRetention < 0 {
+		return errors.Wrap(ErrInvalid, "proposal retention")
+	}
+	if p.MaxVotingWindow <= 0 {
+		return errors.Wrap(ErrInvalid, "max voting window")
+	}
 	return nil
 }
 
@@ -301,6 +510,23 @@ func (p ProposalBase) ValidateBasic() error {
 	return nil
 }
 
+// pendingEndBlock returns true when the proposal still needs to be processed in the EndBlocker: either the final
+// tally has not happened yet or the proposal was accepted but is still waiting for the auto execution.
+func (p ProposalBase) pendingEndBlock() bool {
+	switch {
+	case p.Status == ProposalStatusSubmitted:
+		return true
+	case p.AutoExec && p.Status == ProposalStatusClosed && p.Result == ProposalResultAccepted:
+		return p.ExecutorResult == ProposalExecutorResultNotRun
+	}
+	return false
+}
+
+// finalized returns true when the proposal can not be modified by votes or execution anymore.
+func (p ProposalBase) finalized() bool {
+	return p.Status != ProposalStatusSubmitted && !p.pendingEndBlock()
+}
+
 func (t *Tally) Sub(vote Vote, weight sdk.Dec) error {
 	if weight.LTE(sdk.ZeroDec()) {
 		return errors.Wrap(ErrInvalid, "weight must be greater than 0")
@@ -0,0 +1,1075 @@
+// Code generated by protoc-gen-orm. DO NOT EDIT.
+// source: types.proto
+
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errors "github.com/cosmos/cosmos-sdk/types/errors"
+	orm "github.com/cosmos/modules/incubator/orm"
+)
+
+const (
+	GroupTablePrefix        byte = 0x0
+	GroupTableSeqPrefix     byte = 0x1
+	GroupByAdminIndexPrefix byte = 0x2
+)
+
+// GroupTable is a typed orm.AutoUInt64Table of GroupMetadata objects.
+type GroupTable struct {
+	table        orm.AutoUInt64Table
+	byAdminIndex orm.MultiKeyIndex
+}
+
+// NewGroupTable creates the Group table with its indexes.
+func NewGroupTable(storeKey sdk.StoreKey) GroupTable {
+	builder := orm.NewAutoUInt64TableBuilder(GroupTablePrefix, GroupTableSeqPrefix, storeKey, &GroupMetadata{})
+	builder.DisableTypeCheck()
+	var t GroupTable
+	t.byAdminIndex = orm.NewIndex(builder, GroupByAdminIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(value.(*GroupMetadata).Admin)}, nil
+	})
+	t.table = builder.Build()
+	return t
+}
+
+// Create persists the GroupMetadata with an auto generated uint64 primary key. The key is returned.
+func (t GroupTable) Create(ctx orm.HasKVStore, obj *GroupMetadata) (uint64, error) {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the GroupMetadata with the given rowID.
+func (t GroupTable) Save(ctx orm.HasKVStore, rowID uint64, obj *GroupMetadata) error {
+	return t.table.Save(ctx, rowID, obj)
+}
+
+// Delete removes the GroupMetadata with the given rowID.
+func (t GroupTable) Delete(ctx orm.HasKVStore, rowID uint64) error {
+	return t.table.Delete(ctx, rowID)
+}
+
+// Has checks if a GroupMetadata with the given rowID exists.
+func (t GroupTable) Has(ctx orm.HasKVStore, rowID uint64) bool {
+	return t.table.Has(ctx, rowID)
+}
+
+// Get returns the GroupMetadata with the given rowID. An orm.ErrNotFound is returned when it does not exist.
+func (t GroupTable) Get(ctx orm.HasKVStore, rowID uint64) (GroupMetadata, error) {
+	var obj GroupMetadata
+	_, err := t.table.GetOne(ctx, rowID, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the rowIDs from start to the exclusive end in ascending order.
+func (t GroupTable) PrefixScan(ctx orm.HasKVStore, start, end uint64) (GroupIterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return GroupIterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the rowIDs from start to the exclusive end in descending order.
+func (t GroupTable) ReversePrefixScan(ctx orm.HasKVStore, start, end uint64) (GroupIterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return GroupIterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the rowIDs from start to the exclusive end in ascending order.
+func (t GroupTable) PaginatedPrefixScan(ctx orm.HasKVStore, start, end uint64, pageRequest *orm.PageRequest) ([]GroupMetadata, *orm.PageResponse, error) {
+	var objs []GroupMetadata
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// Sequence returns the sequence of the table.
+func (t GroupTable) Sequence() orm.Sequence {
+	return t.table.Sequence()
+}
+
+// HasByAdmin checks if the ByAdmin index contains the key.
+func (t GroupTable) HasByAdmin(ctx orm.HasKVStore, key sdk.AccAddress) bool {
+	return t.byAdminIndex.Has(ctx, []byte(key))
+}
+
+// GetByAdmin returns an iterator over all GroupMetadata objects with the key in the ByAdmin index.
+func (t GroupTable) GetByAdmin(ctx orm.HasKVStore, key sdk.AccAddress) (GroupIterator, error) {
+	it, err := t.byAdminIndex.Get(ctx, []byte(key))
+	return GroupIterator{it: it}, err
+}
+
+// PrefixScanByAdmin returns an iterator over the ByAdmin index from start to the exclusive end in ascending order.
+func (t GroupTable) PrefixScanByAdmin(ctx orm.HasKVStore, start, end sdk.AccAddress) (GroupIterator, error) {
+	it, err := t.byAdminIndex.PrefixScan(ctx, []byte(start), []byte(end))
+	return GroupIterator{it: it}, err
+}
+
+// VerifyIndexes checks that the indexes of the Group table are consistent with the table data.
+func (t GroupTable) VerifyIndexes(ctx orm.HasKVStore) error {
+	if err := t.byAdminIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByAdmin")
+	}
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t GroupTable) Table() orm.Table {
+	return t.table.Table()
+}
+
+// GroupIterator is an orm.Iterator over GroupMetadata objects.
+type GroupIterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next GroupMetadata into dest which must be a *GroupMetadata. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i GroupIterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*GroupMetadata)
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with GroupMetadata", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i GroupIterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining GroupMetadata objects and closes the iterator.
+func (i GroupIterator) ReadAll() ([]GroupMetadata, error) {
+	defer i.it.Close()
+	result := make([]GroupMetadata, 0)
+	for {
+		var obj GroupMetadata
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+const (
+	GroupMemberTablePrefix         byte = 0x10
+	GroupMemberByGroupIndexPrefix  byte = 0x11
+	GroupMemberByMemberIndexPrefix byte = 0x12
+)
+
+// NaturalKey returns the natural key of the GroupMember in the GroupMember table.
+func (m GroupMember) NaturalKey() []byte {
+	result := make([]byte, 0, 8+len(m.Member))
+	result = append(result, orm.EncodeSequence(uint64(m.Group))...)
+	result = append(result, []byte(m.Member)...)
+	return result
+}
+
+// GroupMemberTable is a typed orm.NaturalKeyTable of GroupMember objects.
+type GroupMemberTable struct {
+	table         orm.NaturalKeyTable
+	byGroupIndex  orm.UInt64Index
+	byMemberIndex orm.MultiKeyIndex
+}
+
+// NewGroupMemberTable creates the GroupMember table with its indexes.
+func NewGroupMemberTable(storeKey sdk.StoreKey) GroupMemberTable {
+	builder := orm.NewNaturalKeyTableBuilder(GroupMemberTablePrefix, storeKey, &GroupMember{}, orm.Max255DynamicLengthIndexKeyCodec{})
+	builder.DisableTypeCheck()
+	var t GroupMemberTable
+	t.byGroupIndex = orm.NewUInt64Index(builder, GroupMemberByGroupIndexPrefix, func(value interface{}) ([]uint64, error) {
+		return []uint64{uint64(value.(*GroupMember).Group)}, nil
+	})
+	t.byMemberIndex = orm.NewIndex(builder, GroupMemberByMemberIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(value.(*GroupMember).Member)}, nil
+	})
+	t.table = builder.Build()
+	return t
+}
+
+// Create persists the GroupMember under its natural key. An orm.ErrUniqueConstraint is returned when the key exists.
+func (t GroupMemberTable) Create(ctx orm.HasKVStore, obj *GroupMember) error {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the GroupMember stored under its natural key.
+func (t GroupMemberTable) Save(ctx orm.HasKVStore, obj *GroupMember) error {
+	return t.table.Save(ctx, obj)
+}
+
+// Delete removes the GroupMember stored under its natural key.
+func (t GroupMemberTable) Delete(ctx orm.HasKVStore, obj *GroupMember) error {
+	return t.table.Delete(ctx, obj)
+}
+
+// Has checks if a GroupMember with the given natural key exists.
+func (t GroupMemberTable) Has(ctx orm.HasKVStore, naturalKey []byte) bool {
+	return t.table.Has(ctx, naturalKey)
+}
+
+// Contains checks if a GroupMember with the natural key of the given object exists.
+func (t GroupMemberTable) Contains(ctx orm.HasKVStore, obj *GroupMember) bool {
+	return t.table.Contains(ctx, obj)
+}
+
+// Get returns the GroupMember with the given natural key. An orm.ErrNotFound is returned when it does not exist.
+func (t GroupMemberTable) Get(ctx orm.HasKVStore, naturalKey []byte) (GroupMember, error) {
+	var obj GroupMember
+	err := t.table.GetOne(ctx, naturalKey, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the natural keys from start to the exclusive end in ascending order.
+func (t GroupMemberTable) PrefixScan(ctx orm.HasKVStore, start, end []byte) (GroupMemberIterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return GroupMemberIterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the natural keys from start to the exclusive end in descending order.
+func (t GroupMemberTable) ReversePrefixScan(ctx orm.HasKVStore, start, end []byte) (GroupMemberIterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return GroupMemberIterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the natural keys from start to the exclusive end in ascending order.
+func (t GroupMemberTable) PaginatedPrefixScan(ctx orm.HasKVStore, start, end []byte, pageRequest *orm.PageRequest) ([]GroupMember, *orm.PageResponse, error) {
+	var objs []GroupMember
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// HasByGroup checks if the ByGroup index contains the key.
+func (t GroupMemberTable) HasByGroup(ctx orm.HasKVStore, key GroupID) bool {
+	return t.byGroupIndex.Has(ctx, uint64(key))
+}
+
+// GetByGroup returns an iterator over all GroupMember objects with the key in the ByGroup index.
+func (t GroupMemberTable) GetByGroup(ctx orm.HasKVStore, key GroupID) (GroupMemberIterator, error) {
+	it, err := t.byGroupIndex.Get(ctx, uint64(key))
+	return GroupMemberIterator{it: it}, err
+}
+
+// PrefixScanByGroup returns an iterator over the ByGroup index from start to the exclusive end in ascending order.
+func (t GroupMemberTable) PrefixScanByGroup(ctx orm.HasKVStore, start, end GroupID) (GroupMemberIterator, error) {
+	it, err := t.byGroupIndex.PrefixScan(ctx, uint64(start), uint64(end))
+	return GroupMemberIterator{it: it}, err
+}
+
+// HasByMember checks if the ByMember index contains the key.
+func (t GroupMemberTable) HasByMember(ctx orm.HasKVStore, key sdk.AccAddress) bool {
+	return t.byMemberIndex.Has(ctx, []byte(key))
+}
+
+// GetByMember returns an iterator over all GroupMember objects with the key in the ByMember index.
+func (t GroupMemberTable) GetByMember(ctx orm.HasKVStore, key sdk.AccAddress) (GroupMemberIterator, error) {
+	it, err := t.byMemberIndex.Get(ctx, []byte(key))
+	return GroupMemberIterator{it: it}, err
+}
+
+// PrefixScanByMember returns an iterator over the ByMember index from start to the exclusive end in ascending order.
+func (t GroupMemberTable) PrefixScanByMember(ctx orm.HasKVStore, start, end sdk.AccAddress) (GroupMemberIterator, error) {
+	it, err := t.byMemberIndex.PrefixScan(ctx, []byte(start), []byte(end))
+	return GroupMemberIterator{it: it}, err
+}
+
+// VerifyIndexes checks that the indexes of the GroupMember table are consistent with the table data.
+func (t GroupMemberTable) VerifyIndexes(ctx orm.HasKVStore) error {
+	if err := t.byGroupIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByGroup")
+	}
+	if err := t.byMemberIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByMember")
+	}
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t GroupMemberTable) Table() orm.Table {
+	return t.table.Table()
+}
+
+// GroupMemberIterator is an orm.Iterator over GroupMember objects.
+type GroupMemberIterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next GroupMember into dest which must be a *GroupMember. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i GroupMemberIterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*GroupMember)
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with GroupMember", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i GroupMemberIterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining GroupMember objects and closes the iterator.
+func (i GroupMemberIterator) ReadAll() ([]GroupMember, error) {
+	defer i.it.Close()
+	result := make([]GroupMember, 0)
+	for {
+		var obj GroupMember
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+const (
+	GroupAccountTablePrefix        byte = 0x20
+	GroupAccountByGroupIndexPrefix byte = 0x22
+	GroupAccountByAdminIndexPrefix byte = 0x23
+)
+
+// NaturalKey returns the natural key of the StdGroupAccountMetadata in the GroupAccount table.
+func (m StdGroupAccountMetadata) NaturalKey() []byte {
+	result := make([]byte, 0, 0+len(m.Base.GroupAccount))
+	result = append(result, []byte(m.Base.GroupAccount)...)
+	return result
+}
+
+// GroupAccountTable is a typed orm.NaturalKeyTable of StdGroupAccountMetadata objects.
+type GroupAccountTable struct {
+	table        orm.NaturalKeyTable
+	byGroupIndex orm.UInt64Index
+	byAdminIndex orm.MultiKeyIndex
+}
+
+// NewGroupAccountTable creates the GroupAccount table with its indexes.
+func NewGroupAccountTable(storeKey sdk.StoreKey) GroupAccountTable {
+	builder := orm.NewNaturalKeyTableBuilder(GroupAccountTablePrefix, storeKey, &StdGroupAccountMetadata{}, orm.Max255DynamicLengthIndexKeyCodec{})
+	builder.DisableTypeCheck()
+	var t GroupAccountTable
+	t.byGroupIndex = orm.NewUInt64Index(builder, GroupAccountByGroupIndexPrefix, func(value interface{}) ([]uint64, error) {
+		return []uint64{uint64(value.(*StdGroupAccountMetadata).Base.Group)}, nil
+	})
+	t.byAdminIndex = orm.NewIndex(builder, GroupAccountByAdminIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(value.(*StdGroupAccountMetadata).Base.Admin)}, nil
+	})
+	t.table = builder.Build()
+	return t
+}
+
+// Create persists the StdGroupAccountMetadata under its natural key. An orm.ErrUniqueConstraint is returned when the key exists.
+func (t GroupAccountTable) Create(ctx orm.HasKVStore, obj *StdGroupAccountMetadata) error {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the StdGroupAccountMetadata stored under its natural key.
+func (t GroupAccountTable) Save(ctx orm.HasKVStore, obj *StdGroupAccountMetadata) error {
+	return t.table.Save(ctx, obj)
+}
+
+// Delete removes the StdGroupAccountMetadata stored under its natural key.
+func (t GroupAccountTable) Delete(ctx orm.HasKVStore, obj *StdGroupAccountMetadata) error {
+	return t.table.Delete(ctx, obj)
+}
+
+// Has checks if a StdGroupAccountMetadata with the given natural key exists.
+func (t GroupAccountTable) Has(ctx orm.HasKVStore, naturalKey []byte) bool {
+	return t.table.Has(ctx, naturalKey)
+}
+
+// Contains checks if a StdGroupAccountMetadata with the natural key of the given object exists.
+func (t GroupAccountTable) Contains(ctx orm.HasKVStore, obj *StdGroupAccountMetadata) bool {
+	return t.table.Contains(ctx, obj)
+}
+
+// Get returns the StdGroupAccountMetadata with the given natural key. An orm.ErrNotFound is returned when it does not exist.
+func (t GroupAccountTable) Get(ctx orm.HasKVStore, naturalKey []byte) (StdGroupAccountMetadata, error) {
+	var obj StdGroupAccountMetadata
+	err := t.table.GetOne(ctx, naturalKey, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the natural keys from start to the exclusive end in ascending order.
+func (t GroupAccountTable) PrefixScan(ctx orm.HasKVStore, start, end []byte) (GroupAccountIterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return GroupAccountIterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the natural keys from start to the exclusive end in descending order.
+func (t GroupAccountTable) ReversePrefixScan(ctx orm.HasKVStore, start, end []byte) (GroupAccountIterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return GroupAccountIterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the natural keys from start to the exclusive end in ascending order.
+func (t GroupAccountTable) PaginatedPrefixScan(ctx orm.HasKVStore, start, end []byte, pageRequest *orm.PageRequest) ([]StdGroupAccountMetadata, *orm.PageResponse, error) {
+	var objs []StdGroupAccountMetadata
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// HasByGroup checks if the ByGroup index contains the key.
+func (t GroupAccountTable) HasByGroup(ctx orm.HasKVStore, key GroupID) bool {
+	return t.byGroupIndex.Has(ctx, uint64(key))
+}
+
+// GetByGroup returns an iterator over all StdGroupAccountMetadata objects with the key in the ByGroup index.
+func (t GroupAccountTable) GetByGroup(ctx orm.HasKVStore, key GroupID) (GroupAccountIterator, error) {
+	it, err := t.byGroupIndex.Get(ctx, uint64(key))
+	return GroupAccountIterator{it: it}, err
+}
+
+// PrefixScanByGroup returns an iterator over the ByGroup index from start to the exclusive end in ascending order.
+func (t GroupAccountTable) PrefixScanByGroup(ctx orm.HasKVStore, start, end GroupID) (GroupAccountIterator, error) {
+	it, err := t.byGroupIndex.PrefixScan(ctx, uint64(start), uint64(end))
+	return GroupAccountIterator{it: it}, err
+}
+
+// HasByAdmin checks if the ByAdmin index contains the key.
+func (t GroupAccountTable) HasByAdmin(ctx orm.HasKVStore, key sdk.AccAddress) bool {
+	return t.byAdminIndex.Has(ctx, []byte(key))
+}
+
+// GetByAdmin returns an iterator over all StdGroupAccountMetadata objects with the key in the ByAdmin index.
+func (t GroupAccountTable) GetByAdmin(ctx orm.HasKVStore, key sdk.AccAddress) (GroupAccountIterator, error) {
+	it, err := t.byAdminIndex.Get(ctx, []byte(key))
+	return GroupAccountIterator{it: it}, err
+}
+
+// PrefixScanByAdmin returns an iterator over the ByAdmin index from start to the exclusive end in ascending order.
+func (t GroupAccountTable) PrefixScanByAdmin(ctx orm.HasKVStore, start, end sdk.AccAddress) (GroupAccountIterator, error) {
+	it, err := t.byAdminIndex.PrefixScan(ctx, []byte(start), []byte(end))
+	return GroupAccountIterator{it: it}, err
+}
+
+// VerifyIndexes checks that the indexes of the GroupAccount table are consistent with the table data.
+func (t GroupAccountTable) VerifyIndexes(ctx orm.HasKVStore) error {
+	if err := t.byGroupIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByGroup")
+	}
+	if err := t.byAdminIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByAdmin")
+	}
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t GroupAccountTable) Table() orm.Table {
+	return t.table.Table()
+}
+
+// GroupAccountIterator is an orm.Iterator over StdGroupAccountMetadata objects.
+type GroupAccountIterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next StdGroupAccountMetadata into dest which must be a *StdGroupAccountMetadata. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i GroupAccountIterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*StdGroupAccountMetadata)
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with StdGroupAccountMetadata", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i GroupAccountIterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining StdGroupAccountMetadata objects and closes the iterator.
+func (i GroupAccountIterator) ReadAll() ([]StdGroupAccountMetadata, error) {
+	defer i.it.Close()
+	result := make([]StdGroupAccountMetadata, 0)
+	for {
+		var obj StdGroupAccountMetadata
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+const (
+	ElectorateSnapshotTablePrefix byte = 0x50
+)
+
+// NaturalKey returns the natural key of the ElectorateSnapshot in the ElectorateSnapshot table.
+func (m ElectorateSnapshot) NaturalKey() []byte {
+	result := make([]byte, 0, 8)
+	result = append(result, orm.EncodeSequence(uint64(m.Proposal))...)
+	return result
+}
+
+// ElectorateSnapshotTable is a typed orm.NaturalKeyTable of ElectorateSnapshot objects.
+type ElectorateSnapshotTable struct {
+	table orm.NaturalKeyTable
+}
+
+// NewElectorateSnapshotTable creates the ElectorateSnapshot table with its indexes.
+func NewElectorateSnapshotTable(storeKey sdk.StoreKey) ElectorateSnapshotTable {
+	builder := orm.NewNaturalKeyTableBuilder(ElectorateSnapshotTablePrefix, storeKey, &ElectorateSnapshot{}, orm.Max255DynamicLengthIndexKeyCodec{})
+	builder.DisableTypeCheck()
+	var t ElectorateSnapshotTable
+	t.table = builder.Build()
+	return t
+}
+
+// Create persists the ElectorateSnapshot under its natural key. An orm.ErrUniqueConstraint is returned when the key exists.
+func (t ElectorateSnapshotTable) Create(ctx orm.HasKVStore, obj *ElectorateSnapshot) error {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the ElectorateSnapshot stored under its natural key.
+func (t ElectorateSnapshotTable) Save(ctx orm.HasKVStore, obj *ElectorateSnapshot) error {
+	return t.table.Save(ctx, obj)
+}
+
+// Delete removes the ElectorateSnapshot stored under its natural key.
+func (t ElectorateSnapshotTable) Delete(ctx orm.HasKVStore, obj *ElectorateSnapshot) error {
+	return t.table.Delete(ctx, obj)
+}
+
+// Has checks if a ElectorateSnapshot with the given natural key exists.
+func (t ElectorateSnapshotTable) Has(ctx orm.HasKVStore, naturalKey []byte) bool {
+	return t.table.Has(ctx, naturalKey)
+}
+
+// Contains checks if a ElectorateSnapshot with the natural key of the given object exists.
+func (t ElectorateSnapshotTable) Contains(ctx orm.HasKVStore, obj *ElectorateSnapshot) bool {
+	return t.table.Contains(ctx, obj)
+}
+
+// Get returns the ElectorateSnapshot with the given natural key. An orm.ErrNotFound is returned when it does not exist.
+func (t ElectorateSnapshotTable) Get(ctx orm.HasKVStore, naturalKey []byte) (ElectorateSnapshot, error) {
+	var obj ElectorateSnapshot
+	err := t.table.GetOne(ctx, naturalKey, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the natural keys from start to the exclusive end in ascending order.
+func (t ElectorateSnapshotTable) PrefixScan(ctx orm.HasKVStore, start, end []byte) (ElectorateSnapshotIterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return ElectorateSnapshotIterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the natural keys from start to the exclusive end in descending order.
+func (t ElectorateSnapshotTable) ReversePrefixScan(ctx orm.HasKVStore, start, end []byte) (ElectorateSnapshotIterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return ElectorateSnapshotIterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the natural keys from start to the exclusive end in ascending order.
+func (t ElectorateSnapshotTable) PaginatedPrefixScan(ctx orm.HasKVStore, start, end []byte, pageRequest *orm.PageRequest) ([]ElectorateSnapshot, *orm.PageResponse, error) {
+	var objs []ElectorateSnapshot
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// VerifyIndexes checks that the indexes of the ElectorateSnapshot table are consistent with the table data.
+func (t ElectorateSnapshotTable) VerifyIndexes(ctx orm.HasKVStore) error {
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t ElectorateSnapshotTable) Table() orm.Table {
+	return t.table.Table()
+}
+
+// ElectorateSnapshotIterator is an orm.Iterator over ElectorateSnapshot objects.
+type ElectorateSnapshotIterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next ElectorateSnapshot into dest which must be a *ElectorateSnapshot. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i ElectorateSnapshotIterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*ElectorateSnapshot)
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with ElectorateSnapshot", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i ElectorateSnapshotIterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining ElectorateSnapshot objects and closes the iterator.
+func (i ElectorateSnapshotIterator) ReadAll() ([]ElectorateSnapshot, error) {
+	defer i.it.Close()
+	result := make([]ElectorateSnapshot, 0)
+	for {
+		var obj ElectorateSnapshot
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+const (
+	ElectorateSnapshotMemberTablePrefix           byte = 0x51
+	ElectorateSnapshotMemberByProposalIndexPrefix byte = 0x52
+)
+
+// NaturalKey returns the natural key of the ElectorateSnapshotMember in the ElectorateSnapshotMember table.
+func (m ElectorateSnapshotMember) NaturalKey() []byte {
+	result := make([]byte, 0, 8+len(m.Member))
+	result = append(result, orm.EncodeSequence(uint64(m.Proposal))...)
+	result = append(result, []byte(m.Member)...)
+	return result
+}
+
+// ElectorateSnapshotMemberTable is a typed orm.NaturalKeyTable of ElectorateSnapshotMember objects.
+type ElectorateSnapshotMemberTable struct {
+	table           orm.NaturalKeyTable
+	byProposalIndex orm.UInt64Index
+}
+
+// NewElectorateSnapshotMemberTable creates the ElectorateSnapshotMember table with its indexes.
+func NewElectorateSnapshotMemberTable(storeKey sdk.StoreKey) ElectorateSnapshotMemberTable {
+	builder := orm.NewNaturalKeyTableBuilder(ElectorateSnapshotMemberTablePrefix, storeKey, &ElectorateSnapshotMember{}, orm.Max255DynamicLengthIndexKeyCodec{})
+	builder.DisableTypeCheck()
+	var t ElectorateSnapshotMemberTable
+	t.byProposalIndex = orm.NewUInt64Index(builder, ElectorateSnapshotMemberByProposalIndexPrefix, func(value interface{}) ([]uint64, error) {
+		return []uint64{uint64(value.(*ElectorateSnapshotMember).Proposal)}, nil
+	})
+	t.table = builder.Build()
+	return t
+}
+
+// Create persists the ElectorateSnapshotMember under its natural key. An orm.ErrUniqueConstraint is returned when the key exists.
+func (t ElectorateSnapshotMemberTable) Create(ctx orm.HasKVStore, obj *ElectorateSnapshotMember) error {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the ElectorateSnapshotMember stored under its natural key.
+func (t ElectorateSnapshotMemberTable) Save(ctx orm.HasKVStore, obj *ElectorateSnapshotMember) error {
+	return t.table.Save(ctx, obj)
+}
+
+// Delete removes the ElectorateSnapshotMember stored under its natural key.
+func (t ElectorateSnapshotMemberTable) Delete(ctx orm.HasKVStore, obj *ElectorateSnapshotMember) error {
+	return t.table.Delete(ctx, obj)
+}
+
+// Has checks if a ElectorateSnapshotMember with the given natural key exists.
+func (t ElectorateSnapshotMemberTable) Has(ctx orm.HasKVStore, naturalKey []byte) bool {
+	return t.table.Has(ctx, naturalKey)
+}
+
+// Contains checks if a ElectorateSnapshotMember with the natural key of the given object exists.
+func (t ElectorateSnapshotMemberTable) Contains(ctx orm.HasKVStore, obj *ElectorateSnapshotMember) bool {
+	return t.table.Contains(ctx, obj)
+}
+
+// Get returns the ElectorateSnapshotMember with the given natural key. An orm.ErrNotFound is returned when it does not exist.
+func (t ElectorateSnapshotMemberTable) Get(ctx orm.HasKVStore, naturalKey []byte) (ElectorateSnapshotMember, error) {
+	var obj ElectorateSnapshotMember
+	err := t.table.GetOne(ctx, naturalKey, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the natural keys from start to the exclusive end in ascending order.
+func (t ElectorateSnapshotMemberTable) PrefixScan(ctx orm.HasKVStore, start, end []byte) (ElectorateSnapshotMemberIterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return ElectorateSnapshotMemberIterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the natural keys from start to the exclusive end in descending order.
+func (t ElectorateSnapshotMemberTable) ReversePrefixScan(ctx orm.HasKVStore, start, end []byte) (ElectorateSnapshotMemberIterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return ElectorateSnapshotMemberIterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the natural keys from start to the exclusive end in ascending order.
+func (t ElectorateSnapshotMemberTable) PaginatedPrefixScan(ctx orm.HasKVStore, start, end []byte, pageRequest *orm.PageRequest) ([]ElectorateSnapshotMember, *orm.PageResponse, error) {
+	var objs []ElectorateSnapshotMember
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// HasByProposal checks if the ByProposal index contains the key.
+func (t ElectorateSnapshotMemberTable) HasByProposal(ctx orm.HasKVStore, key ProposalID) bool {
+	return t.byProposalIndex.Has(ctx, uint64(key))
+}
+
+// GetByProposal returns an iterator over all ElectorateSnapshotMember objects with the key in the ByProposal index.
+func (t ElectorateSnapshotMemberTable) GetByProposal(ctx orm.HasKVStore, key ProposalID) (ElectorateSnapshotMemberIterator, error) {
+	it, err := t.byProposalIndex.Get(ctx, uint64(key))
+	return ElectorateSnapshotMemberIterator{it: it}, err
+}
+
+// PrefixScanByProposal returns an iterator over the ByProposal index from start to the exclusive end in ascending order.
+func (t ElectorateSnapshotMemberTable) PrefixScanByProposal(ctx orm.HasKVStore, start, end ProposalID) (ElectorateSnapshotMemberIterator, error) {
+	it, err := t.byProposalIndex.PrefixScan(ctx, uint64(start), uint64(end))
+	return ElectorateSnapshotMemberIterator{it: it}, err
+}
+
+// VerifyIndexes checks that the indexes of the ElectorateSnapshotMember table are consistent with the table data.
+func (t ElectorateSnapshotMemberTable) VerifyIndexes(ctx orm.HasKVStore) error {
+	if err := t.byProposalIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByProposal")
+	}
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t ElectorateSnapshotMemberTable) Table() orm.Table {
+	return t.table.Table()
+}
+
+// ElectorateSnapshotMemberIterator is an orm.Iterator over ElectorateSnapshotMember objects.
+type ElectorateSnapshotMemberIterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next ElectorateSnapshotMember into dest which must be a *ElectorateSnapshotMember. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i ElectorateSnapshotMemberIterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*ElectorateSnapshotMember)
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with ElectorateSnapshotMember", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i ElectorateSnapshotMemberIterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining ElectorateSnapshotMember objects and closes the iterator.
+func (i ElectorateSnapshotMemberIterator) ReadAll() ([]ElectorateSnapshotMember, error) {
+	defer i.it.Close()
+	result := make([]ElectorateSnapshotMember, 0)
+	for {
+		var obj ElectorateSnapshotMember
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+const (
+	ProposalExecutionTablePrefix byte = 0x60
+)
+
+// NaturalKey returns the natural key of the ProposalExecution in the ProposalExecution table.
+func (m ProposalExecution) NaturalKey() []byte {
+	result := make([]byte, 0, 8)
+	result = append(result, orm.EncodeSequence(uint64(m.Proposal))...)
+	return result
+}
+
+// ProposalExecutionTable is a typed orm.NaturalKeyTable of ProposalExecution objects.
+type ProposalExecutionTable struct {
+	table orm.NaturalKeyTable
+}
+
+// NewProposalExecutionTable creates the ProposalExecution table with its indexes.
+func NewProposalExecutionTable(storeKey sdk.StoreKey) ProposalExecutionTable {
+	builder := orm.NewNaturalKeyTableBuilder(ProposalExecutionTablePrefix, storeKey, &ProposalExecution{}, orm.Max255DynamicLengthIndexKeyCodec{})
+	builder.DisableTypeCheck()
+	var t ProposalExecutionTable
+	t.table = builder.Build()
+	return t
+}
+
+// Create persists the ProposalExecution under its natural key. An orm.ErrUniqueConstraint is returned when the key exists.
+func (t ProposalExecutionTable) Create(ctx orm.HasKVStore, obj *ProposalExecution) error {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the ProposalExecution stored under its natural key.
+func (t ProposalExecutionTable) Save(ctx orm.HasKVStore, obj *ProposalExecution) error {
+	return t.table.Save(ctx, obj)
+}
+
+// Delete removes the ProposalExecution stored under its natural key.
+func (t ProposalExecutionTable) Delete(ctx orm.HasKVStore, obj *ProposalExecution) error {
+	return t.table.Delete(ctx, obj)
+}
+
+// Has checks if a ProposalExecution with the given natural key exists.
+func (t ProposalExecutionTable) Has(ctx orm.HasKVStore, naturalKey []byte) bool {
+	return t.table.Has(ctx, naturalKey)
+}
+
+// Contains checks if a ProposalExecution with the natural key of the given object exists.
+func (t ProposalExecutionTable) Contains(ctx orm.HasKVStore, obj *ProposalExecution) bool {
+	return t.table.Contains(ctx, obj)
+}
+
+// Get returns the ProposalExecution with the given natural key. An orm.ErrNotFound is returned when it does not exist.
+func (t ProposalExecutionTable) Get(ctx orm.HasKVStore, naturalKey []byte) (ProposalExecution, error) {
+	var obj ProposalExecution
+	err := t.table.GetOne(ctx, naturalKey, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the natural keys from start to the exclusive end in ascending order.
+func (t ProposalExecutionTable) PrefixScan(ctx orm.HasKVStore, start, end []byte) (ProposalExecutionIterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return ProposalExecutionIterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the natural keys from start to the exclusive end in descending order.
+func (t ProposalExecutionTable) ReversePrefixScan(ctx orm.HasKVStore, start, end []byte) (ProposalExecutionIterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return ProposalExecutionIterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the natural keys from start to the exclusive end in ascending order.
+func (t ProposalExecutionTable) PaginatedPrefixScan(ctx orm.HasKVStore, start, end []byte, pageRequest *orm.PageRequest) ([]ProposalExecution, *orm.PageResponse, error) {
+	var objs []ProposalExecution
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// VerifyIndexes checks that the indexes of the ProposalExecution table are consistent with the table data.
+func (t ProposalExecutionTable) VerifyIndexes(ctx orm.HasKVStore) error {
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t ProposalExecutionTable) Table() orm.Table {
+	return t.table.Table()
+}
+
+// ProposalExecutionIterator is an orm.Iterator over ProposalExecution objects.
+type ProposalExecutionIterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next ProposalExecution into dest which must be a *ProposalExecution. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i ProposalExecutionIterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*ProposalExecution)
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with ProposalExecution", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i ProposalExecutionIterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining ProposalExecution objects and closes the iterator.
+func (i ProposalExecutionIterator) ReadAll() ([]ProposalExecution, error) {
+	defer i.it.Close()
+	result := make([]ProposalExecution, 0)
+	for {
+		var obj ProposalExecution
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
+
+const (
+	VoteTablePrefix           byte = 0x40
+	VoteByProposalIndexPrefix byte = 0x41
+	VoteByVoterIndexPrefix    byte = 0x42
+)
+
+// NaturalKey returns the natural key of the Vote in the Vote table.
+func (m Vote) NaturalKey() []byte {
+	result := make([]byte, 0, 8+len(m.Voter))
+	result = append(result, orm.EncodeSequence(uint64(m.Proposal))...)
+	result = append(result, []byte(m.Voter)...)
+	return result
+}
+
+// VoteTable is a typed orm.NaturalKeyTable of Vote objects.
+type VoteTable struct {
+	table           orm.NaturalKeyTable
+	byProposalIndex orm.UInt64Index
+	byVoterIndex    orm.MultiKeyIndex
+}
+
+// NewVoteTable creates the Vote table with its indexes.
+func NewVoteTable(storeKey sdk.StoreKey) VoteTable {
+	builder := orm.NewNaturalKeyTableBuilder(VoteTablePrefix, storeKey, &Vote{}, orm.Max255DynamicLengthIndexKeyCodec{})
+	builder.DisableTypeCheck()
+	var t VoteTable
+	t.byProposalIndex = orm.NewUInt64Index(builder, VoteByProposalIndexPrefix, func(value interface{}) ([]uint64, error) {
+		return []uint64{uint64(value.(*Vote).Proposal)}, nil
+	})
+	t.byVoterIndex = orm.NewIndex(builder, VoteByVoterIndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+		return []orm.RowID{[]byte(value.(*Vote).Voter)}, nil
+	})
+	t.table = builder.Build()
+	return t
+}
+
+// Create persists the Vote under its natural key. An orm.ErrUniqueConstraint is returned when the key exists.
+func (t VoteTable) Create(ctx orm.HasKVStore, obj *Vote) error {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the Vote stored under its natural key.
+func (t VoteTable) Save(ctx orm.HasKVStore, obj *Vote) error {
+	return t.table.Save(ctx, obj)
+}
+
+// Delete removes the Vote stored under its natural key.
+func (t VoteTable) Delete(ctx orm.HasKVStore, obj *Vote) error {
+	return t.table.Delete(ctx, obj)
+}
+
+// Has checks if a Vote with the given natural key exists.
+func (t VoteTable) Has(ctx orm.HasKVStore, naturalKey []byte) bool {
+	return t.table.Has(ctx, naturalKey)
+}
+
+// Contains checks if a Vote with the natural key of the given object exists.
+func (t VoteTable) Contains(ctx orm.HasKVStore, obj *Vote) bool {
+	return t.table.Contains(ctx, obj)
+}
+
+// Get returns the Vote with the given natural key. An orm.ErrNotFound is returned when it does not exist.
+func (t VoteTable) Get(ctx orm.HasKVStore, naturalKey []byte) (Vote, error) {
+	var obj Vote
+	err := t.table.GetOne(ctx, naturalKey, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the natural keys from start to the exclusive end in ascending order.
+func (t VoteTable) PrefixScan(ctx orm.HasKVStore, start, end []byte) (VoteIterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return VoteIterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the natural keys from start to the exclusive end in descending order.
+func (t VoteTable) ReversePrefixScan(ctx orm.HasKVStore, start, end []byte) (VoteIterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return VoteIterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the natural keys from start to the exclusive end in ascending order.
+func (t VoteTable) PaginatedPrefixScan(ctx orm.HasKVStore, start, end []byte, pageRequest *orm.PageRequest) ([]Vote, *orm.PageResponse, error) {
+	var objs []Vote
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// HasByProposal checks if the ByProposal index contains the key.
+func (t VoteTable) HasByProposal(ctx orm.HasKVStore, key ProposalID) bool {
+	return t.byProposalIndex.Has(ctx, uint64(key))
+}
+
+// GetByProposal returns an iterator over all Vote objects with the key in the ByProposal index.
+func (t VoteTable) GetByProposal(ctx orm.HasKVStore, key ProposalID) (VoteIterator, error) {
+	it, err := t.byProposalIndex.Get(ctx, uint64(key))
+	return VoteIterator{it: it}, err
+}
+
+// PrefixScanByProposal returns an iterator over the ByProposal index from start to the exclusive end in ascending order.
+func (t VoteTable) PrefixScanByProposal(ctx orm.HasKVStore, start, end ProposalID) (VoteIterator, error) {
+	it, err := t.byProposalIndex.PrefixScan(ctx, uint64(start), uint64(end))
+	return VoteIterator{it: it}, err
+}
+
+// HasByVoter checks if the ByVoter index contains the key.
+func (t VoteTable) HasByVoter(ctx orm.HasKVStore, key sdk.AccAddress) bool {
+	return t.byVoterIndex.Has(ctx, []byte(key))
+}
+
+// GetByVoter returns an iterator over all Vote objects with the key in the ByVoter index.
+func (t VoteTable) GetByVoter(ctx orm.HasKVStore, key sdk.AccAddress) (VoteIterator, error) {
+	it, err := t.byVoterIndex.Get(ctx, []byte(key))
+	return VoteIterator{it: it}, err
+}
+
+// PrefixScanByVoter returns an iterator over the ByVoter index from start to the exclusive end in ascending order.
+func (t VoteTable) PrefixScanByVoter(ctx orm.HasKVStore, start, end sdk.AccAddress) (VoteIterator, error) {
+	it, err := t.byVoterIndex.PrefixScan(ctx, []byte(start), []byte(end))
+	return VoteIterator{it: it}, err
+}
+
+// VerifyIndexes checks that the indexes of the Vote table are consistent with the table data.
+func (t VoteTable) VerifyIndexes(ctx orm.HasKVStore) error {
+	if err := t.byProposalIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByProposal")
+	}
+	if err := t.byVoterIndex.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "ByVoter")
+	}
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t VoteTable) Table() orm.Table {
+	return t.table.Table()
+}
+
+// VoteIterator is an orm.Iterator over Vote objects.
+type VoteIterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next Vote into dest which must be a *Vote. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i VoteIterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*Vote)
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with Vote", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i VoteIterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining Vote objects and closes the iterator.
+func (i VoteIterator) ReadAll() ([]Vote, error) {
+	defer i.it.Close()
+	result := make([]Vote, 0)
+	for {
+		var obj Vote
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
@@ -4,21 +4,31 @@
 package group
 
 import (
+	bytes "bytes"
+	context "context"
+	encoding_json "encoding/json"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/modules/incubator/orm"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -71,6 +81,9 @@ const (
 	ProposalStatusClosed ProposalBase_Status = 2
 	// Final status of a proposal when the group was modified before the final tally.
 	ProposalStatusAborted ProposalBase_Status = 3
+	// Final status of a proposal that was withdrawn by a proposer or the group account admin before the final
+	// tally.
+	ProposalStatusWithdrawn ProposalBase_Status = 4
 )
 
 var ProposalBase_Status_name = map[int32]string{
@@ -78,6 +91,7 @@ var ProposalBase_Status_name = map[int32]string{
 	1: "PROPOSAL_STATUS_SUBMITTED",
 	2: "PROPOSAL_STATUS_CLOSED",
 	3: "PROPOSAL_STATUS_ABORTED",
+	4: "PROPOSAL_STATUS_WITHDRAWN",
 }
 
 var ProposalBase_Status_value = map[string]int32{
@@ -85,6 +99,7 @@ var ProposalBase_Status_value = map[string]int32{
 	"PROPOSAL_STATUS_SUBMITTED": 1,
 	"PROPOSAL_STATUS_CLOSED":    2,
 	"PROPOSAL_STATUS_ABORTED":   3,
+	"PROPOSAL_STATUS_WITHDRAWN": 4,
 }
 
 func (x ProposalBase_Status) String() string {
@@ -92,7 +107,7 @@ func (x ProposalBase_Status) String() string {
 }
 
 func (ProposalBase_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{21, 0}
+	return fileDescriptor_d938547f84707355, []int{35, 0}
 }
 
 type ProposalBase_Result int32
@@ -127,7 +142,7 @@ func (x ProposalBase_Result) String() string {
 }
 
 func (ProposalBase_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{21, 1}
+	return fileDescriptor_d938547f84707355, []int{35, 1}
 }
 
 type ProposalBase_ExecutorResult int32
@@ -162,196 +177,7 @@ func (x ProposalBase_ExecutorResult) String() string {
 }
 
 func (ProposalBase_ExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{21, 2}
-}
-
-type Msg struct {
-	// Types that are valid to be assigned to Sum:
-	//	*Msg_CreateGroup
-	//	*Msg_UpdateGroupMembers
-	//	*Msg_UpdateGroupAdmin
-	//	*Msg_UpdateGroupComment
-	//	*Msg_CreateGroupAccount
-	//	*Msg_UpdateGroupAccountAdmin
-	//	*Msg_UpdateGroupAccountDecisionPolicy
-	//	*Msg_UpdateGroupAccountComment
-	//	*Msg_Vote
-	//	*Msg_Exec
-	Sum isMsg_Sum `protobuf_oneof:"sum"`
-}
-
-func (m *Msg) Reset()         { *m = Msg{} }
-func (m *Msg) String() string { return proto.CompactTextString(m) }
-func (*Msg) ProtoMessage()    {}
-func (*Msg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{0}
-}
-func (m *Msg) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Msg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Msg.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Msg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Msg.Merge(m, src)
-}
-func (m *Msg) XXX_Size() int {
-	return m.Size()
-}
-func (m *Msg) XXX_DiscardUnknown() {
-	xxx_messageInfo_Msg.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Msg proto.InternalMessageInfo
-
-type isMsg_Sum interface {
-	isMsg_Sum()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Msg_CreateGroup struct {
-	CreateGroup *MsgCreateGroup `protobuf:"bytes,1,opt,name=create_group,json=createGroup,proto3,oneof" json:"create_group,omitempty"`
-}
-type Msg_UpdateGroupMembers struct {
-	UpdateGroupMembers *MsgUpdateGroupMembers `protobuf:"bytes,2,opt,name=update_group_members,json=updateGroupMembers,proto3,oneof" json:"update_group_members,omitempty"`
-}
-type Msg_UpdateGroupAdmin struct {
-	UpdateGroupAdmin *MsgUpdateGroupAdmin `protobuf:"bytes,3,opt,name=update_group_admin,json=updateGroupAdmin,proto3,oneof" json:"update_group_admin,omitempty"`
-}
-type Msg_UpdateGroupComment struct {
-	UpdateGroupComment *MsgUpdateGroupComment `protobuf:"bytes,4,opt,name=update_group_comment,json=updateGroupComment,proto3,oneof" json:"update_group_comment,omitempty"`
-}
-type Msg_CreateGroupAccount struct {
-	CreateGroupAccount *MsgCreateGroupAccountStd `protobuf:"bytes,5,opt,name=create_group_account,json=createGroupAccount,proto3,oneof" json:"create_group_account,omitempty"`
-}
-type Msg_UpdateGroupAccountAdmin struct {
-	UpdateGroupAccountAdmin *MsgUpdateGroupAccountAdmin `protobuf:"bytes,6,opt,name=update_group_account_admin,json=updateGroupAccountAdmin,proto3,oneof" json:"update_group_account_admin,omitempty"`
-}
-type Msg_UpdateGroupAccountDecisionPolicy struct {
-	UpdateGroupAccountDecisionPolicy *MsgUpdateGroupAccountDecisionPolicyStd `protobuf:"bytes,7,opt,name=update_group_account_decision_policy,json=updateGroupAccountDecisionPolicy,proto3,oneof" json:"update_group_account_decision_policy,omitempty"`
-}
-type Msg_UpdateGroupAccountComment struct {
-	UpdateGroupAccountComment *MsgUpdateGroupAccountComment `protobuf:"bytes,8,opt,name=update_group_account_comment,json=updateGroupAccountComment,proto3,oneof" json:"update_group_account_comment,omitempty"`
-}
-type Msg_Vote struct {
-	Vote *MsgVote `protobuf:"bytes,9,opt,name=vote,proto3,oneof" json:"vote,omitempty"`
-}
-type Msg_Exec struct {
-	Exec *MsgExec `protobuf:"bytes,10,opt,name=exec,proto3,oneof" json:"exec,omitempty"`
-}
-
-func (*Msg_CreateGroup) isMsg_Sum()                      {}
-func (*Msg_UpdateGroupMembers) isMsg_Sum()               {}
-func (*Msg_UpdateGroupAdmin) isMsg_Sum()                 {}
-func (*Msg_UpdateGroupComment) isMsg_Sum()               {}
-func (*Msg_CreateGroupAccount) isMsg_Sum()               {}
-func (*Msg_UpdateGroupAccountAdmin) isMsg_Sum()          {}
-func (*Msg_UpdateGroupAccountDecisionPolicy) isMsg_Sum() {}
-func (*Msg_UpdateGroupAccountComment) isMsg_Sum()        {}
-func (*Msg_Vote) isMsg_Sum()                             {}
-func (*Msg_Exec) isMsg_Sum()                             {}
-
-func (m *Msg) GetSum() isMsg_Sum {
-	if m != nil {
-		return m.Sum
-	}
-	return nil
-}
-
-func (m *Msg) GetCreateGroup() *MsgCreateGroup {
-	if x, ok := m.GetSum().(*Msg_CreateGroup); ok {
-		return x.CreateGroup
-	}
-	return nil
-}
-
-func (m *Msg) GetUpdateGroupMembers() *MsgUpdateGroupMembers {
-	if x, ok := m.GetSum().(*Msg_UpdateGroupMembers); ok {
-		return x.UpdateGroupMembers
-	}
-	return nil
-}
-
-func (m *Msg) GetUpdateGroupAdmin() *MsgUpdateGroupAdmin {
-	if x, ok := m.GetSum().(*Msg_UpdateGroupAdmin); ok {
-		return x.UpdateGroupAdmin
-	}
-	return nil
-}
-
-func (m *Msg) GetUpdateGroupComment() *MsgUpdateGroupComment {
-	if x, ok := m.GetSum().(*Msg_UpdateGroupComment); ok {
-		return x.UpdateGroupComment
-	}
-	return nil
-}
-
-func (m *Msg) GetCreateGroupAccount() *MsgCreateGroupAccountStd {
-	if x, ok := m.GetSum().(*Msg_CreateGroupAccount); ok {
-		return x.CreateGroupAccount
-	}
-	return nil
-}
-
-func (m *Msg) GetUpdateGroupAccountAdmin() *MsgUpdateGroupAccountAdmin {
-	if x, ok := m.GetSum().(*Msg_UpdateGroupAccountAdmin); ok {
-		return x.UpdateGroupAccountAdmin
-	}
-	return nil
-}
-
-func (m *Msg) GetUpdateGroupAccountDecisionPolicy() *MsgUpdateGroupAccountDecisionPolicyStd {
-	if x, ok := m.GetSum().(*Msg_UpdateGroupAccountDecisionPolicy); ok {
-		return x.UpdateGroupAccountDecisionPolicy
-	}
-	return nil
-}
-
-func (m *Msg) GetUpdateGroupAccountComment() *MsgUpdateGroupAccountComment {
-	if x, ok := m.GetSum().(*Msg_UpdateGroupAccountComment); ok {
-		return x.UpdateGroupAccountComment
-	}
-	return nil
-}
-
-func (m *Msg) GetVote() *MsgVote {
-	if x, ok := m.GetSum().(*Msg_Vote); ok {
-		return x.Vote
-	}
-	return nil
-}
-
-func (m *Msg) GetExec() *MsgExec {
-	if x, ok := m.GetSum().(*Msg_Exec); ok {
-		return x.Exec
-	}
-	return nil
-}
-
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Msg) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Msg_CreateGroup)(nil),
-		(*Msg_UpdateGroupMembers)(nil),
-		(*Msg_UpdateGroupAdmin)(nil),
-		(*Msg_UpdateGroupComment)(nil),
-		(*Msg_CreateGroupAccount)(nil),
-		(*Msg_UpdateGroupAccountAdmin)(nil),
-		(*Msg_UpdateGroupAccountDecisionPolicy)(nil),
-		(*Msg_UpdateGroupAccountComment)(nil),
-		(*Msg_Vote)(nil),
-		(*Msg_Exec)(nil),
-	}
+	return fileDescriptor_d938547f84707355, []int{35, 2}
 }
 
 type MsgCreateGroup struct {
@@ -364,7 +190,7 @@ func (m *MsgCreateGroup) Reset()         { *m = MsgCreateGroup{} }
 func (m *MsgCreateGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGroup) ProtoMessage()    {}
 func (*MsgCreateGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{1}
+	return fileDescriptor_d938547f84707355, []int{0}
 }
 func (m *MsgCreateGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,6 +240,50 @@ func (m *MsgCreateGroup) GetComment() string {
 	return ""
 }
 
+type MsgCreateGroupResponse struct {
+	Group GroupID `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
+}
+
+func (m *MsgCreateGroupResponse) Reset()         { *m = MsgCreateGroupResponse{} }
+func (m *MsgCreateGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGroupResponse) ProtoMessage()    {}
+func (*MsgCreateGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{1}
+}
+func (m *MsgCreateGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGroupResponse.Merge(m, src)
+}
+func (m *MsgCreateGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGroupResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGroupResponse) GetGroup() GroupID {
+	if m != nil {
+		return m.Group
+	}
+	return 0
+}
+
 type MsgUpdateGroupMembers struct {
 	Admin         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
 	Group         GroupID                                       `protobuf:"varint,2,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
@@ -474,6 +344,42 @@ func (m *MsgUpdateGroupMembers) GetMemberUpdates() []Member {
 	return nil
 }
 
+type MsgUpdateGroupMembersResponse struct {
+}
+
+func (m *MsgUpdateGroupMembersResponse) Reset()         { *m = MsgUpdateGroupMembersResponse{} }
+func (m *MsgUpdateGroupMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupMembersResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{3}
+}
+func (m *MsgUpdateGroupMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupMembersResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupMembersResponse proto.InternalMessageInfo
+
 type MsgUpdateGroupAdmin struct {
 	Admin    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
 	Group    GroupID                                       `protobuf:"varint,2,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
@@ -484,7 +390,7 @@ func (m *MsgUpdateGroupAdmin) Reset()         { *m = MsgUpdateGroupAdmin{} }
 func (m *MsgUpdateGroupAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAdmin) ProtoMessage()    {}
 func (*MsgUpdateGroupAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{3}
+	return fileDescriptor_d938547f84707355, []int{4}
 }
 func (m *MsgUpdateGroupAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,6 +440,42 @@ func (m *MsgUpdateGroupAdmin) GetNewAdmin() github_com_cosmos_cosmos_sdk_types.A
 	return nil
 }
 
+type MsgUpdateGroupAdminResponse struct {
+}
+
+func (m *MsgUpdateGroupAdminResponse) Reset()         { *m = MsgUpdateGroupAdminResponse{} }
+func (m *MsgUpdateGroupAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupAdminResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{5}
+}
+func (m *MsgUpdateGroupAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupAdminResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupAdminResponse proto.InternalMessageInfo
+
 type MsgUpdateGroupComment struct {
 	Admin   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
 	Group   GroupID                                       `protobuf:"varint,2,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
@@ -544,7 +486,7 @@ func (m *MsgUpdateGroupComment) Reset()         { *m = MsgUpdateGroupComment{} }
 func (m *MsgUpdateGroupComment) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupComment) ProtoMessage()    {}
 func (*MsgUpdateGroupComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{4}
+	return fileDescriptor_d938547f84707355, []int{6}
 }
 func (m *MsgUpdateGroupComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,6 +536,42 @@ func (m *MsgUpdateGroupComment) GetComment() string {
 	return ""
 }
 
+type MsgUpdateGroupCommentResponse struct {
+}
+
+func (m *MsgUpdateGroupCommentResponse) Reset()         { *m = MsgUpdateGroupCommentResponse{} }
+func (m *MsgUpdateGroupCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupCommentResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{7}
+}
+func (m *MsgUpdateGroupCommentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupCommentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupCommentResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupCommentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupCommentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupCommentResponse proto.InternalMessageInfo
+
 type Member struct {
 	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
 	Power   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,2,opt,name=power,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power"`
@@ -604,7 +582,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{5}
+	return fileDescriptor_d938547f84707355, []int{8}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,13 +629,21 @@ type MsgCreateGroupAccountBase struct {
 	Admin   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
 	Group   GroupID                                       `protobuf:"varint,2,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
 	Comment string                                        `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// auto_exec enables the automatic execution of accepted proposals in the EndBlocker.
+	AutoExec bool `protobuf:"varint,4,opt,name=auto_exec,json=autoExec,proto3" json:"auto_exec,omitempty"`
+	// snapshot_electorate enables the electorate snapshot for new proposals of the group account.
+	SnapshotElectorate bool `protobuf:"varint,5,opt,name=snapshot_electorate,json=snapshotElectorate,proto3" json:"snapshot_electorate,omitempty"`
+	// allowed_msg_types restricts the msgs of proposals to the given types when not empty.
+	AllowedMsgTypes []MsgType `protobuf:"bytes,6,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types"`
+	// denied_msg_types rejects proposals with any msg of the given types.
+	DeniedMsgTypes []MsgType `protobuf:"bytes,7,rep,name=denied_msg_types,json=deniedMsgTypes,proto3" json:"denied_msg_types"`
 }
 
 func (m *MsgCreateGroupAccountBase) Reset()         { *m = MsgCreateGroupAccountBase{} }
 func (m *MsgCreateGroupAccountBase) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGroupAccountBase) ProtoMessage()    {}
 func (*MsgCreateGroupAccountBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{6}
+	return fileDescriptor_d938547f84707355, []int{9}
 }
 func (m *MsgCreateGroupAccountBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,6 +693,34 @@ func (m *MsgCreateGroupAccountBase) GetComment() string {
 	return ""
 }
 
+func (m *MsgCreateGroupAccountBase) GetAutoExec() bool {
+	if m != nil {
+		return m.AutoExec
+	}
+	return false
+}
+
+func (m *MsgCreateGroupAccountBase) GetSnapshotElectorate() bool {
+	if m != nil {
+		return m.SnapshotElectorate
+	}
+	return false
+}
+
+func (m *MsgCreateGroupAccountBase) GetAllowedMsgTypes() []MsgType {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (m *MsgCreateGroupAccountBase) GetDeniedMsgTypes() []MsgType {
+	if m != nil {
+		return m.DeniedMsgTypes
+	}
+	return nil
+}
+
 // MsgCreateGroupAccountStd creates a group account using one of the members of StdDecisionPolicy. Apps can
 // create their own create account msg that supports custom DecisionPolicy's using MsgCreateGroupAccountBase as
 // starting point
@@ -719,7 +733,7 @@ func (m *MsgCreateGroupAccountStd) Reset()         { *m = MsgCreateGroupAccountS
 func (m *MsgCreateGroupAccountStd) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateGroupAccountStd) ProtoMessage()    {}
 func (*MsgCreateGroupAccountStd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{7}
+	return fileDescriptor_d938547f84707355, []int{10}
 }
 func (m *MsgCreateGroupAccountStd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,6 +762,50 @@ func (m *MsgCreateGroupAccountStd) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateGroupAccountStd proto.InternalMessageInfo
 
+type MsgCreateGroupAccountResponse struct {
+	GroupAccount github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"group_account,omitempty"`
+}
+
+func (m *MsgCreateGroupAccountResponse) Reset()         { *m = MsgCreateGroupAccountResponse{} }
+func (m *MsgCreateGroupAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGroupAccountResponse) ProtoMessage()    {}
+func (*MsgCreateGroupAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{11}
+}
+func (m *MsgCreateGroupAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateGroupAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateGroupAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateGroupAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGroupAccountResponse.Merge(m, src)
+}
+func (m *MsgCreateGroupAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateGroupAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGroupAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateGroupAccountResponse proto.InternalMessageInfo
+
+func (m *MsgCreateGroupAccountResponse) GetGroupAccount() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.GroupAccount
+	}
+	return nil
+}
+
 type MsgUpdateGroupAccountAdmin struct {
 	Admin        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
 	GroupAccount github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=group_account,json=groupAccount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"group_account,omitempty"`
@@ -758,7 +816,7 @@ func (m *MsgUpdateGroupAccountAdmin) Reset()         { *m = MsgUpdateGroupAccoun
 func (m *MsgUpdateGroupAccountAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountAdmin) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{8}
+	return fileDescriptor_d938547f84707355, []int{12}
 }
 func (m *MsgUpdateGroupAccountAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,23 +866,21 @@ func (m *MsgUpdateGroupAccountAdmin) GetNewAdmin() github_com_cosmos_cosmos_sdk_
 	return nil
 }
 
-type MsgUpdateGroupAccountBase struct {
-	Admin github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
-	Group GroupID                                       `protobuf:"varint,2,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
+type MsgUpdateGroupAccountAdminResponse struct {
 }
 
-func (m *MsgUpdateGroupAccountBase) Reset()         { *m = MsgUpdateGroupAccountBase{} }
-func (m *MsgUpdateGroupAccountBase) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateGroupAccountBase) ProtoMessage()    {}
-func (*MsgUpdateGroupAccountBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{9}
+func (m *MsgUpdateGroupAccountAdminResponse) Reset()         { *m = MsgUpdateGroupAccountAdminResponse{} }
+func (m *MsgUpdateGroupAccountAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupAccountAdminResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupAccountAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{13}
 }
-func (m *MsgUpdateGroupAccountBase) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateGroupAccountAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateGroupAccountBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateGroupAccountAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateGroupAccountBase.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateGroupAccountAdminResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -834,8 +890,46 @@ func (m *MsgUpdateGroupAccountBase) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateGroupAccountBase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateGroupAccountBase.Merge(m, src)
+func (m *MsgUpdateGroupAccountAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupAccountAdminResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupAccountAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupAccountAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupAccountAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupAccountAdminResponse proto.InternalMessageInfo
+
+type MsgUpdateGroupAccountBase struct {
+	Admin        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
+	GroupAccount github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=group_account,json=groupAccount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"group_account,omitempty"`
+}
+
+func (m *MsgUpdateGroupAccountBase) Reset()         { *m = MsgUpdateGroupAccountBase{} }
+func (m *MsgUpdateGroupAccountBase) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupAccountBase) ProtoMessage()    {}
+func (*MsgUpdateGroupAccountBase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{14}
+}
+func (m *MsgUpdateGroupAccountBase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupAccountBase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupAccountBase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupAccountBase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupAccountBase.Merge(m, src)
 }
 func (m *MsgUpdateGroupAccountBase) XXX_Size() int {
 	return m.Size()
@@ -853,15 +947,15 @@ func (m *MsgUpdateGroupAccountBase) GetAdmin() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
-func (m *MsgUpdateGroupAccountBase) GetGroup() GroupID {
+func (m *MsgUpdateGroupAccountBase) GetGroupAccount() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Group
+		return m.GroupAccount
 	}
-	return 0
+	return nil
 }
 
-// MsgCreateGroupAccountStd allows a group account decision policy to be updated to a member of StdDecisionPolicy, can
-// be overridden to support custom DecisionPolicy's by apps.
+// MsgUpdateGroupAccountDecisionPolicyStd allows a group account decision policy to be updated to a member of
+// StdDecisionPolicy, can be overridden to support custom DecisionPolicy's by apps.
 type MsgUpdateGroupAccountDecisionPolicyStd struct {
 	Base           MsgUpdateGroupAccountBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base"`
 	DecisionPolicy StdDecisionPolicy         `protobuf:"bytes,2,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy"`
@@ -873,7 +967,7 @@ func (m *MsgUpdateGroupAccountDecisionPolicyStd) Reset() {
 func (m *MsgUpdateGroupAccountDecisionPolicyStd) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountDecisionPolicyStd) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountDecisionPolicyStd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{10}
+	return fileDescriptor_d938547f84707355, []int{15}
 }
 func (m *MsgUpdateGroupAccountDecisionPolicyStd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,20 +996,46 @@ func (m *MsgUpdateGroupAccountDecisionPolicyStd) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyStd proto.InternalMessageInfo
 
-func (m *MsgUpdateGroupAccountDecisionPolicyStd) GetBase() MsgUpdateGroupAccountBase {
-	if m != nil {
-		return m.Base
-	}
-	return MsgUpdateGroupAccountBase{}
+type MsgUpdateGroupAccountDecisionPolicyResponse struct {
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicyStd) GetDecisionPolicy() StdDecisionPolicy {
-	if m != nil {
-		return m.DecisionPolicy
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) Reset() {
+	*m = MsgUpdateGroupAccountDecisionPolicyResponse{}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGroupAccountDecisionPolicyResponse) ProtoMessage() {}
+func (*MsgUpdateGroupAccountDecisionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{16}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return StdDecisionPolicy{}
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyResponse.DiscardUnknown(m)
 }
 
+var xxx_messageInfo_MsgUpdateGroupAccountDecisionPolicyResponse proto.InternalMessageInfo
+
 type MsgUpdateGroupAccountComment struct {
 	Admin        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
 	GroupAccount github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=group_account,json=groupAccount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"group_account,omitempty"`
@@ -926,7 +1046,7 @@ func (m *MsgUpdateGroupAccountComment) Reset()         { *m = MsgUpdateGroupAcco
 func (m *MsgUpdateGroupAccountComment) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateGroupAccountComment) ProtoMessage()    {}
 func (*MsgUpdateGroupAccountComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{11}
+	return fileDescriptor_d938547f84707355, []int{17}
 }
 func (m *MsgUpdateGroupAccountComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -976,6 +1096,42 @@ func (m *MsgUpdateGroupAccountComment) GetComment() string {
 	return ""
 }
 
+type MsgUpdateGroupAccountCommentResponse struct {
+}
+
+func (m *MsgUpdateGroupAccountCommentResponse) Reset()         { *m = MsgUpdateGroupAccountCommentResponse{} }
+func (m *MsgUpdateGroupAccountCommentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupAccountCommentResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupAccountCommentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{18}
+}
+func (m *MsgUpdateGroupAccountCommentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupAccountCommentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupAccountCommentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupAccountCommentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupAccountCommentResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupAccountCommentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupAccountCommentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupAccountCommentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupAccountCommentResponse proto.InternalMessageInfo
+
 // StdDecisionPolicy is a set of standard decision policies that can be used by zones that do not implement custom
 // DecisionPolicy types. Apps can start with StdDecisionPolicy and later add custom DecisionPolicy's by creating
 // a MyAppDecisionPolicy and registering that with the group module codec. In order to be backwards compatible,
@@ -984,6 +1140,8 @@ func (m *MsgUpdateGroupAccountComment) GetComment() string {
 type StdDecisionPolicy struct {
 	// Types that are valid to be assigned to Sum:
 	//	*StdDecisionPolicy_Threshold
+	//	*StdDecisionPolicy_Percentage
+	//	*StdDecisionPolicy_Quorum
 	Sum isStdDecisionPolicy_Sum `protobuf_oneof:"sum"`
 }
 
@@ -991,7 +1149,7 @@ func (m *StdDecisionPolicy) Reset()         { *m = StdDecisionPolicy{} }
 func (m *StdDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*StdDecisionPolicy) ProtoMessage()    {}
 func (*StdDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{12}
+	return fileDescriptor_d938547f84707355, []int{19}
 }
 func (m *StdDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,8 +1187,16 @@ type isStdDecisionPolicy_Sum interface {
 type StdDecisionPolicy_Threshold struct {
 	Threshold *ThresholdDecisionPolicy `protobuf:"bytes,1,opt,name=threshold,proto3,oneof" json:"threshold,omitempty"`
 }
+type StdDecisionPolicy_Percentage struct {
+	Percentage *PercentageDecisionPolicy `protobuf:"bytes,2,opt,name=percentage,proto3,oneof" json:"percentage,omitempty"`
+}
+type StdDecisionPolicy_Quorum struct {
+	Quorum *QuorumDecisionPolicy `protobuf:"bytes,3,opt,name=quorum,proto3,oneof" json:"quorum,omitempty"`
+}
 
-func (*StdDecisionPolicy_Threshold) isStdDecisionPolicy_Sum() {}
+func (*StdDecisionPolicy_Threshold) isStdDecisionPolicy_Sum()  {}
+func (*StdDecisionPolicy_Percentage) isStdDecisionPolicy_Sum() {}
+func (*StdDecisionPolicy_Quorum) isStdDecisionPolicy_Sum()     {}
 
 func (m *StdDecisionPolicy) GetSum() isStdDecisionPolicy_Sum {
 	if m != nil {
@@ -1046,10 +1212,26 @@ func (m *StdDecisionPolicy) GetThreshold() *ThresholdDecisionPolicy {
 	return nil
 }
 
+func (m *StdDecisionPolicy) GetPercentage() *PercentageDecisionPolicy {
+	if x, ok := m.GetSum().(*StdDecisionPolicy_Percentage); ok {
+		return x.Percentage
+	}
+	return nil
+}
+
+func (m *StdDecisionPolicy) GetQuorum() *QuorumDecisionPolicy {
+	if x, ok := m.GetSum().(*StdDecisionPolicy_Quorum); ok {
+		return x.Quorum
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*StdDecisionPolicy) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*StdDecisionPolicy_Threshold)(nil),
+		(*StdDecisionPolicy_Percentage)(nil),
+		(*StdDecisionPolicy_Quorum)(nil),
 	}
 }
 
@@ -1059,13 +1241,17 @@ type ThresholdDecisionPolicy struct {
 	// timout is the duration from submission of a proposal to the end of voting period
 	// Within this times votes and exec messages can be submitted.
 	Timout types.Duration `protobuf:"bytes,2,opt,name=timout,proto3" json:"timout"`
+	// min_execution_period is the duration from submission of a proposal until it can be accepted and executed.
+	// It gives members time to react before an accepted proposal becomes final. Zero disables the delay and the
+	// value must be lower than the timout.
+	MinExecutionPeriod types.Duration `protobuf:"bytes,3,opt,name=min_execution_period,json=minExecutionPeriod,proto3" json:"min_execution_period"`
 }
 
 func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
 func (m *ThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
 func (*ThresholdDecisionPolicy) ProtoMessage()    {}
 func (*ThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{13}
+	return fileDescriptor_d938547f84707355, []int{20}
 }
 func (m *ThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1101,6 +1287,139 @@ func (m *ThresholdDecisionPolicy) GetTimout() types.Duration {
 	return types.Duration{}
 }
 
+func (m *ThresholdDecisionPolicy) GetMinExecutionPeriod() types.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return types.Duration{}
+}
+
+type PercentageDecisionPolicy struct {
+	// percentage is the minimum fraction of the total group weight that must vote yes for a proposal to succeed.
+	// The value must be greater than 0 and not exceed 1.
+	Percentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
+	// timout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timout types.Duration `protobuf:"bytes,2,opt,name=timout,proto3" json:"timout"`
+	// min_execution_period is the duration from submission of a proposal until it can be accepted and executed.
+	// It gives members time to react before an accepted proposal becomes final. Zero disables the delay and the
+	// value must be lower than the timout.
+	MinExecutionPeriod types.Duration `protobuf:"bytes,3,opt,name=min_execution_period,json=minExecutionPeriod,proto3" json:"min_execution_period"`
+}
+
+func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPolicy{} }
+func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*PercentageDecisionPolicy) ProtoMessage()    {}
+func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{21}
+}
+func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentageDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentageDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PercentageDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentageDecisionPolicy.Merge(m, src)
+}
+func (m *PercentageDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentageDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentageDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercentageDecisionPolicy proto.InternalMessageInfo
+
+func (m *PercentageDecisionPolicy) GetTimout() types.Duration {
+	if m != nil {
+		return m.Timout
+	}
+	return types.Duration{}
+}
+
+func (m *PercentageDecisionPolicy) GetMinExecutionPeriod() types.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return types.Duration{}
+}
+
+// QuorumDecisionPolicy follows the tally rules of the chain governance module. A proposal passes when the quorum
+// of participating weight is reached, the veto weight does not exceed the veto threshold and the yes weight exceeds
+// the threshold of all non abstain votes.
+type QuorumDecisionPolicy struct {
+	// quorum is the minimum fraction of the total group weight that must participate in the vote.
+	Quorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
+	// threshold is the fraction of yes votes of all non abstain votes that must be exceeded for a proposal to pass.
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+	// veto_threshold is the fraction of veto votes of all participating votes that rejects a proposal when exceeded.
+	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold"`
+	// timout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timout types.Duration `protobuf:"bytes,4,opt,name=timout,proto3" json:"timout"`
+	// min_execution_period is the duration from submission of a proposal until it can be accepted and executed.
+	// It gives members time to react before an accepted proposal becomes final. Zero disables the delay and the
+	// value must be lower than the timout.
+	MinExecutionPeriod types.Duration `protobuf:"bytes,5,opt,name=min_execution_period,json=minExecutionPeriod,proto3" json:"min_execution_period"`
+}
+
+func (m *QuorumDecisionPolicy) Reset()         { *m = QuorumDecisionPolicy{} }
+func (m *QuorumDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*QuorumDecisionPolicy) ProtoMessage()    {}
+func (*QuorumDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{22}
+}
+func (m *QuorumDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumDecisionPolicy.Merge(m, src)
+}
+func (m *QuorumDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumDecisionPolicy proto.InternalMessageInfo
+
+func (m *QuorumDecisionPolicy) GetTimout() types.Duration {
+	if m != nil {
+		return m.Timout
+	}
+	return types.Duration{}
+}
+
+func (m *QuorumDecisionPolicy) GetMinExecutionPeriod() types.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return types.Duration{}
+}
+
 // MsgProposeBase is the base propose msg that app should use to implement a MsgPropose type based
 // on their app Msg type.
 //
@@ -1120,7 +1439,7 @@ func (m *MsgProposeBase) Reset()         { *m = MsgProposeBase{} }
 func (m *MsgProposeBase) String() string { return proto.CompactTextString(m) }
 func (*MsgProposeBase) ProtoMessage()    {}
 func (*MsgProposeBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{14}
+	return fileDescriptor_d938547f84707355, []int{23}
 }
 func (m *MsgProposeBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1181,7 +1500,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{15}
+	return fileDescriptor_d938547f84707355, []int{24}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,6 +1557,42 @@ func (m *MsgVote) GetComment() string {
 	return ""
 }
 
+type MsgVoteResponse struct {
+}
+
+func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
+func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteResponse) ProtoMessage()    {}
+func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{25}
+}
+func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteResponse.Merge(m, src)
+}
+func (m *MsgVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
+
 type MsgExec struct {
 	Proposal ProposalID                                    `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
 	Signer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
@@ -1247,7 +1602,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{16}
+	return fileDescriptor_d938547f84707355, []int{26}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,30 +1645,23 @@ func (m *MsgExec) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	return nil
 }
 
-type GroupMetadata struct {
-	Group   GroupID                                       `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
-	Admin   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
-	Comment string                                        `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
-	// version is used to track changes to a group's membership structure that
-	// would break existing proposals. Whenever any members power is changed,
-	// or any member is added or removed this version is incremented and will
-	// cause proposals based on older versions of this group to fail
-	Version     uint64                                 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	TotalWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"totalWeight"`
+// MsgExecResponse contains the execution record when the proposal payload was executed.
+type MsgExecResponse struct {
+	Execution *ProposalExecution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
 }
 
-func (m *GroupMetadata) Reset()         { *m = GroupMetadata{} }
-func (m *GroupMetadata) String() string { return proto.CompactTextString(m) }
-func (*GroupMetadata) ProtoMessage()    {}
-func (*GroupMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{17}
+func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
+func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResponse) ProtoMessage()    {}
+func (*MsgExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{27}
 }
-func (m *GroupMetadata) XXX_Unmarshal(b []byte) error {
+func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExecResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1323,51 +1671,202 @@ func (m *GroupMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *GroupMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMetadata.Merge(m, src)
+func (m *MsgExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResponse.Merge(m, src)
 }
-func (m *GroupMetadata) XXX_Size() int {
+func (m *MsgExecResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMetadata.DiscardUnknown(m)
+func (m *MsgExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupMetadata proto.InternalMessageInfo
-
-func (m *GroupMetadata) GetGroup() GroupID {
-	if m != nil {
-		return m.Group
-	}
-	return 0
-}
+var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
-func (m *GroupMetadata) GetAdmin() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgExecResponse) GetExecution() *ProposalExecution {
 	if m != nil {
-		return m.Admin
+		return m.Execution
 	}
 	return nil
 }
 
-func (m *GroupMetadata) GetComment() string {
-	if m != nil {
-		return m.Comment
-	}
-	return ""
+// MsgWithdrawProposal withdraws a submitted proposal. The signer must be one of the proposers or the group account admin.
+type MsgWithdrawProposal struct {
+	Proposal ProposalID                                    `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+	Signer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *GroupMetadata) GetVersion() uint64 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
+func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
+func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProposal) ProtoMessage()    {}
+func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{28}
 }
-
-type GroupMember struct {
-	Group GroupID `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
-	// todo: @aaronc field has different name in `Member.address`. Can we unify this?
-	Member github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=member,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"member,omitempty"`
-	// todo: @aaronc it is `Member.power`. Can we unify this?
+func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProposal.Merge(m, src)
+}
+func (m *MsgWithdrawProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawProposal proto.InternalMessageInfo
+
+func (m *MsgWithdrawProposal) GetProposal() ProposalID {
+	if m != nil {
+		return m.Proposal
+	}
+	return 0
+}
+
+func (m *MsgWithdrawProposal) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgWithdrawProposalResponse struct {
+}
+
+func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposalResponse{} }
+func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
+func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{29}
+}
+func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProposalResponse.Merge(m, src)
+}
+func (m *MsgWithdrawProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawProposalResponse proto.InternalMessageInfo
+
+type GroupMetadata struct {
+	Group   GroupID                                       `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
+	Admin   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
+	Comment string                                        `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// version is the metadata version of the group. It is incremented whenever the admin or comment is changed.
+	// Metadata changes do not affect existing proposals.
+	Version     uint64                                 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	TotalWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"totalWeight"`
+	// membership_version is used to track changes to a group's membership structure that
+	// would break existing proposals. Whenever any members power is changed,
+	// or any member is added or removed this version is incremented and will
+	// cause proposals based on older versions of this group to fail
+	MembershipVersion uint64 `protobuf:"varint,6,opt,name=membership_version,json=membershipVersion,proto3" json:"membership_version,omitempty"`
+}
+
+func (m *GroupMetadata) Reset()         { *m = GroupMetadata{} }
+func (m *GroupMetadata) String() string { return proto.CompactTextString(m) }
+func (*GroupMetadata) ProtoMessage()    {}
+func (*GroupMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{30}
+}
+func (m *GroupMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GroupMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMetadata.Merge(m, src)
+}
+func (m *GroupMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupMetadata proto.InternalMessageInfo
+
+func (m *GroupMetadata) GetGroup() GroupID {
+	if m != nil {
+		return m.Group
+	}
+	return 0
+}
+
+func (m *GroupMetadata) GetAdmin() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Admin
+	}
+	return nil
+}
+
+func (m *GroupMetadata) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *GroupMetadata) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GroupMetadata) GetMembershipVersion() uint64 {
+	if m != nil {
+		return m.MembershipVersion
+	}
+	return 0
+}
+
+type GroupMember struct {
+	Group GroupID `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
+	// todo: @aaronc field has different name in `Member.address`. Can we unify this?
+	Member github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=member,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"member,omitempty"`
+	// todo: @aaronc it is `Member.power`. Can we unify this?
 	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 	Comment string                                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
 }
@@ -1376,7 +1875,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{18}
+	return fileDescriptor_d938547f84707355, []int{31}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,13 +1943,24 @@ type GroupAccountMetadataBase struct {
 	// version is used to track changes to a group's GroupAccountMetadataBase structure that
 	// would create a different result on a running proposal.
 	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// AutoExec enables the automatic execution of accepted proposals in the EndBlocker when the voting period ended.
+	AutoExec bool `protobuf:"varint,6,opt,name=auto_exec,json=autoExec,proto3" json:"auto_exec,omitempty"`
+	// SnapshotElectorate enables a snapshot of the member weights and total weight of the group on proposal
+	// submission. Votes are weighted against the snapshot so that group membership changes do not abort the proposal.
+	SnapshotElectorate bool `protobuf:"varint,7,opt,name=snapshot_electorate,json=snapshotElectorate,proto3" json:"snapshot_electorate,omitempty"`
+	// AllowedMsgTypes restricts the msgs of proposals to the given types when not empty. It is checked on proposal
+	// submission and execution.
+	AllowedMsgTypes []MsgType `protobuf:"bytes,8,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types"`
+	// DeniedMsgTypes rejects proposals with any msg of the given types. It is checked on proposal submission and
+	// execution.
+	DeniedMsgTypes []MsgType `protobuf:"bytes,9,rep,name=denied_msg_types,json=deniedMsgTypes,proto3" json:"denied_msg_types"`
 }
 
 func (m *GroupAccountMetadataBase) Reset()         { *m = GroupAccountMetadataBase{} }
 func (m *GroupAccountMetadataBase) String() string { return proto.CompactTextString(m) }
 func (*GroupAccountMetadataBase) ProtoMessage()    {}
 func (*GroupAccountMetadataBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{19}
+	return fileDescriptor_d938547f84707355, []int{32}
 }
 func (m *GroupAccountMetadataBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1514,6 +2024,87 @@ func (m *GroupAccountMetadataBase) GetVersion() uint64 {
 	return 0
 }
 
+func (m *GroupAccountMetadataBase) GetAutoExec() bool {
+	if m != nil {
+		return m.AutoExec
+	}
+	return false
+}
+
+func (m *GroupAccountMetadataBase) GetSnapshotElectorate() bool {
+	if m != nil {
+		return m.SnapshotElectorate
+	}
+	return false
+}
+
+func (m *GroupAccountMetadataBase) GetAllowedMsgTypes() []MsgType {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (m *GroupAccountMetadataBase) GetDeniedMsgTypes() []MsgType {
+	if m != nil {
+		return m.DeniedMsgTypes
+	}
+	return nil
+}
+
+// MsgType identifies a sdk.Msg by its route and type. An empty type matches all msgs of the route.
+type MsgType struct {
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Type  string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *MsgType) Reset()         { *m = MsgType{} }
+func (m *MsgType) String() string { return proto.CompactTextString(m) }
+func (*MsgType) ProtoMessage()    {}
+func (*MsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{33}
+}
+func (m *MsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgType.Merge(m, src)
+}
+func (m *MsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgType proto.InternalMessageInfo
+
+func (m *MsgType) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *MsgType) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
 // StdGroupAccountMetadata is a default group account metadata type to be used by apps which do not implement custom
 // DecisionPolicy's.
 type StdGroupAccountMetadata struct {
@@ -1525,7 +2116,7 @@ func (m *StdGroupAccountMetadata) Reset()         { *m = StdGroupAccountMetadata
 func (m *StdGroupAccountMetadata) String() string { return proto.CompactTextString(m) }
 func (*StdGroupAccountMetadata) ProtoMessage()    {}
 func (*StdGroupAccountMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{20}
+	return fileDescriptor_d938547f84707355, []int{34}
 }
 func (m *StdGroupAccountMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1581,8 +2172,8 @@ type ProposalBase struct {
 	Comment      string                                          `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
 	Proposers    []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,rep,name=proposers,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposers,omitempty"`
 	SubmittedAt  types.Timestamp                                 `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
-	// GroupVersion tracks the version of the group that this proposal corresponds to. When group membership is changed
-	// existing proposals for prior group versions will become invalid.
+	// GroupVersion tracks the membership version of the group that this proposal corresponds to. When group membership
+	// is changed existing proposals for prior membership versions will become invalid.
 	GroupVersion uint64 `protobuf:"varint,5,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
 	// GroupAccountVersion tracks the version of the group account that this proposal corresponds to. When a decision policy is changed
 	// an existing proposals for prior policy versions will become invalid.
@@ -1600,13 +2191,19 @@ type ProposalBase struct {
 	Timeout types.Timestamp `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout"`
 	// Result is the final result based on the votes and election rule. Initial value is NotRun.
 	ExecutorResult ProposalBase_ExecutorResult `protobuf:"varint,11,opt,name=executor_result,json=executorResult,proto3,enum=cosmos_modules.incubator.group.v1_alpha.ProposalBase_ExecutorResult" json:"executor_result,omitempty"`
+	// AutoExec is copied from the group account on creation. Accepted proposals are executed in the EndBlocker
+	// when the voting period ended and no MsgExec was submitted before.
+	AutoExec bool `protobuf:"varint,12,opt,name=auto_exec,json=autoExec,proto3" json:"auto_exec,omitempty"`
+	// SnapshotElectorate is copied from the group account on creation. When set, the group version is not checked and
+	// votes are weighted against the ElectorateSnapshot of the proposal.
+	SnapshotElectorate bool `protobuf:"varint,13,opt,name=snapshot_electorate,json=snapshotElectorate,proto3" json:"snapshot_electorate,omitempty"`
 }
 
 func (m *ProposalBase) Reset()         { *m = ProposalBase{} }
 func (m *ProposalBase) String() string { return proto.CompactTextString(m) }
 func (*ProposalBase) ProtoMessage()    {}
 func (*ProposalBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{21}
+	return fileDescriptor_d938547f84707355, []int{35}
 }
 func (m *ProposalBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1712,6 +2309,20 @@ func (m *ProposalBase) GetExecutorResult() ProposalBase_ExecutorResult {
 	return ProposalExecutorResultInvalid
 }
 
+func (m *ProposalBase) GetAutoExec() bool {
+	if m != nil {
+		return m.AutoExec
+	}
+	return false
+}
+
+func (m *ProposalBase) GetSnapshotElectorate() bool {
+	if m != nil {
+		return m.SnapshotElectorate
+	}
+	return false
+}
+
 type Tally struct {
 	YesCount     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"yes_count"`
 	NoCount      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=no_count,json=noCount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"no_count"`
@@ -1723,7 +2334,7 @@ func (m *Tally) Reset()         { *m = Tally{} }
 func (m *Tally) String() string { return proto.CompactTextString(m) }
 func (*Tally) ProtoMessage()    {}
 func (*Tally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{22}
+	return fileDescriptor_d938547f84707355, []int{36}
 }
 func (m *Tally) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,26 +2363,24 @@ func (m *Tally) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Tally proto.InternalMessageInfo
 
-type Vote struct {
-	Proposal    ProposalID                                    `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
-	Voter       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
-	Choice      Choice                                        `protobuf:"varint,3,opt,name=choice,proto3,enum=cosmos_modules.incubator.group.v1_alpha.Choice" json:"choice,omitempty"`
-	Comment     string                                        `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	SubmittedAt types.Timestamp                               `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
+// ElectorateSnapshot is the total weight of the group at proposal submission.
+type ElectorateSnapshot struct {
+	Proposal    ProposalID                             `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+	TotalWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_weight"`
 }
 
-func (m *Vote) Reset()         { *m = Vote{} }
-func (m *Vote) String() string { return proto.CompactTextString(m) }
-func (*Vote) ProtoMessage()    {}
-func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{23}
+func (m *ElectorateSnapshot) Reset()         { *m = ElectorateSnapshot{} }
+func (m *ElectorateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ElectorateSnapshot) ProtoMessage()    {}
+func (*ElectorateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{37}
 }
-func (m *Vote) XXX_Unmarshal(b []byte) error {
+func (m *ElectorateSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ElectorateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ElectorateSnapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1781,69 +2390,44 @@ func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Vote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vote.Merge(m, src)
+func (m *ElectorateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectorateSnapshot.Merge(m, src)
 }
-func (m *Vote) XXX_Size() int {
+func (m *ElectorateSnapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vote) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vote.DiscardUnknown(m)
+func (m *ElectorateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectorateSnapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Vote proto.InternalMessageInfo
+var xxx_messageInfo_ElectorateSnapshot proto.InternalMessageInfo
 
-func (m *Vote) GetProposal() ProposalID {
+func (m *ElectorateSnapshot) GetProposal() ProposalID {
 	if m != nil {
 		return m.Proposal
 	}
 	return 0
 }
 
-func (m *Vote) GetVoter() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.Voter
-	}
-	return nil
-}
-
-func (m *Vote) GetChoice() Choice {
-	if m != nil {
-		return m.Choice
-	}
-	return Choice_UNKNOWN
-}
-
-func (m *Vote) GetComment() string {
-	if m != nil {
-		return m.Comment
-	}
-	return ""
-}
-
-func (m *Vote) GetSubmittedAt() types.Timestamp {
-	if m != nil {
-		return m.SubmittedAt
-	}
-	return types.Timestamp{}
-}
-
-// Params defines the set of configurable parameters.
-type Params struct {
-	MaxCommentLength uint32 `protobuf:"varint,1,opt,name=max_comment_length,json=maxCommentLength,proto3" json:"max_comment_length,omitempty" yaml:"max_comment_length"`
+// ElectorateSnapshotMember is the weight of a group member at proposal submission.
+type ElectorateSnapshotMember struct {
+	Proposal ProposalID                                    `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+	Member   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=member,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"member,omitempty"`
+	Weight   github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 }
 
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{24}
+func (m *ElectorateSnapshotMember) Reset()         { *m = ElectorateSnapshotMember{} }
+func (m *ElectorateSnapshotMember) String() string { return proto.CompactTextString(m) }
+func (*ElectorateSnapshotMember) ProtoMessage()    {}
+func (*ElectorateSnapshotMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{38}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *ElectorateSnapshotMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ElectorateSnapshotMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ElectorateSnapshotMember.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1853,40 +2437,58 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *ElectorateSnapshotMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectorateSnapshotMember.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *ElectorateSnapshotMember) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *ElectorateSnapshotMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectorateSnapshotMember.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_ElectorateSnapshotMember proto.InternalMessageInfo
 
-func (m *Params) GetMaxCommentLength() uint32 {
+func (m *ElectorateSnapshotMember) GetProposal() ProposalID {
 	if m != nil {
-		return m.MaxCommentLength
+		return m.Proposal
 	}
 	return 0
 }
 
-type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=Params,proto3" json:"Params"`
+func (m *ElectorateSnapshotMember) GetMember() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Member
+	}
+	return nil
 }
 
-func (m *GenesisState) Reset()      { *m = GenesisState{} }
-func (*GenesisState) ProtoMessage() {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{25}
+// ProposalExecution is the record of the last execution of a proposal payload.
+type ProposalExecution struct {
+	Proposal       ProposalID                  `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+	ExecutorResult ProposalBase_ExecutorResult `protobuf:"varint,2,opt,name=executor_result,json=executorResult,proto3,enum=cosmos_modules.incubator.group.v1_alpha.ProposalBase_ExecutorResult" json:"executor_result,omitempty"`
+	ExecutedAt     types.Timestamp             `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at"`
+	// MsgResults contains the result of every msg on success.
+	MsgResults []MsgExecutionResult `protobuf:"bytes,4,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
+	// FailedMsgIndex is the position of the failed msg or -1 when the failure is not caused by a single msg, like
+	// running out of gas. Only set on failure.
+	FailedMsgIndex int32 `protobuf:"varint,5,opt,name=failed_msg_index,json=failedMsgIndex,proto3" json:"failed_msg_index,omitempty"`
+	// FailureReason is the error message on failure.
+	FailureReason string `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+
+func (m *ProposalExecution) Reset()         { *m = ProposalExecution{} }
+func (m *ProposalExecution) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecution) ProtoMessage()    {}
+func (*ProposalExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{39}
+}
+func (m *ProposalExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposalExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposalExecution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1896,2346 +2498,13229 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *ProposalExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecution.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *ProposalExecution) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *ProposalExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_ProposalExecution proto.InternalMessageInfo
 
-func (m *GenesisState) GetParams() Params {
+func (m *ProposalExecution) GetProposal() ProposalID {
 	if m != nil {
-		return m.Params
+		return m.Proposal
 	}
-	return Params{}
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.Choice", Choice_name, Choice_value)
-	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.ProposalBase_Status", ProposalBase_Status_name, ProposalBase_Status_value)
-	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.ProposalBase_Result", ProposalBase_Result_name, ProposalBase_Result_value)
-	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.ProposalBase_ExecutorResult", ProposalBase_ExecutorResult_name, ProposalBase_ExecutorResult_value)
-	proto.RegisterType((*Msg)(nil), "cosmos_modules.incubator.group.v1_alpha.Msg")
-	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroup")
-	proto.RegisterType((*MsgUpdateGroupMembers)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupMembers")
-	proto.RegisterType((*MsgUpdateGroupAdmin)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAdmin")
-	proto.RegisterType((*MsgUpdateGroupComment)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupComment")
-	proto.RegisterType((*Member)(nil), "cosmos_modules.incubator.group.v1_alpha.Member")
-	proto.RegisterType((*MsgCreateGroupAccountBase)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroupAccountBase")
-	proto.RegisterType((*MsgCreateGroupAccountStd)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroupAccountStd")
-	proto.RegisterType((*MsgUpdateGroupAccountAdmin)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountAdmin")
-	proto.RegisterType((*MsgUpdateGroupAccountBase)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountBase")
-	proto.RegisterType((*MsgUpdateGroupAccountDecisionPolicyStd)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountDecisionPolicyStd")
-	proto.RegisterType((*MsgUpdateGroupAccountComment)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountComment")
-	proto.RegisterType((*StdDecisionPolicy)(nil), "cosmos_modules.incubator.group.v1_alpha.StdDecisionPolicy")
-	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos_modules.incubator.group.v1_alpha.ThresholdDecisionPolicy")
-	proto.RegisterType((*MsgProposeBase)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgProposeBase")
-	proto.RegisterType((*MsgVote)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgVote")
-	proto.RegisterType((*MsgExec)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgExec")
-	proto.RegisterType((*GroupMetadata)(nil), "cosmos_modules.incubator.group.v1_alpha.GroupMetadata")
-	proto.RegisterType((*GroupMember)(nil), "cosmos_modules.incubator.group.v1_alpha.GroupMember")
-	proto.RegisterType((*GroupAccountMetadataBase)(nil), "cosmos_modules.incubator.group.v1_alpha.GroupAccountMetadataBase")
-	proto.RegisterType((*StdGroupAccountMetadata)(nil), "cosmos_modules.incubator.group.v1_alpha.StdGroupAccountMetadata")
-	proto.RegisterType((*ProposalBase)(nil), "cosmos_modules.incubator.group.v1_alpha.ProposalBase")
-	proto.RegisterType((*Tally)(nil), "cosmos_modules.incubator.group.v1_alpha.Tally")
-	proto.RegisterType((*Vote)(nil), "cosmos_modules.incubator.group.v1_alpha.Vote")
-	proto.RegisterType((*Params)(nil), "cosmos_modules.incubator.group.v1_alpha.Params")
-	proto.RegisterType((*GenesisState)(nil), "cosmos_modules.incubator.group.v1_alpha.GenesisState")
+func (m *ProposalExecution) GetExecutorResult() ProposalBase_ExecutorResult {
+	if m != nil {
+		return m.ExecutorResult
+	}
+	return ProposalExecutorResultInvalid
 }
 
-func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
-
-var fileDescriptor_d938547f84707355 = []byte{
-	// 1926 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x77, 0xdb, 0x8e, 0x9d, 0x3c, 0x27, 0x19, 0x53, 0x33, 0xb3, 0x71, 0xcc, 0x6c, 0xec, 0x6d,
-	0xd0, 0xb2, 0x0c, 0x1a, 0x87, 0x1d, 0x10, 0x8b, 0x22, 0x40, 0xf8, 0x2b, 0x89, 0x99, 0xc4, 0x8e,
-	0xba, 0xed, 0x2c, 0x1f, 0x23, 0x35, 0x9d, 0xee, 0x5a, 0xa7, 0xc1, 0xdd, 0x6d, 0x75, 0x57, 0x67,
-	0x92, 0x1b, 0x70, 0x61, 0x14, 0x09, 0x09, 0x38, 0x71, 0x89, 0x34, 0xab, 0xfd, 0x17, 0xe0, 0xc0,
-	0x79, 0x39, 0xac, 0x38, 0x2d, 0xe2, 0x02, 0x48, 0x44, 0x30, 0x73, 0x81, 0x1b, 0x70, 0xe0, 0x30,
-	0x5c, 0x50, 0x57, 0x55, 0xc7, 0xee, 0xd8, 0xce, 0xfa, 0x23, 0x3b, 0x3b, 0x37, 0x77, 0x57, 0xbd,
-	0xdf, 0x7b, 0xbf, 0xf7, 0x5e, 0xbf, 0xf7, 0xaa, 0x0c, 0x29, 0x72, 0xd2, 0xc5, 0x6e, 0xa1, 0xeb,
-	0xd8, 0xc4, 0x46, 0x9f, 0xd3, 0x6c, 0xd7, 0xb4, 0x5d, 0xc5, 0xb4, 0x75, 0xaf, 0x83, 0xdd, 0x82,
-	0x61, 0x69, 0xde, 0x81, 0x4a, 0x6c, 0xa7, 0xd0, 0x76, 0x6c, 0xaf, 0x5b, 0x38, 0x7a, 0x53, 0x51,
-	0x3b, 0xdd, 0x43, 0x35, 0x7b, 0xab, 0x6d, 0xb7, 0x6d, 0x2a, 0xb3, 0xee, 0xff, 0x62, 0xe2, 0xd9,
-	0xb5, 0xb6, 0x6d, 0xb7, 0x3b, 0x78, 0x9d, 0x3e, 0x1d, 0x78, 0xef, 0xac, 0xeb, 0x9e, 0xa3, 0x12,
-	0xc3, 0xb6, 0xf8, 0x7a, 0xee, 0xf2, 0x3a, 0x31, 0x4c, 0xec, 0x12, 0xd5, 0xec, 0xf2, 0x0d, 0x5f,
-	0x20, 0x87, 0x86, 0xa3, 0x2b, 0x5d, 0xd5, 0x21, 0x27, 0x6c, 0xd7, 0x3a, 0xb3, 0xe8, 0x5e, 0xff,
-	0x03, 0xdb, 0x2c, 0xfe, 0x6f, 0x1e, 0x62, 0xbb, 0x6e, 0x1b, 0x3d, 0x84, 0x45, 0xcd, 0xc1, 0x2a,
-	0xc1, 0x0a, 0x35, 0x32, 0x23, 0xe4, 0x85, 0x37, 0x52, 0xf7, 0xdf, 0x2a, 0x8c, 0xc9, 0xa5, 0xb0,
-	0xeb, 0xb6, 0xcb, 0x54, 0x7e, 0xcb, 0x7f, 0xbf, 0x1d, 0x91, 0x52, 0x5a, 0xef, 0x11, 0x39, 0x70,
-	0xcb, 0xeb, 0xea, 0x17, 0xe8, 0x8a, 0x89, 0xcd, 0x03, 0xec, 0xb8, 0x99, 0x28, 0xd5, 0xf2, 0x8d,
-	0x49, 0xb4, 0xb4, 0x28, 0x0e, 0x85, 0xdd, 0x65, 0x28, 0xdb, 0x11, 0x09, 0x79, 0x03, 0x6f, 0x51,
-	0x07, 0x50, 0x48, 0xa7, 0xaa, 0x9b, 0x86, 0x95, 0x89, 0x51, 0x8d, 0x5f, 0x9b, 0x52, 0x63, 0xd1,
-	0xc7, 0xd8, 0x8e, 0x48, 0x69, 0xef, 0xd2, 0xbb, 0x01, 0x86, 0x9a, 0x6d, 0x9a, 0xd8, 0x22, 0x99,
-	0xf8, 0x4c, 0x0c, 0xcb, 0x0c, 0xe5, 0x12, 0x43, 0xfe, 0x16, 0x79, 0x70, 0xab, 0x3f, 0x66, 0x8a,
-	0xaa, 0x69, 0xb6, 0x67, 0x91, 0xcc, 0x1c, 0xd5, 0x59, 0x9c, 0x32, 0x76, 0x45, 0x86, 0x22, 0x13,
-	0xdd, 0x57, 0xab, 0x0d, 0x2c, 0xa0, 0x9f, 0x08, 0x90, 0x0d, 0x7b, 0x96, 0x2d, 0x70, 0x0f, 0x27,
-	0xa8, 0xf6, 0xf2, 0xb4, 0x1e, 0x66, 0x58, 0x81, 0xa3, 0x57, 0xbc, 0xe1, 0x4b, 0xe8, 0x5d, 0x01,
-	0x3e, 0x3b, 0xd4, 0x08, 0x1d, 0x6b, 0x86, 0x6b, 0xd8, 0x96, 0xd2, 0xb5, 0x3b, 0x86, 0x76, 0x92,
-	0x49, 0x52, 0x73, 0x1a, 0xb3, 0x99, 0x53, 0xe1, 0xa0, 0x7b, 0x14, 0x93, 0xb9, 0x26, 0xef, 0x7d,
-	0xc4, 0x36, 0xf4, 0x58, 0x80, 0x3b, 0x43, 0x6d, 0x0c, 0x92, 0x63, 0x9e, 0xda, 0x56, 0x9d, 0xcd,
-	0xb6, 0x5e, 0x8e, 0xac, 0x7a, 0xa3, 0x16, 0xd1, 0x26, 0xc4, 0x8f, 0x6c, 0x82, 0x33, 0x0b, 0x54,
-	0xe3, 0x17, 0x27, 0xd1, 0xb8, 0x6f, 0x13, 0xbc, 0x1d, 0x91, 0xa8, 0xbc, 0x8f, 0x83, 0x8f, 0xb1,
-	0x96, 0x81, 0xc9, 0x71, 0xaa, 0xc7, 0x58, 0xf3, 0x71, 0x7c, 0xf9, 0xd2, 0x1c, 0xc4, 0x5c, 0xcf,
-	0x14, 0x7f, 0x27, 0xc0, 0x72, 0x38, 0xfb, 0xd0, 0x16, 0xcc, 0xb1, 0x3c, 0xf2, 0x2b, 0xd0, 0x62,
-	0xe9, 0xcd, 0xe7, 0xe7, 0xb9, 0x7b, 0x6d, 0x83, 0x1c, 0x7a, 0x07, 0x05, 0xcd, 0x36, 0x79, 0xf1,
-	0x0a, 0x0a, 0x9a, 0xab, 0xff, 0x70, 0x9d, 0x95, 0xde, 0xa2, 0xa6, 0x15, 0x75, 0xdd, 0xc1, 0xae,
-	0x2b, 0x31, 0x79, 0xd4, 0x80, 0x64, 0xaf, 0xcc, 0xc4, 0xde, 0x48, 0xdd, 0x5f, 0x1f, 0xdf, 0x5a,
-	0x2a, 0x57, 0x8a, 0x7f, 0x70, 0x9e, 0x8b, 0x48, 0x01, 0x0a, 0xca, 0x40, 0x32, 0x08, 0x9c, 0x5f,
-	0x45, 0x16, 0xa4, 0xe0, 0x51, 0xfc, 0xbb, 0x00, 0xb7, 0x87, 0x96, 0xa6, 0xeb, 0x63, 0xf3, 0x1a,
-	0xcc, 0xb1, 0xc2, 0xec, 0x97, 0xcc, 0x78, 0x29, 0xf5, 0xfc, 0x3c, 0x97, 0xa4, 0x9a, 0x6a, 0x15,
-	0x89, 0xad, 0xa0, 0x87, 0xb0, 0xcc, 0x4c, 0x55, 0x58, 0x1e, 0xb8, 0x99, 0xd8, 0x2c, 0xbc, 0x97,
-	0x18, 0x18, 0x23, 0xe5, 0x8a, 0x7f, 0x10, 0xe0, 0xe6, 0x90, 0x62, 0xf8, 0x42, 0x19, 0xd6, 0x61,
-	0xc1, 0xc2, 0x8f, 0xfa, 0x2a, 0xf9, 0x54, 0xfa, 0xe6, 0x2d, 0xfc, 0x88, 0xda, 0x2e, 0x9e, 0x0d,
-	0xc4, 0x2d, 0xf8, 0x5e, 0x5e, 0x24, 0xab, 0xd1, 0x79, 0xf5, 0x1b, 0x01, 0x12, 0x2c, 0x26, 0xe8,
-	0x01, 0x24, 0x55, 0x86, 0x3c, 0xbd, 0x49, 0x01, 0x02, 0xaa, 0xc0, 0x5c, 0xd7, 0x7e, 0x84, 0x1d,
-	0x6a, 0xd4, 0x42, 0xa9, 0xe0, 0xc7, 0xfb, 0x2f, 0xe7, 0xb9, 0xd7, 0xc7, 0x80, 0xab, 0x60, 0x4d,
-	0x62, 0xc2, 0x57, 0xd8, 0xfd, 0xae, 0x00, 0xab, 0x43, 0x9b, 0x4a, 0x49, 0x75, 0xf1, 0x4b, 0xe2,
-	0xdb, 0x7f, 0x0a, 0x90, 0x19, 0xd5, 0xf8, 0xd0, 0x43, 0x88, 0x1f, 0xa8, 0x2e, 0xe6, 0x53, 0x50,
-	0x69, 0xb6, 0x4e, 0xea, 0x93, 0xe6, 0xdf, 0x14, 0x45, 0x45, 0x06, 0xdc, 0xb8, 0xdc, 0xa5, 0xd8,
-	0x20, 0xb4, 0x31, 0xb6, 0x22, 0x99, 0xe8, 0xe1, 0x66, 0xc3, 0x15, 0x2c, 0xeb, 0xa1, 0xb7, 0x1b,
-	0xf1, 0xc7, 0x4f, 0x72, 0x11, 0xf1, 0x67, 0x51, 0xc8, 0x8e, 0x6e, 0xb3, 0xd7, 0x17, 0x90, 0x7d,
-	0x58, 0x0a, 0x4f, 0x22, 0xd1, 0x69, 0x01, 0x17, 0xdb, 0xfd, 0x13, 0xc7, 0x75, 0x7f, 0xf7, 0x3f,
-	0x65, 0xf9, 0x39, 0xe8, 0x8f, 0x17, 0x9d, 0x9f, 0xe2, 0xbf, 0x05, 0x78, 0x7d, 0xbc, 0x89, 0x63,
-	0x96, 0x9c, 0x1c, 0x4e, 0xf4, 0x13, 0xca, 0x49, 0xf1, 0xcf, 0x02, 0xdc, 0xb9, 0x6a, 0x92, 0x79,
-	0xf9, 0xf3, 0x71, 0x74, 0x55, 0xf9, 0x85, 0x00, 0x9f, 0x1a, 0xf0, 0x03, 0xfa, 0x3e, 0x2c, 0x90,
-	0x43, 0x07, 0xbb, 0x87, 0x76, 0x47, 0xe7, 0xf1, 0xfb, 0xe6, 0xd8, 0x6e, 0x6d, 0x06, 0x92, 0x61,
-	0xd0, 0xed, 0x88, 0xd4, 0x03, 0xdd, 0xb8, 0xf9, 0xfb, 0x5f, 0xdf, 0xbb, 0x71, 0xf7, 0x92, 0xfb,
-	0xf9, 0x90, 0xf5, 0x44, 0x80, 0x95, 0x11, 0x20, 0x68, 0xe7, 0xb2, 0x65, 0x93, 0x77, 0x83, 0x1e,
-	0x00, 0x7a, 0x0b, 0x12, 0xc4, 0x30, 0x6d, 0x8f, 0xf0, 0xdc, 0x59, 0x2d, 0xb0, 0xb3, 0x6a, 0x21,
-	0x38, 0xab, 0x16, 0x2a, 0xfc, 0x2c, 0xcb, 0x53, 0x83, 0x6f, 0x17, 0xff, 0xc8, 0xe6, 0xc0, 0x3d,
-	0xc7, 0xee, 0xda, 0x2e, 0xa6, 0x5f, 0xe1, 0x40, 0xec, 0x84, 0xeb, 0x89, 0x5d, 0x03, 0x16, 0xba,
-	0x4c, 0x0d, 0x1f, 0x0c, 0xa7, 0xc2, 0xec, 0x61, 0x5c, 0x91, 0x0c, 0xcf, 0x04, 0x48, 0xf2, 0x01,
-	0x1a, 0xdd, 0x85, 0x79, 0x26, 0xa2, 0x76, 0x28, 0x93, 0x78, 0x69, 0xf9, 0xf9, 0x79, 0x0e, 0xf6,
-	0xf8, 0xbb, 0x5a, 0x45, 0xba, 0x58, 0x47, 0x35, 0x48, 0xf8, 0xc3, 0xf6, 0x2c, 0xf6, 0x71, 0x00,
-	0xb4, 0x05, 0x09, 0xed, 0xd0, 0x36, 0x34, 0x4c, 0x6d, 0x5b, 0x9e, 0x60, 0x16, 0x2c, 0x53, 0x31,
-	0x89, 0x8b, 0xf7, 0xb3, 0x8c, 0x87, 0x59, 0xfe, 0x88, 0xb1, 0xf4, 0xc7, 0xfb, 0x49, 0x59, 0xba,
-	0x46, 0xdb, 0xe2, 0x53, 0xc8, 0x74, 0x2c, 0x19, 0x80, 0xf8, 0xe3, 0x28, 0x2c, 0xf1, 0xb1, 0x9b,
-	0xa8, 0xba, 0x4a, 0xd4, 0x5e, 0xe9, 0x15, 0x46, 0x8e, 0x06, 0x17, 0x55, 0x26, 0x3a, 0x63, 0x95,
-	0x19, 0x99, 0x00, 0xfe, 0xca, 0x11, 0x76, 0xfc, 0xcf, 0x8d, 0x3a, 0x2d, 0x2e, 0x05, 0x8f, 0x68,
-	0x0f, 0x52, 0xc4, 0x26, 0x6a, 0xe7, 0x6d, 0x6c, 0xb4, 0x0f, 0xd9, 0x89, 0x7d, 0xf2, 0x2f, 0xaf,
-	0x1f, 0x42, 0xfc, 0xab, 0x00, 0xa9, 0xbe, 0xa3, 0xc7, 0x38, 0x1e, 0xa8, 0x41, 0x82, 0xcd, 0xf8,
-	0x33, 0x44, 0x80, 0x01, 0xa0, 0x4d, 0x48, 0x3c, 0x62, 0x54, 0x62, 0x53, 0x51, 0xe1, 0xd2, 0x57,
-	0xa4, 0xd9, 0x2f, 0xa3, 0x90, 0xe9, 0x6f, 0x16, 0x41, 0xa8, 0x3f, 0xd6, 0x62, 0x31, 0xc6, 0x84,
-	0x79, 0x91, 0x46, 0xb1, 0xeb, 0x4b, 0xa3, 0xf8, 0xc8, 0x34, 0x9a, 0x0b, 0xa5, 0x91, 0x7f, 0xf0,
-	0x5c, 0x91, 0x89, 0x3e, 0xcc, 0x2f, 0xe8, 0x7b, 0xa1, 0x79, 0x61, 0xfc, 0xdb, 0xa0, 0x51, 0x4e,
-	0xfe, 0xa4, 0xc6, 0x85, 0xf7, 0x17, 0x61, 0x31, 0x28, 0x20, 0x1f, 0x6b, 0xb0, 0xfb, 0x02, 0x10,
-	0x0d, 0x07, 0x20, 0xd4, 0x33, 0x62, 0xd7, 0xd0, 0x33, 0xca, 0xb0, 0xe8, 0x7a, 0x07, 0xa6, 0x41,
-	0x08, 0xd6, 0x15, 0x35, 0xb8, 0x25, 0xcc, 0x0e, 0xb4, 0xcb, 0x66, 0x70, 0xb5, 0xcb, 0x7d, 0x93,
-	0xba, 0x90, 0x2a, 0x12, 0xf4, 0x99, 0xc0, 0x0f, 0xe1, 0xe4, 0x60, 0xa4, 0xf6, 0x79, 0xa1, 0xb9,
-	0x0f, 0xb7, 0xc3, 0x77, 0x4f, 0xc1, 0xe6, 0x04, 0xdd, 0x7c, 0xb3, 0xdf, 0x03, 0x81, 0x4c, 0x13,
-	0x12, 0x2e, 0x51, 0x89, 0xe7, 0xd2, 0xcb, 0xb3, 0xe5, 0x09, 0x6e, 0x4b, 0xfb, 0xe3, 0x54, 0x90,
-	0x29, 0x86, 0xc4, 0xb1, 0x7c, 0x54, 0x07, 0xbb, 0x5e, 0x87, 0x5d, 0x7b, 0x4d, 0x8d, 0x2a, 0x51,
-	0x0c, 0x89, 0x63, 0x21, 0x19, 0xc0, 0x6f, 0x75, 0x8a, 0xaf, 0x24, 0xb8, 0xde, 0x2a, 0x8c, 0x3f,
-	0x5b, 0xa9, 0x9d, 0x4e, 0x90, 0x77, 0x0b, 0x3e, 0x8e, 0x6f, 0x33, 0x46, 0x1b, 0x90, 0x24, 0x86,
-	0x89, 0xfd, 0x41, 0x06, 0xc6, 0x8c, 0x4c, 0x20, 0x80, 0x4c, 0xb8, 0x81, 0x8f, 0xb1, 0xe6, 0x11,
-	0xdb, 0x51, 0x38, 0xdf, 0x14, 0xe5, 0x5b, 0x99, 0x8e, 0x6f, 0x95, 0x83, 0x71, 0xde, 0xcb, 0x38,
-	0xf4, 0x2c, 0xfe, 0x4b, 0x80, 0x04, 0x73, 0x34, 0xfa, 0x0a, 0xac, 0xec, 0x49, 0x8d, 0xbd, 0x86,
-	0x5c, 0xdc, 0x51, 0xe4, 0x66, 0xb1, 0xd9, 0x92, 0x95, 0x5a, 0x7d, 0xbf, 0xb8, 0x53, 0xab, 0xa4,
-	0x23, 0xd9, 0xd5, 0xd3, 0xb3, 0xfc, 0xed, 0x00, 0x98, 0x09, 0xd4, 0xac, 0x23, 0xb5, 0x63, 0xe8,
-	0x68, 0x03, 0x56, 0x2f, 0xcb, 0xc9, 0xad, 0xd2, 0x6e, 0xad, 0xd9, 0xac, 0x56, 0xd2, 0x42, 0xf6,
-	0xd3, 0xa7, 0x67, 0xf9, 0x95, 0xb0, 0xa4, 0x1c, 0x64, 0x21, 0xfa, 0x32, 0xbc, 0x72, 0x59, 0xb6,
-	0xbc, 0xd3, 0x90, 0xab, 0x95, 0x74, 0x34, 0x9b, 0x39, 0x3d, 0xcb, 0xdf, 0x0a, 0x0b, 0x96, 0x3b,
-	0xb6, 0x8b, 0xf5, 0x61, 0x96, 0x16, 0x4b, 0x0d, 0xc9, 0xd7, 0x17, 0x1b, 0x66, 0x69, 0xf1, 0xc0,
-	0x76, 0x08, 0xd6, 0xb3, 0xf1, 0xc7, 0xef, 0xad, 0x45, 0xc4, 0xff, 0x0a, 0x90, 0x60, 0xec, 0x43,
-	0x40, 0x52, 0x55, 0x6e, 0xed, 0x34, 0x47, 0x51, 0x66, 0x02, 0xc3, 0x28, 0x73, 0xb9, 0x56, 0xbd,
-	0x52, 0xdd, 0xac, 0xd5, 0x07, 0x29, 0x33, 0xc9, 0x96, 0xa5, 0xe3, 0x77, 0x0c, 0x0b, 0xeb, 0xe8,
-	0xab, 0x90, 0xb9, 0x2c, 0x5b, 0x2c, 0x97, 0xab, 0x7b, 0x4d, 0x4a, 0x3a, 0x7b, 0x7a, 0x96, 0x7f,
-	0x25, 0x2c, 0x5a, 0xd4, 0x34, 0xdc, 0x25, 0xc3, 0x25, 0xa5, 0xea, 0xb7, 0xaa, 0x65, 0xc6, 0x7b,
-	0x88, 0xa4, 0x84, 0x7f, 0x80, 0xb5, 0x1e, 0xf1, 0xdf, 0x46, 0x61, 0x39, 0x9c, 0x0e, 0x68, 0x0b,
-	0xf2, 0x17, 0x90, 0xd5, 0x6f, 0x57, 0xcb, 0xad, 0x66, 0x43, 0x1a, 0xf4, 0xc4, 0x6b, 0xa7, 0x67,
-	0xf9, 0x57, 0x03, 0xe8, 0x30, 0x42, 0xe0, 0x91, 0xcd, 0x2b, 0x80, 0xea, 0x8d, 0xa6, 0x22, 0xb5,
-	0xea, 0x69, 0x21, 0x9b, 0x3f, 0x3d, 0xcb, 0xdf, 0x19, 0x0e, 0x54, 0xb7, 0x89, 0xe4, 0x59, 0x57,
-	0x1a, 0x24, 0xb7, 0xca, 0xe5, 0xaa, 0x2c, 0xa7, 0xa3, 0x57, 0x19, 0x24, 0x7b, 0x9a, 0x86, 0x5d,
-	0xf7, 0x4a, 0xa0, 0xcd, 0x62, 0x6d, 0xa7, 0x25, 0x55, 0xd3, 0xb1, 0xab, 0x80, 0x36, 0x55, 0xa3,
-	0xe3, 0x39, 0x98, 0xfb, 0xee, 0xfd, 0x28, 0xcc, 0xd1, 0xaf, 0x1d, 0x3d, 0x80, 0x85, 0x13, 0xec,
-	0x2a, 0xbd, 0xd6, 0x31, 0xf9, 0xb4, 0x32, 0x7f, 0x82, 0xdd, 0x32, 0xed, 0x19, 0x35, 0x98, 0xb7,
-	0x6c, 0xa5, 0x77, 0xb8, 0x9c, 0x1c, 0x2b, 0x69, 0xd9, 0x0c, 0x4a, 0x86, 0x25, 0xf5, 0xc0, 0x25,
-	0xaa, 0x61, 0x71, 0xbc, 0xe9, 0x26, 0xa9, 0x45, 0x0e, 0xc2, 0x40, 0x77, 0x01, 0x8e, 0x30, 0x09,
-	0x2c, 0x8c, 0x4f, 0x77, 0xc0, 0xf3, 0x11, 0x28, 0x9c, 0xf8, 0x5e, 0x14, 0xe2, 0x13, 0x1f, 0x67,
-	0xb6, 0x60, 0x8e, 0x9e, 0x46, 0x66, 0x18, 0xb4, 0xa9, 0xfc, 0x0b, 0x38, 0xcc, 0x0c, 0x34, 0xe6,
-	0xb9, 0x29, 0x1a, 0xb3, 0xa8, 0x40, 0x62, 0x4f, 0x75, 0x54, 0xd3, 0x45, 0x0f, 0x00, 0x99, 0xea,
-	0x71, 0xf0, 0x7f, 0x8f, 0xd2, 0xc1, 0x56, 0x9b, 0x1c, 0x52, 0x87, 0x2d, 0x95, 0x5e, 0xfd, 0xcf,
-	0x79, 0x6e, 0xf5, 0x44, 0x35, 0x3b, 0x1b, 0xe2, 0xe0, 0x1e, 0x51, 0x4a, 0x9b, 0xea, 0x31, 0xbf,
-	0x10, 0xd9, 0xa1, 0xaf, 0x36, 0xe6, 0x7f, 0xf5, 0x24, 0x17, 0xf9, 0xc7, 0x93, 0x9c, 0x20, 0xb6,
-	0x61, 0x71, 0x0b, 0x5b, 0xd8, 0x35, 0x5c, 0xd6, 0xaf, 0x76, 0x03, 0x85, 0x7c, 0xd8, 0x1b, 0xdf,
-	0x31, 0x4c, 0x2c, 0x38, 0x8d, 0xb3, 0xa7, 0x9e, 0xa2, 0xbb, 0x5f, 0x87, 0x04, 0x73, 0x1d, 0x4a,
-	0x41, 0xb2, 0x55, 0x7f, 0x50, 0x6f, 0xbc, 0x5d, 0x4f, 0x47, 0x50, 0x02, 0xa2, 0xf5, 0x46, 0x5a,
-	0x40, 0x49, 0x88, 0x7d, 0xa7, 0x2a, 0xa7, 0xa3, 0xfe, 0x6a, 0xb1, 0x24, 0x37, 0x8b, 0xb5, 0x7a,
-	0x3a, 0x86, 0xe6, 0x21, 0xbe, 0x5f, 0x6d, 0x36, 0xd2, 0xf1, 0x52, 0xf9, 0x83, 0xa7, 0x6b, 0xc2,
-	0x87, 0x4f, 0xd7, 0x84, 0xbf, 0x3d, 0x5d, 0x13, 0x7e, 0xfe, 0x6c, 0x2d, 0xf2, 0xe1, 0xb3, 0xb5,
-	0xc8, 0x9f, 0x9e, 0xad, 0x45, 0xbe, 0xfb, 0xf9, 0xc1, 0x04, 0xe0, 0xb6, 0xae, 0x5f, 0xd8, 0xba,
-	0x4e, 0x6d, 0x3d, 0x48, 0x50, 0xa7, 0x7f, 0xe9, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x09, 0x67,
-	0x14, 0x97, 0x64, 0x1f, 0x00, 0x00,
+func (m *ProposalExecution) GetExecutedAt() types.Timestamp {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return types.Timestamp{}
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ProposalExecution) GetMsgResults() []MsgExecutionResult {
+	if m != nil {
+		return m.MsgResults
 	}
+	return nil
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MaxCommentLength != that1.MaxCommentLength {
-		return false
+func (m *ProposalExecution) GetFailedMsgIndex() int32 {
+	if m != nil {
+		return m.FailedMsgIndex
 	}
-	return true
+	return 0
 }
-func (this *GenesisState) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ProposalExecution) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
 	}
+	return ""
+}
 
-	that1, ok := that.(*GenesisState)
-	if !ok {
-		that2, ok := that.(GenesisState)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MsgExecutionResult is the result of a single msg of a proposal payload.
+type MsgExecutionResult struct {
+	Data   []byte           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Log    string           `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	Events []ExecutionEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
+}
+
+func (m *MsgExecutionResult) Reset()         { *m = MsgExecutionResult{} }
+func (m *MsgExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*MsgExecutionResult) ProtoMessage()    {}
+func (*MsgExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{40}
+}
+func (m *MsgExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Params.Equal(&that1.Params) {
-		return false
-	}
-	return true
 }
-func (this *StdDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
-	if x := this.GetThreshold(); x != nil {
-		return x
+func (m *MsgExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecutionResult.Merge(m, src)
+}
+func (m *MsgExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecutionResult proto.InternalMessageInfo
+
+func (m *MsgExecutionResult) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (this *StdDecisionPolicy) SetDecisionPolicy(value DecisionPolicy) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
-	}
-	switch vt := value.(type) {
-	case *ThresholdDecisionPolicy:
-		this.Sum = &StdDecisionPolicy_Threshold{vt}
-		return nil
+func (m *MsgExecutionResult) GetLog() string {
+	if m != nil {
+		return m.Log
 	}
-	return fmt.Errorf("can't encode value of type %T as message StdDecisionPolicy", value)
+	return ""
 }
 
-func (m *Msg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExecutionResult) GetEvents() []ExecutionEvent {
+	if m != nil {
+		return m.Events
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Msg) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExecutionEvent is an event emitted by a msg of a proposal payload.
+type ExecutionEvent struct {
+	Type       string                    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Attributes []ExecutionEventAttribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
 }
 
-func (m *Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *ExecutionEvent) Reset()         { *m = ExecutionEvent{} }
+func (m *ExecutionEvent) String() string { return proto.CompactTextString(m) }
+func (*ExecutionEvent) ProtoMessage()    {}
+func (*ExecutionEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{41}
+}
+func (m *ExecutionEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExecutionEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionEvent.Merge(m, src)
+}
+func (m *ExecutionEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionEvent.DiscardUnknown(m)
 }
 
-func (m *Msg_CreateGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ExecutionEvent proto.InternalMessageInfo
+
+func (m *ExecutionEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *Msg_CreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CreateGroup != nil {
-		{
-			size, err := m.CreateGroup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ExecutionEvent) GetAttributes() []ExecutionEventAttribute {
+	if m != nil {
+		return m.Attributes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Msg_UpdateGroupMembers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type ExecutionEventAttribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *Msg_UpdateGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UpdateGroupMembers != nil {
-		{
-			size, err := m.UpdateGroupMembers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *ExecutionEventAttribute) Reset()         { *m = ExecutionEventAttribute{} }
+func (m *ExecutionEventAttribute) String() string { return proto.CompactTextString(m) }
+func (*ExecutionEventAttribute) ProtoMessage()    {}
+func (*ExecutionEventAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{42}
+}
+func (m *ExecutionEventAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionEventAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionEventAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Msg_UpdateGroupAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecutionEventAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionEventAttribute.Merge(m, src)
+}
+func (m *ExecutionEventAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionEventAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionEventAttribute.DiscardUnknown(m)
 }
 
-func (m *Msg_UpdateGroupAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UpdateGroupAdmin != nil {
-		{
-			size, err := m.UpdateGroupAdmin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_ExecutionEventAttribute proto.InternalMessageInfo
+
+func (m *ExecutionEventAttribute) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Msg_UpdateGroupComment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *Msg_UpdateGroupComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UpdateGroupComment != nil {
-		{
-			size, err := m.UpdateGroupComment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *ExecutionEventAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *Msg_CreateGroupAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type Vote struct {
+	Proposal    ProposalID                                    `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+	Voter       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Choice      Choice                                        `protobuf:"varint,3,opt,name=choice,proto3,enum=cosmos_modules.incubator.group.v1_alpha.Choice" json:"choice,omitempty"`
+	Comment     string                                        `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	SubmittedAt types.Timestamp                               `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
 }
 
-func (m *Msg_CreateGroupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CreateGroupAccount != nil {
-		{
-			size, err := m.CreateGroupAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{43}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Msg_UpdateGroupAccountAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
 }
 
-func (m *Msg_UpdateGroupAccountAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UpdateGroupAccountAdmin != nil {
-		{
-			size, err := m.UpdateGroupAccountAdmin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetProposal() ProposalID {
+	if m != nil {
+		return m.Proposal
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *Msg_UpdateGroupAccountDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *Vote) GetVoter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
 }
 
-func (m *Msg_UpdateGroupAccountDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UpdateGroupAccountDecisionPolicy != nil {
-		{
-			size, err := m.UpdateGroupAccountDecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func (m *Vote) GetChoice() Choice {
+	if m != nil {
+		return m.Choice
 	}
-	return len(dAtA) - i, nil
+	return Choice_UNKNOWN
 }
-func (m *Msg_UpdateGroupAccountComment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *Vote) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
 }
 
-func (m *Msg_UpdateGroupAccountComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UpdateGroupAccountComment != nil {
-		{
-			size, err := m.UpdateGroupAccountComment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+func (m *Vote) GetSubmittedAt() types.Timestamp {
+	if m != nil {
+		return m.SubmittedAt
 	}
-	return len(dAtA) - i, nil
+	return types.Timestamp{}
 }
-func (m *Msg_Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// Params defines the set of configurable parameters.
+type Params struct {
+	MaxCommentLength uint32 `protobuf:"varint,1,opt,name=max_comment_length,json=maxCommentLength,proto3" json:"max_comment_length,omitempty" yaml:"max_comment_length"`
+	// MaxEndBlockProposals is the max number of expired proposals processed in a single EndBlocker.
+	MaxEndBlockProposals uint32 `protobuf:"varint,2,opt,name=max_end_block_proposals,json=maxEndBlockProposals,proto3" json:"max_end_block_proposals,omitempty" yaml:"max_end_block_proposals"`
+	// MaxAutoExecGas is the gas limit for the automatic execution of a single proposal in the EndBlocker.
+	MaxAutoExecGas uint64 `protobuf:"varint,3,opt,name=max_auto_exec_gas,json=maxAutoExecGas,proto3" json:"max_auto_exec_gas,omitempty" yaml:"max_auto_exec_gas"`
+	// ProposalRetention is the duration after the proposal timeout until a finalized proposal and its votes are
+	// pruned. A zero value disables pruning.
+	ProposalRetention time.Duration `protobuf:"bytes,4,opt,name=proposal_retention,json=proposalRetention,proto3,stdduration" json:"proposal_retention" yaml:"proposal_retention"`
+	// MaxVotingWindow is the upper limit for the timout of any decision policy.
+	MaxVotingWindow time.Duration `protobuf:"bytes,5,opt,name=max_voting_window,json=maxVotingWindow,proto3,stdduration" json:"max_voting_window" yaml:"max_voting_window"`
 }
 
-func (m *Msg_Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Vote != nil {
-		{
-			size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{44}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Msg_Exec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func (m *Msg_Exec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Exec != nil {
-		{
-			size, err := m.Exec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxCommentLength() uint32 {
+	if m != nil {
+		return m.MaxCommentLength
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *Params) GetMaxEndBlockProposals() uint32 {
+	if m != nil {
+		return m.MaxEndBlockProposals
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMaxAutoExecGas() uint64 {
+	if m != nil {
+		return m.MaxAutoExecGas
+	}
+	return 0
 }
 
-func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *Params) GetProposalRetention() time.Duration {
+	if m != nil {
+		return m.ProposalRetention
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateGroupMembers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMaxVotingWindow() time.Duration {
+	if m != nil {
+		return m.MaxVotingWindow
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateGroupMembers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GenesisState struct {
+	Params Params `protobuf:"bytes,1,opt,name=Params,proto3" json:"Params"`
+	// Groups is the json encoded `[]orm.Model` export of the group table.
+	Groups encoding_json.RawMessage `protobuf:"bytes,2,opt,name=groups,proto3,casttype=encoding/json.RawMessage" json:"groups,omitempty"`
+	// GroupSeq is the current value of the group ID sequence.
+	GroupSeq uint64 `protobuf:"varint,3,opt,name=group_seq,json=groupSeq,proto3" json:"group_seq,omitempty"`
+	// GroupMembers is the json encoded `[]orm.Model` export of the group member table.
+	GroupMembers encoding_json.RawMessage `protobuf:"bytes,4,opt,name=group_members,json=groupMembers,proto3,casttype=encoding/json.RawMessage" json:"group_members,omitempty"`
+	// GroupAccounts is the json encoded `[]orm.Model` export of the group account table.
+	GroupAccounts encoding_json.RawMessage `protobuf:"bytes,5,opt,name=group_accounts,json=groupAccounts,proto3,casttype=encoding/json.RawMessage" json:"group_accounts,omitempty"`
+	// GroupAccountSeq is the current value of the group account sequence.
+	GroupAccountSeq uint64 `protobuf:"varint,6,opt,name=group_account_seq,json=groupAccountSeq,proto3" json:"group_account_seq,omitempty"`
+	// Proposals is the json encoded `[]orm.Model` export of the proposal table.
+	Proposals encoding_json.RawMessage `protobuf:"bytes,7,opt,name=proposals,proto3,casttype=encoding/json.RawMessage" json:"proposals,omitempty"`
+	// ProposalSeq is the current value of the proposal ID sequence.
+	ProposalSeq uint64 `protobuf:"varint,8,opt,name=proposal_seq,json=proposalSeq,proto3" json:"proposal_seq,omitempty"`
+	// Votes is the json encoded `[]orm.Model` export of the vote table.
+	Votes encoding_json.RawMessage `protobuf:"bytes,9,opt,name=votes,proto3,casttype=encoding/json.RawMessage" json:"votes,omitempty"`
+	// ElectorateSnapshots is the json encoded `[]orm.Model` export of the electorate snapshot table.
+	ElectorateSnapshots encoding_json.RawMessage `protobuf:"bytes,10,opt,name=electorate_snapshots,json=electorateSnapshots,proto3,casttype=encoding/json.RawMessage" json:"electorate_snapshots,omitempty"`
+	// ElectorateSnapshotMembers is the json encoded `[]orm.Model` export of the electorate snapshot member table.
+	ElectorateSnapshotMembers encoding_json.RawMessage `protobuf:"bytes,11,opt,name=electorate_snapshot_members,json=electorateSnapshotMembers,proto3,casttype=encoding/json.RawMessage" json:"electorate_snapshot_members,omitempty"`
+	// ProposalExecutions is the json encoded `[]orm.Model` export of the proposal execution table.
+	ProposalExecutions encoding_json.RawMessage `protobuf:"bytes,12,opt,name=proposal_executions,json=proposalExecutions,proto3,casttype=encoding/json.RawMessage" json:"proposal_executions,omitempty"`
 }
 
-func (m *MsgUpdateGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MemberUpdates) > 0 {
-		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *GenesisState) Reset()      { *m = GenesisState{} }
+func (*GenesisState) ProtoMessage() {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{45}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateGroupAdmin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgUpdateGroupAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetGroups() encoding_json.RawMessage {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
 }
 
-func (m *MsgUpdateGroupAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewAdmin) > 0 {
-		i -= len(m.NewAdmin)
-		copy(dAtA[i:], m.NewAdmin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewAdmin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *GenesisState) GetGroupSeq() uint64 {
+	if m != nil {
+		return m.GroupSeq
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateGroupComment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetGroupMembers() encoding_json.RawMessage {
+	if m != nil {
+		return m.GroupMembers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateGroupComment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetGroupAccounts() encoding_json.RawMessage {
+	if m != nil {
+		return m.GroupAccounts
+	}
+	return nil
 }
 
-func (m *MsgUpdateGroupComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *GenesisState) GetGroupAccountSeq() uint64 {
+	if m != nil {
+		return m.GroupAccountSeq
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Member) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetProposals() encoding_json.RawMessage {
+	if m != nil {
+		return m.Proposals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetProposalSeq() uint64 {
+	if m != nil {
+		return m.ProposalSeq
+	}
+	return 0
 }
 
-func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x1a
+func (m *GenesisState) GetVotes() encoding_json.RawMessage {
+	if m != nil {
+		return m.Votes
 	}
-	{
-		size := m.Power.Size()
-		i -= size
-		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetElectorateSnapshots() encoding_json.RawMessage {
+	if m != nil {
+		return m.ElectorateSnapshots
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *GenesisState) GetElectorateSnapshotMembers() encoding_json.RawMessage {
+	if m != nil {
+		return m.ElectorateSnapshotMembers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCreateGroupAccountBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetProposalExecutions() encoding_json.RawMessage {
+	if m != nil {
+		return m.ProposalExecutions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateGroupAccountBase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PageRequest selects the page of a list query. Pages start with 1. Zero values are replaced by defaults.
+type PageRequest struct {
+	Page  uint32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *MsgCreateGroupAccountBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
+func (m *PageRequest) Reset()         { *m = PageRequest{} }
+func (m *PageRequest) String() string { return proto.CompactTextString(m) }
+func (*PageRequest) ProtoMessage()    {}
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{46}
+}
+func (m *PageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageRequest.Merge(m, src)
+}
+func (m *PageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageRequest proto.InternalMessageInfo
+
+func (m *PageRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCreateGroupAccountStd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PageRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateGroupAccountStd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupRequest struct {
+	Group GroupID `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
 }
 
-func (m *MsgCreateGroupAccountStd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGroupRequest) Reset()         { *m = QueryGroupRequest{} }
+func (m *QueryGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupRequest) ProtoMessage()    {}
+func (*QueryGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{47}
+}
+func (m *QueryGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupRequest.Merge(m, src)
+}
+func (m *QueryGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateGroupAccountAdmin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupRequest proto.InternalMessageInfo
+
+func (m *QueryGroupRequest) GetGroup() GroupID {
+	if m != nil {
+		return m.Group
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateGroupAccountAdmin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupResponse struct {
+	Group GroupMetadata `protobuf:"bytes,1,opt,name=group,proto3" json:"group"`
 }
 
-func (m *MsgUpdateGroupAccountAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewAdmin) > 0 {
-		i -= len(m.NewAdmin)
-		copy(dAtA[i:], m.NewAdmin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewAdmin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupAccount) > 0 {
-		i -= len(m.GroupAccount)
-		copy(dAtA[i:], m.GroupAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGroupResponse) Reset()         { *m = QueryGroupResponse{} }
+func (m *QueryGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupResponse) ProtoMessage()    {}
+func (*QueryGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{48}
+}
+func (m *QueryGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupResponse.Merge(m, src)
+}
+func (m *QueryGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateGroupAccountBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupResponse proto.InternalMessageInfo
+
+func (m *QueryGroupResponse) GetGroup() GroupMetadata {
+	if m != nil {
+		return m.Group
 	}
-	return dAtA[:n], nil
+	return GroupMetadata{}
 }
 
-func (m *MsgUpdateGroupAccountBase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupMembersRequest struct {
+	Group      GroupID      `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
+	Pagination *PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *MsgUpdateGroupAccountBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryGroupMembersRequest) Reset()         { *m = QueryGroupMembersRequest{} }
+func (m *QueryGroupMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupMembersRequest) ProtoMessage()    {}
+func (*QueryGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{49}
+}
+func (m *QueryGroupMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryGroupMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupMembersRequest.Merge(m, src)
+}
+func (m *QueryGroupMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupMembersRequest proto.InternalMessageInfo
+
+func (m *QueryGroupMembersRequest) GetGroup() GroupID {
+	if m != nil {
+		return m.Group
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicyStd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupMembersRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicyStd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupMembersResponse struct {
+	Members []GroupMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicyStd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGroupMembersResponse) Reset()         { *m = QueryGroupMembersResponse{} }
+func (m *QueryGroupMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupMembersResponse) ProtoMessage()    {}
+func (*QueryGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{50}
+}
+func (m *QueryGroupMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupMembersResponse.Merge(m, src)
+}
+func (m *QueryGroupMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupMembersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateGroupAccountComment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupMembersResponse proto.InternalMessageInfo
+
+func (m *QueryGroupMembersResponse) GetMembers() []GroupMember {
+	if m != nil {
+		return m.Members
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateGroupAccountComment) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupsByAdminRequest struct {
+	Admin      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
+	Pagination *PageRequest                                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *MsgUpdateGroupAccountComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.GroupAccount) > 0 {
-		i -= len(m.GroupAccount)
-		copy(dAtA[i:], m.GroupAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryGroupsByAdminRequest) Reset()         { *m = QueryGroupsByAdminRequest{} }
+func (m *QueryGroupsByAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupsByAdminRequest) ProtoMessage()    {}
+func (*QueryGroupsByAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{51}
+}
+func (m *QueryGroupsByAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupsByAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupsByAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryGroupsByAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupsByAdminRequest.Merge(m, src)
+}
+func (m *QueryGroupsByAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupsByAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupsByAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupsByAdminRequest proto.InternalMessageInfo
+
+func (m *QueryGroupsByAdminRequest) GetAdmin() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Admin
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *StdDecisionPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupsByAdminRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupsByAdminResponse struct {
+	Groups []GroupMetadata `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
 }
 
-func (m *StdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGroupsByAdminResponse) Reset()         { *m = QueryGroupsByAdminResponse{} }
+func (m *QueryGroupsByAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupsByAdminResponse) ProtoMessage()    {}
+func (*QueryGroupsByAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{52}
 }
-
-func (m *StdDecisionPolicy_Threshold) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGroupsByAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *StdDecisionPolicy_Threshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Threshold != nil {
-		{
-			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *QueryGroupsByAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupsByAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *ThresholdDecisionPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupsByAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupsByAdminResponse.Merge(m, src)
+}
+func (m *QueryGroupsByAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupsByAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupsByAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupsByAdminResponse proto.InternalMessageInfo
+
+func (m *QueryGroupsByAdminResponse) GetGroups() []GroupMetadata {
+	if m != nil {
+		return m.Groups
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ThresholdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupsByMemberRequest struct {
+	Member     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=member,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"member,omitempty"`
+	Pagination *PageRequest                                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Timout.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGroupsByMemberRequest) Reset()         { *m = QueryGroupsByMemberRequest{} }
+func (m *QueryGroupsByMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupsByMemberRequest) ProtoMessage()    {}
+func (*QueryGroupsByMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{53}
+}
+func (m *QueryGroupsByMemberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupsByMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupsByMemberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Threshold.Size()
-		i -= size
-		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgProposeBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGroupsByMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupsByMemberRequest.Merge(m, src)
 }
-
-func (m *MsgProposeBase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGroupsByMemberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupsByMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupsByMemberRequest.DiscardUnknown(m)
 }
 
-func (m *MsgProposeBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proposers) > 0 {
-		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proposers[iNdEx])
-			copy(dAtA[i:], m.Proposers[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.GroupAccount) > 0 {
-		i -= len(m.GroupAccount)
-		copy(dAtA[i:], m.GroupAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGroupsByMemberRequest proto.InternalMessageInfo
+
+func (m *QueryGroupsByMemberRequest) GetMember() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Member
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupsByMemberRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGroupsByMemberResponse contains the memberships of the address with the group id and weight.
+type QueryGroupsByMemberResponse struct {
+	Memberships []GroupMember `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships"`
 }
 
-func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Choice != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Choice))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Voters[iNdEx])
-			copy(dAtA[i:], m.Voters[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Voters[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *QueryGroupsByMemberResponse) Reset()         { *m = QueryGroupsByMemberResponse{} }
+func (m *QueryGroupsByMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupsByMemberResponse) ProtoMessage()    {}
+func (*QueryGroupsByMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{54}
+}
+func (m *QueryGroupsByMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupsByMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupsByMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Proposal != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupsByMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupsByMemberResponse.Merge(m, src)
+}
+func (m *QueryGroupsByMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupsByMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupsByMemberResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupsByMemberResponse proto.InternalMessageInfo
+
+func (m *QueryGroupsByMemberResponse) GetMemberships() []GroupMember {
+	if m != nil {
+		return m.Memberships
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupAccountRequest struct {
+	GroupAccount github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"group_account,omitempty"`
 }
 
-func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Proposal != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGroupAccountRequest) Reset()         { *m = QueryGroupAccountRequest{} }
+func (m *QueryGroupAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountRequest) ProtoMessage()    {}
+func (*QueryGroupAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{55}
+}
+func (m *QueryGroupAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountRequest.Merge(m, src)
+}
+func (m *QueryGroupAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountRequest.DiscardUnknown(m)
 }
 
-func (m *GroupMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupAccountRequest proto.InternalMessageInfo
+
+func (m *QueryGroupAccountRequest) GetGroupAccount() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.GroupAccount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GroupMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupAccountResponse struct {
+	GroupAccount StdGroupAccountMetadata `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3" json:"group_account"`
 }
 
-func (m *GroupMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalWeight.Size()
-		i -= size
-		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryGroupAccountResponse) Reset()         { *m = QueryGroupAccountResponse{} }
+func (m *QueryGroupAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountResponse) ProtoMessage()    {}
+func (*QueryGroupAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{56}
+}
+func (m *QueryGroupAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountResponse.Merge(m, src)
+}
+func (m *QueryGroupAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountResponse.DiscardUnknown(m)
 }
 
-func (m *GroupMember) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupAccountResponse proto.InternalMessageInfo
+
+func (m *QueryGroupAccountResponse) GetGroupAccount() StdGroupAccountMetadata {
+	if m != nil {
+		return m.GroupAccount
 	}
-	return dAtA[:n], nil
+	return StdGroupAccountMetadata{}
 }
 
-func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupAccountsByGroupRequest struct {
+	Group      GroupID      `protobuf:"varint,1,opt,name=group,proto3,casttype=GroupID" json:"group,omitempty"`
+	Pagination *PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryGroupAccountsByGroupRequest) Reset()         { *m = QueryGroupAccountsByGroupRequest{} }
+func (m *QueryGroupAccountsByGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountsByGroupRequest) ProtoMessage()    {}
+func (*QueryGroupAccountsByGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{57}
+}
+func (m *QueryGroupAccountsByGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountsByGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountsByGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Member) > 0 {
-		i -= len(m.Member)
-		copy(dAtA[i:], m.Member)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryGroupAccountsByGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountsByGroupRequest.Merge(m, src)
+}
+func (m *QueryGroupAccountsByGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountsByGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountsByGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAccountsByGroupRequest proto.InternalMessageInfo
+
+func (m *QueryGroupAccountsByGroupRequest) GetGroup() GroupID {
+	if m != nil {
+		return m.Group
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GroupAccountMetadataBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupAccountsByGroupRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GroupAccountMetadataBase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupAccountsByGroupResponse struct {
+	GroupAccounts []StdGroupAccountMetadata `protobuf:"bytes,1,rep,name=group_accounts,json=groupAccounts,proto3" json:"group_accounts"`
 }
 
-func (m *GroupAccountMetadataBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Group != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.GroupAccount) > 0 {
-		i -= len(m.GroupAccount)
-		copy(dAtA[i:], m.GroupAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGroupAccountsByGroupResponse) Reset()         { *m = QueryGroupAccountsByGroupResponse{} }
+func (m *QueryGroupAccountsByGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountsByGroupResponse) ProtoMessage()    {}
+func (*QueryGroupAccountsByGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{58}
+}
+func (m *QueryGroupAccountsByGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountsByGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountsByGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGroupAccountsByGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountsByGroupResponse.Merge(m, src)
+}
+func (m *QueryGroupAccountsByGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountsByGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountsByGroupResponse.DiscardUnknown(m)
 }
 
-func (m *StdGroupAccountMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGroupAccountsByGroupResponse proto.InternalMessageInfo
+
+func (m *QueryGroupAccountsByGroupResponse) GetGroupAccounts() []StdGroupAccountMetadata {
+	if m != nil {
+		return m.GroupAccounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StdGroupAccountMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupAccountsByAdminRequest struct {
+	Admin      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=admin,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"admin,omitempty"`
+	Pagination *PageRequest                                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *StdGroupAccountMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGroupAccountsByAdminRequest) Reset()         { *m = QueryGroupAccountsByAdminRequest{} }
+func (m *QueryGroupAccountsByAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountsByAdminRequest) ProtoMessage()    {}
+func (*QueryGroupAccountsByAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{59}
+}
+func (m *QueryGroupAccountsByAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountsByAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountsByAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+}
+func (m *QueryGroupAccountsByAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountsByAdminRequest.Merge(m, src)
+}
+func (m *QueryGroupAccountsByAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountsByAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountsByAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAccountsByAdminRequest proto.InternalMessageInfo
+
+func (m *QueryGroupAccountsByAdminRequest) GetAdmin() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Admin
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProposalBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGroupAccountsByAdminRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProposalBase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryGroupAccountsByAdminResponse struct {
+	GroupAccounts []StdGroupAccountMetadata `protobuf:"bytes,1,rep,name=group_accounts,json=groupAccounts,proto3" json:"group_accounts"`
 }
 
-func (m *ProposalBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecutorResult != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorResult))
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.VoteState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGroupAccountsByAdminResponse) Reset()         { *m = QueryGroupAccountsByAdminResponse{} }
+func (m *QueryGroupAccountsByAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupAccountsByAdminResponse) ProtoMessage()    {}
+func (*QueryGroupAccountsByAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{60}
+}
+func (m *QueryGroupAccountsByAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGroupAccountsByAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGroupAccountsByAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.Result != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.GroupAccountVersion != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupAccountVersion))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if m.GroupVersion != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *QueryGroupAccountsByAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupAccountsByAdminResponse.Merge(m, src)
+}
+func (m *QueryGroupAccountsByAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGroupAccountsByAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupAccountsByAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupAccountsByAdminResponse proto.InternalMessageInfo
+
+func (m *QueryGroupAccountsByAdminResponse) GetGroupAccounts() []StdGroupAccountMetadata {
+	if m != nil {
+		return m.GroupAccounts
 	}
-	{
-		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+type QueryProposalRequest struct {
+	Proposal ProposalID `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+}
+
+func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
+func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequest) ProtoMessage()    {}
+func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{61}
+}
+func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Proposers) > 0 {
-		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Proposers[iNdEx])
-			copy(dAtA[i:], m.Proposers[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GroupAccount) > 0 {
-		i -= len(m.GroupAccount)
-		copy(dAtA[i:], m.GroupAccount)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRequest.Merge(m, src)
+}
+func (m *QueryProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRequest.DiscardUnknown(m)
 }
 
-func (m *Tally) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
+
+func (m *QueryProposalRequest) GetProposal() ProposalID {
+	if m != nil {
+		return m.Proposal
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Tally) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposalResponse contains the app specific proposal type.
+type QueryProposalResponse struct {
+	Proposal *types.Any `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
 }
 
-func (m *Tally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.VetoCount.Size()
-		i -= size
-		if _, err := m.VetoCount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.AbstainCount.Size()
-		i -= size
-		if _, err := m.AbstainCount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.NoCount.Size()
-		i -= size
-		if _, err := m.NoCount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.YesCount.Size()
-		i -= size
-		if _, err := m.YesCount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
+func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResponse) ProtoMessage()    {}
+func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{62}
+}
+func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResponse.Merge(m, src)
+}
+func (m *QueryProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResponse.DiscardUnknown(m)
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
+
+func (m *QueryProposalResponse) GetProposal() *types.Any {
+	if m != nil {
+		return m.Proposal
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProposalExecutionRequest struct {
+	Proposal ProposalID `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProposalExecutionRequest) Reset()         { *m = QueryProposalExecutionRequest{} }
+func (m *QueryProposalExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionRequest) ProtoMessage()    {}
+func (*QueryProposalExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{63}
+}
+func (m *QueryProposalExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Comment) > 0 {
-		i -= len(m.Comment)
-		copy(dAtA[i:], m.Comment)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Choice != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Choice))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Proposal != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionRequest.Merge(m, src)
+}
+func (m *QueryProposalExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalExecutionRequest proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionRequest) GetProposal() ProposalID {
+	if m != nil {
+		return m.Proposal
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProposalExecutionResponse struct {
+	Execution ProposalExecution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxCommentLength != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCommentLength))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryProposalExecutionResponse) Reset()         { *m = QueryProposalExecutionResponse{} }
+func (m *QueryProposalExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalExecutionResponse) ProtoMessage()    {}
+func (*QueryProposalExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{64}
+}
+func (m *QueryProposalExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalExecutionResponse.Merge(m, src)
+}
+func (m *QueryProposalExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalExecutionResponse.DiscardUnknown(m)
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalExecutionResponse proto.InternalMessageInfo
+
+func (m *QueryProposalExecutionResponse) GetExecution() ProposalExecution {
+	if m != nil {
+		return m.Execution
 	}
-	return dAtA[:n], nil
+	return ProposalExecution{}
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProposalsByGroupAccountRequest struct {
+	GroupAccount github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=group_account,json=groupAccount,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"group_account,omitempty"`
+	Pagination   *PageRequest                                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProposalsByGroupAccountRequest) Reset()         { *m = QueryProposalsByGroupAccountRequest{} }
+func (m *QueryProposalsByGroupAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByGroupAccountRequest) ProtoMessage()    {}
+func (*QueryProposalsByGroupAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{65}
+}
+func (m *QueryProposalsByGroupAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByGroupAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByGroupAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalsByGroupAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByGroupAccountRequest.Merge(m, src)
+}
+func (m *QueryProposalsByGroupAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByGroupAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByGroupAccountRequest.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryProposalsByGroupAccountRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByGroupAccountRequest) GetGroupAccount() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.GroupAccount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Msg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+
+func (m *QueryProposalsByGroupAccountRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *Msg_CreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CreateGroup != nil {
-		l = m.CreateGroup.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+// QueryProposalsByGroupAccountResponse contains the app specific proposal types.
+type QueryProposalsByGroupAccountResponse struct {
+	Proposals []*types.Any `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 }
-func (m *Msg_UpdateGroupMembers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateGroupMembers != nil {
-		l = m.UpdateGroupMembers.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+
+func (m *QueryProposalsByGroupAccountResponse) Reset()         { *m = QueryProposalsByGroupAccountResponse{} }
+func (m *QueryProposalsByGroupAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByGroupAccountResponse) ProtoMessage()    {}
+func (*QueryProposalsByGroupAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{66}
 }
-func (m *Msg_UpdateGroupAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateGroupAdmin != nil {
-		l = m.UpdateGroupAdmin.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *QueryProposalsByGroupAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *Msg_UpdateGroupComment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateGroupComment != nil {
-		l = m.UpdateGroupComment.Size()
-		n += 1 + l + sovTypes(uint64(l))
+func (m *QueryProposalsByGroupAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByGroupAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-func (m *Msg_CreateGroupAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CreateGroupAccount != nil {
-		l = m.CreateGroupAccount.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *QueryProposalsByGroupAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByGroupAccountResponse.Merge(m, src)
 }
-func (m *Msg_UpdateGroupAccountAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateGroupAccountAdmin != nil {
-		l = m.UpdateGroupAccountAdmin.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *QueryProposalsByGroupAccountResponse) XXX_Size() int {
+	return m.Size()
 }
-func (m *Msg_UpdateGroupAccountDecisionPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateGroupAccountDecisionPolicy != nil {
-		l = m.UpdateGroupAccountDecisionPolicy.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+func (m *QueryProposalsByGroupAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByGroupAccountResponse.DiscardUnknown(m)
 }
-func (m *Msg_UpdateGroupAccountComment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateGroupAccountComment != nil {
-		l = m.UpdateGroupAccountComment.Size()
-		n += 1 + l + sovTypes(uint64(l))
+
+var xxx_messageInfo_QueryProposalsByGroupAccountResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByGroupAccountResponse) GetProposals() []*types.Any {
+	if m != nil {
+		return m.Proposals
 	}
-	return n
+	return nil
 }
-func (m *Msg_Vote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Vote != nil {
-		l = m.Vote.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+
+type QueryProposalsByProposerRequest struct {
+	Proposer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	Pagination *PageRequest                                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *Msg_Exec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Exec != nil {
-		l = m.Exec.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+
+func (m *QueryProposalsByProposerRequest) Reset()         { *m = QueryProposalsByProposerRequest{} }
+func (m *QueryProposalsByProposerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByProposerRequest) ProtoMessage()    {}
+func (*QueryProposalsByProposerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{67}
 }
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+func (m *QueryProposalsByProposerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByProposerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByProposerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+}
+func (m *QueryProposalsByProposerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByProposerRequest.Merge(m, src)
+}
+func (m *QueryProposalsByProposerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByProposerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByProposerRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateGroupMembers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_QueryProposalsByProposerRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsByProposerRequest) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
+	return nil
+}
+
+func (m *QueryProposalsByProposerRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.MemberUpdates) > 0 {
-		for _, e := range m.MemberUpdates {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+// QueryProposalsByProposerResponse contains the app specific proposal types.
+type QueryProposalsByProposerResponse struct {
+	Proposals []*types.Any `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+}
+
+func (m *QueryProposalsByProposerResponse) Reset()         { *m = QueryProposalsByProposerResponse{} }
+func (m *QueryProposalsByProposerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsByProposerResponse) ProtoMessage()    {}
+func (*QueryProposalsByProposerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{68}
+}
+func (m *QueryProposalsByProposerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsByProposerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsByProposerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryProposalsByProposerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsByProposerResponse.Merge(m, src)
+}
+func (m *QueryProposalsByProposerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsByProposerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsByProposerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateGroupAdmin) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryProposalsByProposerResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsByProposerResponse) GetProposals() []*types.Any {
+	if m != nil {
+		return m.Proposals
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
-	}
-	l = len(m.NewAdmin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+	return nil
 }
 
-func (m *MsgUpdateGroupComment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
-	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+type QueryVoteByProposalVoterRequest struct {
+	Proposal ProposalID                                    `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+	Voter    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 }
 
-func (m *Member) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.Power.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (m *QueryVoteByProposalVoterRequest) Reset()         { *m = QueryVoteByProposalVoterRequest{} }
+func (m *QueryVoteByProposalVoterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteByProposalVoterRequest) ProtoMessage()    {}
+func (*QueryVoteByProposalVoterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{69}
+}
+func (m *QueryVoteByProposalVoterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteByProposalVoterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteByProposalVoterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryVoteByProposalVoterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteByProposalVoterRequest.Merge(m, src)
+}
+func (m *QueryVoteByProposalVoterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteByProposalVoterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteByProposalVoterRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCreateGroupAccountBase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
-	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_QueryVoteByProposalVoterRequest proto.InternalMessageInfo
+
+func (m *QueryVoteByProposalVoterRequest) GetProposal() ProposalID {
+	if m != nil {
+		return m.Proposal
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCreateGroupAccountStd) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVoteByProposalVoterRequest) GetVoter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Voter
 	}
-	var l int
-	_ = l
-	l = m.Base.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.DecisionPolicy.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgUpdateGroupAccountAdmin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.GroupAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.NewAdmin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+type QueryVoteByProposalVoterResponse struct {
+	Vote Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote"`
 }
 
-func (m *MsgUpdateGroupAccountBase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
+func (m *QueryVoteByProposalVoterResponse) Reset()         { *m = QueryVoteByProposalVoterResponse{} }
+func (m *QueryVoteByProposalVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteByProposalVoterResponse) ProtoMessage()    {}
+func (*QueryVoteByProposalVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{70}
+}
+func (m *QueryVoteByProposalVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteByProposalVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteByProposalVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryVoteByProposalVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteByProposalVoterResponse.Merge(m, src)
+}
+func (m *QueryVoteByProposalVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteByProposalVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteByProposalVoterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateGroupAccountDecisionPolicyStd) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryVoteByProposalVoterResponse proto.InternalMessageInfo
+
+func (m *QueryVoteByProposalVoterResponse) GetVote() Vote {
+	if m != nil {
+		return m.Vote
 	}
-	var l int
-	_ = l
-	l = m.Base.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.DecisionPolicy.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return Vote{}
 }
 
-func (m *MsgUpdateGroupAccountComment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.GroupAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+type QueryVotesByProposalRequest struct {
+	Proposal   ProposalID   `protobuf:"varint,1,opt,name=proposal,proto3,casttype=ProposalID" json:"proposal,omitempty"`
+	Pagination *PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *StdDecisionPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+func (m *QueryVotesByProposalRequest) Reset()         { *m = QueryVotesByProposalRequest{} }
+func (m *QueryVotesByProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesByProposalRequest) ProtoMessage()    {}
+func (*QueryVotesByProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{71}
+}
+func (m *QueryVotesByProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesByProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesByProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryVotesByProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesByProposalRequest.Merge(m, src)
+}
+func (m *QueryVotesByProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesByProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesByProposalRequest.DiscardUnknown(m)
 }
 
-func (m *StdDecisionPolicy_Threshold) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Threshold != nil {
-		l = m.Threshold.Size()
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_QueryVotesByProposalRequest proto.InternalMessageInfo
+
+func (m *QueryVotesByProposalRequest) GetProposal() ProposalID {
+	if m != nil {
+		return m.Proposal
 	}
-	return n
+	return 0
 }
-func (m *ThresholdDecisionPolicy) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryVotesByProposalRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Threshold.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.Timout.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgProposeBase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Proposers) > 0 {
-		for _, b := range m.Proposers {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+type QueryVotesByProposalResponse struct {
+	Votes []Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *QueryVotesByProposalResponse) Reset()         { *m = QueryVotesByProposalResponse{} }
+func (m *QueryVotesByProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesByProposalResponse) ProtoMessage()    {}
+func (*QueryVotesByProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{72}
+}
+func (m *QueryVotesByProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesByProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesByProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+}
+func (m *QueryVotesByProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesByProposalResponse.Merge(m, src)
+}
+func (m *QueryVotesByProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesByProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesByProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Proposal != 0 {
-		n += 1 + sovTypes(uint64(m.Proposal))
+var xxx_messageInfo_QueryVotesByProposalResponse proto.InternalMessageInfo
+
+func (m *QueryVotesByProposalResponse) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
 	}
-	if len(m.Voters) > 0 {
-		for _, b := range m.Voters {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+type QueryVotesByVoterRequest struct {
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Pagination *PageRequest                                  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotesByVoterRequest) Reset()         { *m = QueryVotesByVoterRequest{} }
+func (m *QueryVotesByVoterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesByVoterRequest) ProtoMessage()    {}
+func (*QueryVotesByVoterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{73}
+}
+func (m *QueryVotesByVoterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesByVoterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesByVoterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Choice != 0 {
-		n += 1 + sovTypes(uint64(m.Choice))
-	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *QueryVotesByVoterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesByVoterRequest.Merge(m, src)
+}
+func (m *QueryVotesByVoterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesByVoterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesByVoterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotesByVoterRequest proto.InternalMessageInfo
+
+func (m *QueryVotesByVoterRequest) GetVoter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Voter
 	}
-	return n
+	return nil
 }
 
-func (m *MsgExec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVotesByVoterRequest) GetPagination() *PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.Proposal != 0 {
-		n += 1 + sovTypes(uint64(m.Proposal))
+	return nil
+}
+
+type QueryVotesByVoterResponse struct {
+	Votes []Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *QueryVotesByVoterResponse) Reset()         { *m = QueryVotesByVoterResponse{} }
+func (m *QueryVotesByVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesByVoterResponse) ProtoMessage()    {}
+func (*QueryVotesByVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{74}
+}
+func (m *QueryVotesByVoterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotesByVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotesByVoterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *QueryVotesByVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotesByVoterResponse.Merge(m, src)
+}
+func (m *QueryVotesByVoterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotesByVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotesByVoterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotesByVoterResponse proto.InternalMessageInfo
+
+func (m *QueryVotesByVoterResponse) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
 	}
-	return n
+	return nil
 }
 
-func (m *GroupMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.Choice", Choice_name, Choice_value)
+	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.ProposalBase_Status", ProposalBase_Status_name, ProposalBase_Status_value)
+	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.ProposalBase_Result", ProposalBase_Result_name, ProposalBase_Result_value)
+	proto.RegisterEnum("cosmos_modules.incubator.group.v1_alpha.ProposalBase_ExecutorResult", ProposalBase_ExecutorResult_name, ProposalBase_ExecutorResult_value)
+	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroup")
+	proto.RegisterType((*MsgCreateGroupResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroupResponse")
+	proto.RegisterType((*MsgUpdateGroupMembers)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupMembers")
+	proto.RegisterType((*MsgUpdateGroupMembersResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupMembersResponse")
+	proto.RegisterType((*MsgUpdateGroupAdmin)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAdmin")
+	proto.RegisterType((*MsgUpdateGroupAdminResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAdminResponse")
+	proto.RegisterType((*MsgUpdateGroupComment)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupComment")
+	proto.RegisterType((*MsgUpdateGroupCommentResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupCommentResponse")
+	proto.RegisterType((*Member)(nil), "cosmos_modules.incubator.group.v1_alpha.Member")
+	proto.RegisterType((*MsgCreateGroupAccountBase)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroupAccountBase")
+	proto.RegisterType((*MsgCreateGroupAccountStd)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroupAccountStd")
+	proto.RegisterType((*MsgCreateGroupAccountResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgCreateGroupAccountResponse")
+	proto.RegisterType((*MsgUpdateGroupAccountAdmin)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountAdmin")
+	proto.RegisterType((*MsgUpdateGroupAccountAdminResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountAdminResponse")
+	proto.RegisterType((*MsgUpdateGroupAccountBase)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountBase")
+	proto.RegisterType((*MsgUpdateGroupAccountDecisionPolicyStd)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountDecisionPolicyStd")
+	proto.RegisterType((*MsgUpdateGroupAccountDecisionPolicyResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountDecisionPolicyResponse")
+	proto.RegisterType((*MsgUpdateGroupAccountComment)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountComment")
+	proto.RegisterType((*MsgUpdateGroupAccountCommentResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgUpdateGroupAccountCommentResponse")
+	proto.RegisterType((*StdDecisionPolicy)(nil), "cosmos_modules.incubator.group.v1_alpha.StdDecisionPolicy")
+	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos_modules.incubator.group.v1_alpha.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos_modules.incubator.group.v1_alpha.PercentageDecisionPolicy")
+	proto.RegisterType((*QuorumDecisionPolicy)(nil), "cosmos_modules.incubator.group.v1_alpha.QuorumDecisionPolicy")
+	proto.RegisterType((*MsgProposeBase)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgProposeBase")
+	proto.RegisterType((*MsgVote)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgVote")
+	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgVoteResponse")
+	proto.RegisterType((*MsgExec)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgExec")
+	proto.RegisterType((*MsgExecResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgExecResponse")
+	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgWithdrawProposal")
+	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgWithdrawProposalResponse")
+	proto.RegisterType((*GroupMetadata)(nil), "cosmos_modules.incubator.group.v1_alpha.GroupMetadata")
+	proto.RegisterType((*GroupMember)(nil), "cosmos_modules.incubator.group.v1_alpha.GroupMember")
+	proto.RegisterType((*GroupAccountMetadataBase)(nil), "cosmos_modules.incubator.group.v1_alpha.GroupAccountMetadataBase")
+	proto.RegisterType((*MsgType)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgType")
+	proto.RegisterType((*StdGroupAccountMetadata)(nil), "cosmos_modules.incubator.group.v1_alpha.StdGroupAccountMetadata")
+	proto.RegisterType((*ProposalBase)(nil), "cosmos_modules.incubator.group.v1_alpha.ProposalBase")
+	proto.RegisterType((*Tally)(nil), "cosmos_modules.incubator.group.v1_alpha.Tally")
+	proto.RegisterType((*ElectorateSnapshot)(nil), "cosmos_modules.incubator.group.v1_alpha.ElectorateSnapshot")
+	proto.RegisterType((*ElectorateSnapshotMember)(nil), "cosmos_modules.incubator.group.v1_alpha.ElectorateSnapshotMember")
+	proto.RegisterType((*ProposalExecution)(nil), "cosmos_modules.incubator.group.v1_alpha.ProposalExecution")
+	proto.RegisterType((*MsgExecutionResult)(nil), "cosmos_modules.incubator.group.v1_alpha.MsgExecutionResult")
+	proto.RegisterType((*ExecutionEvent)(nil), "cosmos_modules.incubator.group.v1_alpha.ExecutionEvent")
+	proto.RegisterType((*ExecutionEventAttribute)(nil), "cosmos_modules.incubator.group.v1_alpha.ExecutionEventAttribute")
+	proto.RegisterType((*Vote)(nil), "cosmos_modules.incubator.group.v1_alpha.Vote")
+	proto.RegisterType((*Params)(nil), "cosmos_modules.incubator.group.v1_alpha.Params")
+	proto.RegisterType((*GenesisState)(nil), "cosmos_modules.incubator.group.v1_alpha.GenesisState")
+	proto.RegisterType((*PageRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.PageRequest")
+	proto.RegisterType((*QueryGroupRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupRequest")
+	proto.RegisterType((*QueryGroupResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupResponse")
+	proto.RegisterType((*QueryGroupMembersRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupMembersRequest")
+	proto.RegisterType((*QueryGroupMembersResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupMembersResponse")
+	proto.RegisterType((*QueryGroupsByAdminRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupsByAdminRequest")
+	proto.RegisterType((*QueryGroupsByAdminResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupsByAdminResponse")
+	proto.RegisterType((*QueryGroupsByMemberRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupsByMemberRequest")
+	proto.RegisterType((*QueryGroupsByMemberResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupsByMemberResponse")
+	proto.RegisterType((*QueryGroupAccountRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupAccountRequest")
+	proto.RegisterType((*QueryGroupAccountResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupAccountResponse")
+	proto.RegisterType((*QueryGroupAccountsByGroupRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupAccountsByGroupRequest")
+	proto.RegisterType((*QueryGroupAccountsByGroupResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupAccountsByGroupResponse")
+	proto.RegisterType((*QueryGroupAccountsByAdminRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupAccountsByAdminRequest")
+	proto.RegisterType((*QueryGroupAccountsByAdminResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryGroupAccountsByAdminResponse")
+	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalRequest")
+	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalResponse")
+	proto.RegisterType((*QueryProposalExecutionRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalExecutionRequest")
+	proto.RegisterType((*QueryProposalExecutionResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalExecutionResponse")
+	proto.RegisterType((*QueryProposalsByGroupAccountRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalsByGroupAccountRequest")
+	proto.RegisterType((*QueryProposalsByGroupAccountResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalsByGroupAccountResponse")
+	proto.RegisterType((*QueryProposalsByProposerRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalsByProposerRequest")
+	proto.RegisterType((*QueryProposalsByProposerResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryProposalsByProposerResponse")
+	proto.RegisterType((*QueryVoteByProposalVoterRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryVoteByProposalVoterRequest")
+	proto.RegisterType((*QueryVoteByProposalVoterResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryVoteByProposalVoterResponse")
+	proto.RegisterType((*QueryVotesByProposalRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryVotesByProposalRequest")
+	proto.RegisterType((*QueryVotesByProposalResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryVotesByProposalResponse")
+	proto.RegisterType((*QueryVotesByVoterRequest)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryVotesByVoterRequest")
+	proto.RegisterType((*QueryVotesByVoterResponse)(nil), "cosmos_modules.incubator.group.v1_alpha.QueryVotesByVoterResponse")
+}
+
+func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
+
+var fileDescriptor_d938547f84707355 = []byte{
+	// 3915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5b, 0x6c, 0x1b, 0x57,
+	0x7a, 0xd6, 0xf0, 0x26, 0xe9, 0xa7, 0x2e, 0xd4, 0xb1, 0x12, 0x51, 0x63, 0x5b, 0x64, 0x26, 0x71,
+	0xea, 0x66, 0x61, 0x29, 0xcb, 0x0d, 0x92, 0x40, 0xd9, 0xec, 0x86, 0xa4, 0x28, 0x85, 0xb1, 0x75,
+	0xf1, 0x90, 0xb2, 0x77, 0xd3, 0xa0, 0xcc, 0x88, 0x3c, 0xa6, 0x66, 0x4d, 0xce, 0xd0, 0x73, 0x86,
+	0x96, 0x85, 0xbe, 0xe4, 0xad, 0xa9, 0xd1, 0xa2, 0x37, 0x74, 0x11, 0x60, 0xeb, 0xdd, 0x00, 0xbd,
+	0x3c, 0xf4, 0xf2, 0x50, 0xa0, 0xdb, 0x76, 0x8b, 0xee, 0x43, 0xd1, 0xa2, 0xd8, 0x1a, 0x2e, 0xba,
+	0xed, 0xa2, 0x40, 0xdb, 0x07, 0xb5, 0x9b, 0xbc, 0xb4, 0x7d, 0x4c, 0x81, 0x16, 0xf0, 0x4b, 0x8b,
+	0x39, 0xe7, 0xcc, 0x70, 0x86, 0x1c, 0x2a, 0x1c, 0x92, 0x72, 0xb2, 0x6f, 0x73, 0x39, 0xff, 0xf7,
+	0x5f, 0xce, 0x7f, 0xfe, 0xf3, 0xff, 0xff, 0x9c, 0x81, 0xb8, 0x79, 0xdc, 0xc2, 0x64, 0xb5, 0x65,
+	0xe8, 0xa6, 0x8e, 0x7e, 0xaa, 0xaa, 0x93, 0xa6, 0x4e, 0x2a, 0x4d, 0xbd, 0xd6, 0x6e, 0x60, 0xb2,
+	0xaa, 0x6a, 0xd5, 0xf6, 0x81, 0x62, 0xea, 0xc6, 0x6a, 0xdd, 0xd0, 0xdb, 0xad, 0xd5, 0xbb, 0x5f,
+	0xac, 0x28, 0x8d, 0xd6, 0xa1, 0x22, 0x2e, 0xd6, 0xf5, 0xba, 0x4e, 0x69, 0xd6, 0xac, 0x2b, 0x46,
+	0x2e, 0x2e, 0xd7, 0x75, 0xbd, 0xde, 0xc0, 0x6b, 0xf4, 0xee, 0xa0, 0x7d, 0x6b, 0x4d, 0xd1, 0x8e,
+	0xf9, 0xab, 0x95, 0xee, 0x57, 0xb5, 0xb6, 0xa1, 0x98, 0xaa, 0xae, 0xf1, 0xf7, 0xa9, 0xee, 0xf7,
+	0xa6, 0xda, 0xc4, 0xc4, 0x54, 0x9a, 0x2d, 0x3e, 0xe0, 0x0b, 0xe6, 0xa1, 0x6a, 0xd4, 0x2a, 0x2d,
+	0xc5, 0x30, 0x8f, 0xd9, 0xa8, 0x35, 0x26, 0xec, 0x15, 0xf7, 0x0d, 0x1f, 0x3c, 0xab, 0x1b, 0xcd,
+	0x35, 0xdd, 0x68, 0xb2, 0x5b, 0xe9, 0xaf, 0x05, 0x98, 0xdb, 0x26, 0xf5, 0xbc, 0x81, 0x15, 0x13,
+	0x6f, 0x59, 0x9a, 0xa0, 0x2d, 0x88, 0x2a, 0xb5, 0xa6, 0xaa, 0x25, 0x85, 0xb4, 0x70, 0x79, 0x26,
+	0xf7, 0xc5, 0xc7, 0x27, 0xa9, 0x2b, 0x75, 0xd5, 0x3c, 0x6c, 0x1f, 0xac, 0x56, 0xf5, 0x26, 0x47,
+	0xb3, 0x39, 0x90, 0xda, 0xed, 0x35, 0x66, 0xa6, 0x6c, 0xb5, 0x9a, 0xad, 0xd5, 0x0c, 0x4c, 0x88,
+	0xcc, 0xe8, 0xd1, 0x2e, 0x4c, 0x36, 0x71, 0xf3, 0x00, 0x1b, 0x24, 0x19, 0x4a, 0x87, 0x2f, 0xc7,
+	0x33, 0x6b, 0xab, 0x03, 0x1a, 0x71, 0x75, 0x9b, 0xd2, 0xe5, 0x22, 0x3f, 0x38, 0x49, 0x4d, 0xc8,
+	0x36, 0x0a, 0x4a, 0xc2, 0x64, 0x55, 0x6f, 0x36, 0xb1, 0x66, 0x26, 0xc3, 0x69, 0xe1, 0xf2, 0xb4,
+	0x6c, 0xdf, 0x4a, 0xaf, 0xc1, 0xd3, 0x5e, 0x2d, 0x64, 0x4c, 0x5a, 0xba, 0x46, 0x30, 0x7a, 0x06,
+	0xa2, 0x14, 0x9b, 0x6a, 0x13, 0xc9, 0xc5, 0x1f, 0x9f, 0xa4, 0x26, 0xe9, 0x88, 0xe2, 0x86, 0xcc,
+	0xde, 0x48, 0x3f, 0x16, 0xe0, 0xa9, 0x6d, 0x52, 0xdf, 0x6f, 0xd5, 0x6c, 0xea, 0x6d, 0xce, 0x70,
+	0x6c, 0xa6, 0x70, 0xa4, 0x08, 0xf5, 0x93, 0x02, 0xbd, 0x03, 0x73, 0x4c, 0xcf, 0x4a, 0x9b, 0x0a,
+	0x42, 0x92, 0xe1, 0x51, 0x8c, 0x36, 0xcb, 0xc0, 0x98, 0x52, 0x44, 0x4a, 0xc1, 0x45, 0x5f, 0x15,
+	0x6d, 0x3b, 0x49, 0xff, 0x20, 0xc0, 0x39, 0xef, 0x88, 0x2c, 0x95, 0xfc, 0x49, 0x9a, 0x60, 0x07,
+	0xa6, 0x35, 0x7c, 0x54, 0x61, 0xfc, 0xc2, 0xc3, 0xf2, 0x9b, 0xd2, 0xf0, 0x11, 0x95, 0x5d, 0xba,
+	0x08, 0xe7, 0x7d, 0x54, 0x72, 0x54, 0x7e, 0xd0, 0x33, 0xef, 0x79, 0xe6, 0x4e, 0x4f, 0x54, 0xe9,
+	0xfe, 0x4e, 0xdd, 0x33, 0x67, 0x5c, 0x3c, 0x47, 0x81, 0xef, 0x0a, 0x10, 0x63, 0xf3, 0x88, 0xae,
+	0xc2, 0xa4, 0xc2, 0x58, 0x0f, 0x2f, 0xb3, 0x8d, 0x80, 0x36, 0x20, 0xda, 0xd2, 0x8f, 0xb0, 0x41,
+	0xa5, 0x9e, 0xce, 0xad, 0x5a, 0x0e, 0xf5, 0xaf, 0x27, 0xa9, 0xe7, 0x07, 0x80, 0xdb, 0xc0, 0x55,
+	0x99, 0x11, 0x9f, 0xa2, 0xd8, 0x77, 0xc3, 0xb0, 0xec, 0x5d, 0xae, 0xd9, 0x6a, 0x55, 0x6f, 0x6b,
+	0x66, 0x4e, 0x21, 0xf8, 0xf3, 0x61, 0x7c, 0x74, 0x1e, 0xa6, 0x95, 0xb6, 0xa9, 0x57, 0xf0, 0x3d,
+	0x5c, 0x4d, 0x46, 0xd2, 0xc2, 0xe5, 0x29, 0x79, 0xca, 0x7a, 0x50, 0xb8, 0x87, 0xab, 0x68, 0x0d,
+	0xce, 0x11, 0x4d, 0x69, 0x91, 0x43, 0xdd, 0xac, 0xe0, 0x06, 0xae, 0x9a, 0xba, 0xa1, 0x98, 0x38,
+	0x19, 0xa5, 0xc3, 0x90, 0xfd, 0xaa, 0xe0, 0xbc, 0x41, 0x07, 0xb0, 0xa0, 0x34, 0x1a, 0xfa, 0x11,
+	0xae, 0x55, 0x9a, 0xa4, 0x5e, 0xa1, 0x12, 0x27, 0x63, 0x74, 0x7d, 0xbf, 0x38, 0xf8, 0xfa, 0x26,
+	0xf5, 0xf2, 0x71, 0x0b, 0xf3, 0x05, 0x3e, 0xcf, 0x01, 0xf9, 0x53, 0x82, 0xde, 0x85, 0x44, 0x0d,
+	0x6b, 0xaa, 0x87, 0xc5, 0xe4, 0x48, 0x2c, 0xe6, 0x18, 0x9e, 0xcd, 0x41, 0xfa, 0x4f, 0x01, 0x92,
+	0xbe, 0xf3, 0x56, 0x32, 0x6b, 0xe8, 0x1d, 0x88, 0x1c, 0x28, 0x04, 0xd3, 0x59, 0x8b, 0x67, 0x72,
+	0x41, 0x58, 0xfa, 0x3b, 0x02, 0x17, 0x82, 0xa2, 0x22, 0x15, 0xe6, 0x6b, 0xb8, 0xaa, 0x12, 0x55,
+	0xd7, 0x2a, 0x2d, 0xbd, 0xa1, 0x56, 0x8f, 0xe9, 0xac, 0xc6, 0x33, 0xeb, 0x03, 0x33, 0x2a, 0x99,
+	0xb5, 0x0d, 0x0e, 0xb1, 0x47, 0x11, 0x3a, 0x5a, 0xba, 0x9f, 0xae, 0x47, 0xde, 0xff, 0x30, 0x35,
+	0x21, 0x1d, 0xc1, 0x45, 0x5f, 0xc9, 0x9c, 0x8d, 0xe5, 0x06, 0xcc, 0x52, 0x06, 0x15, 0x85, 0xbd,
+	0x18, 0xde, 0x5d, 0x67, 0xea, 0x2e, 0x7c, 0xe9, 0x97, 0x42, 0x20, 0x76, 0x45, 0x2d, 0xf6, 0x66,
+	0xcc, 0xf1, 0xb8, 0x47, 0xfe, 0xd0, 0x58, 0xe4, 0x1f, 0x7b, 0x10, 0x7f, 0x0e, 0xa4, 0xfe, 0xe6,
+	0x70, 0x42, 0xe1, 0x5f, 0x08, 0xb0, 0xec, 0x3b, 0x6c, 0xbc, 0x21, 0xe5, 0x8c, 0x8c, 0x26, 0xfd,
+	0xaf, 0x00, 0xcf, 0xfb, 0x8a, 0xef, 0xf5, 0xd8, 0x11, 0xd7, 0x99, 0xbf, 0x75, 0x3e, 0xdb, 0x75,
+	0x76, 0x05, 0xbe, 0x30, 0x80, 0xe2, 0xce, 0x3c, 0xff, 0x8b, 0x00, 0x17, 0x7c, 0xc7, 0x8f, 0x7d,
+	0xeb, 0x3e, 0xab, 0xf5, 0xd1, 0x7f, 0x5b, 0x7c, 0x1e, 0x9e, 0x3b, 0x4d, 0x35, 0xc7, 0x06, 0x7f,
+	0x1f, 0x82, 0x85, 0x1e, 0x23, 0xa3, 0x77, 0x61, 0xda, 0x3c, 0x34, 0x30, 0x39, 0xd4, 0x1b, 0x35,
+	0xee, 0x1c, 0x6f, 0x0c, 0x3c, 0x67, 0x65, 0x9b, 0xd2, 0x0b, 0xfa, 0xe6, 0x84, 0xdc, 0x01, 0x45,
+	0x55, 0x80, 0x16, 0x36, 0xaa, 0x58, 0x33, 0x95, 0x3a, 0xe6, 0x6e, 0x91, 0x1d, 0x98, 0xc5, 0x9e,
+	0x43, 0xda, 0xc3, 0xc3, 0x05, 0x8b, 0x6e, 0x42, 0xec, 0x4e, 0x5b, 0x37, 0xda, 0x4d, 0x6a, 0x9d,
+	0x78, 0xe6, 0xf5, 0x81, 0x19, 0x5c, 0xa7, 0x64, 0x3d, 0xe0, 0x1c, 0x6e, 0xfd, 0xdc, 0xc3, 0x3f,
+	0xbe, 0x32, 0xff, 0x42, 0x97, 0x67, 0x46, 0x21, 0x4c, 0xda, 0x4d, 0xe9, 0xbf, 0x05, 0x58, 0xea,
+	0x63, 0x02, 0x74, 0xad, 0xdb, 0xae, 0xc1, 0x13, 0x22, 0x97, 0x0d, 0x5f, 0x81, 0x98, 0xa9, 0x36,
+	0xf5, 0xb6, 0xc9, 0xed, 0xb7, 0xbc, 0xca, 0xaa, 0xbb, 0x55, 0xbb, 0xba, 0x5b, 0xdd, 0xe0, 0xd5,
+	0x1f, 0x5f, 0x35, 0x7c, 0x38, 0xba, 0x0e, 0x8b, 0x4d, 0x55, 0xa3, 0xe9, 0x48, 0xdb, 0xa4, 0xab,
+	0x13, 0x1b, 0xaa, 0x5e, 0x4b, 0x86, 0x07, 0x83, 0x41, 0x4d, 0x55, 0x2b, 0xd8, 0xb4, 0x7b, 0x94,
+	0x54, 0xfa, 0x1f, 0x01, 0x92, 0xfd, 0x66, 0x05, 0xed, 0x78, 0x26, 0x7b, 0x38, 0xbd, 0xdd, 0xf3,
+	0xfa, 0x79, 0x52, 0xfc, 0x97, 0xc3, 0xb0, 0xe8, 0xe7, 0x2d, 0x68, 0xd3, 0x71, 0xbe, 0xe1, 0x14,
+	0xe6, 0xd4, 0x5e, 0x9f, 0x09, 0x8d, 0xea, 0x33, 0xfb, 0x30, 0x77, 0x17, 0x9b, 0x7a, 0xa5, 0x03,
+	0x19, 0x1e, 0x0a, 0x72, 0xd6, 0x42, 0x29, 0xfb, 0xb8, 0x62, 0x64, 0x3c, 0x33, 0x12, 0x1d, 0x7e,
+	0x46, 0x7e, 0xc4, 0xda, 0x10, 0x7b, 0x86, 0xde, 0xd2, 0x09, 0xa6, 0x7b, 0xf6, 0x19, 0xe5, 0x57,
+	0x68, 0x17, 0xa6, 0x5b, 0x8c, 0x0d, 0xef, 0x4b, 0x0c, 0x85, 0xd9, 0xc1, 0x38, 0x25, 0xa0, 0x7f,
+	0x2c, 0xc0, 0xe4, 0x36, 0xa9, 0xdf, 0xd0, 0x4d, 0x8c, 0x5e, 0x80, 0x29, 0x46, 0xa2, 0x34, 0x78,
+	0x2b, 0x62, 0xee, 0xf1, 0x49, 0x0a, 0xf6, 0xf8, 0xb3, 0xe2, 0x86, 0xec, 0xbc, 0x47, 0x45, 0x88,
+	0xdd, 0xd5, 0xcd, 0x91, 0xe4, 0xe3, 0x00, 0x68, 0x0b, 0x62, 0xd5, 0x43, 0x5d, 0xad, 0x62, 0x2a,
+	0xdb, 0x5c, 0x80, 0x6e, 0x42, 0x9e, 0x92, 0xc9, 0x9c, 0xdc, 0xad, 0x65, 0xc4, 0xab, 0xe5, 0x02,
+	0xcc, 0x73, 0x25, 0x9d, 0x1d, 0xea, 0x3d, 0xa6, 0x38, 0xad, 0x95, 0x02, 0x2a, 0x4e, 0xd4, 0xba,
+	0xc6, 0x2b, 0xcf, 0xe1, 0x14, 0x67, 0x00, 0xd2, 0x6d, 0x98, 0xe7, 0x12, 0x38, 0x19, 0xfb, 0xd7,
+	0x60, 0xda, 0xf1, 0xd9, 0xa4, 0x10, 0x30, 0xab, 0xb1, 0xe5, 0x75, 0x3c, 0x57, 0xee, 0x80, 0x49,
+	0xbf, 0xc8, 0x9a, 0x27, 0x37, 0x55, 0xf3, 0xb0, 0x66, 0x28, 0x47, 0xf6, 0xd8, 0xcf, 0x4a, 0x77,
+	0xd6, 0xf7, 0xe8, 0x96, 0xc6, 0x99, 0x9d, 0x7f, 0x0a, 0xc1, 0x2c, 0xef, 0x01, 0x99, 0x4a, 0x4d,
+	0x31, 0x95, 0x01, 0x9a, 0x64, 0x9d, 0xbc, 0x2a, 0x34, 0x62, 0x5e, 0xd5, 0xbf, 0xe4, 0x4e, 0xc2,
+	0xe4, 0x5d, 0x6c, 0x58, 0xe1, 0x98, 0xba, 0x58, 0x44, 0xb6, 0x6f, 0xd1, 0x1e, 0xc4, 0x4d, 0xdd,
+	0x54, 0x1a, 0x37, 0xb1, 0x5a, 0x3f, 0x34, 0x93, 0xd1, 0xa1, 0xc2, 0x9f, 0x1b, 0x02, 0x5d, 0x01,
+	0xc4, 0xbb, 0x8a, 0x87, 0x6a, 0xab, 0x62, 0xb3, 0x8d, 0x51, 0xb6, 0x0b, 0x9d, 0x37, 0x37, 0xd8,
+	0x8b, 0xf5, 0x67, 0x1f, 0x3e, 0xba, 0x94, 0x4a, 0x0b, 0x97, 0x85, 0x0c, 0x82, 0xc9, 0xdc, 0x31,
+	0xad, 0x3d, 0x12, 0x21, 0x91, 0xa9, 0x04, 0x51, 0x6a, 0x2b, 0xe9, 0xdb, 0x21, 0x88, 0xbb, 0x7a,
+	0x6b, 0x83, 0x58, 0xb5, 0x08, 0x31, 0xc6, 0x6c, 0x84, 0x49, 0x67, 0x00, 0xd6, 0xde, 0x75, 0xc4,
+	0xcc, 0x33, 0xdc, 0xee, 0xc0, 0xa9, 0xfb, 0x2f, 0xf4, 0xf5, 0xaf, 0x3e, 0x7c, 0x74, 0xe9, 0xb5,
+	0x44, 0x42, 0x64, 0xb2, 0x8b, 0x9c, 0x31, 0xb3, 0x08, 0xd5, 0x2b, 0xb1, 0xc0, 0xdf, 0x65, 0x16,
+	0x13, 0xc8, 0x7e, 0x0f, 0x53, 0xb9, 0x63, 0x66, 0x10, 0xe9, 0x77, 0x23, 0x90, 0x74, 0x27, 0xb6,
+	0xb6, 0xff, 0x9d, 0x69, 0xbc, 0x1f, 0xa0, 0x0b, 0xe4, 0xf8, 0x76, 0x78, 0x7c, 0xbe, 0x1d, 0xe9,
+	0xeb, 0xdb, 0x51, 0xaf, 0x6f, 0x7b, 0x1a, 0x4d, 0xb1, 0xc1, 0x1a, 0x4d, 0x93, 0xc1, 0x1a, 0x4d,
+	0x53, 0x67, 0xdf, 0x68, 0x9a, 0x1e, 0x6b, 0xa3, 0xe9, 0x4b, 0x30, 0xc9, 0xaf, 0xd1, 0x22, 0x44,
+	0x0d, 0xbd, 0x6d, 0xf2, 0x14, 0x54, 0x66, 0x37, 0x08, 0x41, 0xc4, 0xe2, 0xcb, 0x72, 0x2b, 0x99,
+	0x5e, 0x4b, 0xdf, 0x0f, 0xc1, 0x52, 0xc9, 0xac, 0xf9, 0x39, 0x18, 0xfa, 0x19, 0x4f, 0xd1, 0x3c,
+	0x78, 0xd1, 0xd2, 0xcf, 0x5b, 0x3f, 0xab, 0x9a, 0xf9, 0xed, 0x87, 0x8f, 0x2e, 0xdd, 0x48, 0xa4,
+	0x45, 0x64, 0x31, 0x5e, 0xf5, 0xac, 0x97, 0xcc, 0x92, 0x08, 0x9d, 0xa7, 0x9d, 0x35, 0x29, 0x39,
+	0x2f, 0x58, 0xb0, 0x72, 0xc2, 0xd7, 0xb3, 0x30, 0xe3, 0x56, 0x4f, 0xfa, 0xbb, 0x59, 0x98, 0xb1,
+	0xf7, 0x8a, 0x33, 0x5d, 0x91, 0xae, 0x55, 0x12, 0xf2, 0xae, 0x12, 0x4f, 0x6e, 0x16, 0x1e, 0x43,
+	0x6e, 0x96, 0x87, 0x19, 0xd2, 0x3e, 0x68, 0xaa, 0xa6, 0x89, 0x6b, 0x15, 0xc5, 0xce, 0x74, 0xc5,
+	0x9e, 0x14, 0xb5, 0x6c, 0x7f, 0x52, 0xe3, 0x76, 0x8f, 0x3b, 0x54, 0x59, 0x13, 0x3d, 0x6b, 0xdb,
+	0xc1, 0xbb, 0x82, 0x99, 0x52, 0x7c, 0x87, 0x40, 0x19, 0x78, 0xca, 0x63, 0xac, 0xae, 0x3d, 0xe5,
+	0x9c, 0xdb, 0x02, 0x36, 0x4d, 0x19, 0x62, 0xc4, 0x54, 0xcc, 0x36, 0xa1, 0x0b, 0x7a, 0x2e, 0xf3,
+	0xe5, 0xc0, 0xd9, 0x88, 0x35, 0x4f, 0xab, 0x25, 0x8a, 0x21, 0x73, 0x2c, 0x0b, 0xd5, 0xc0, 0xa4,
+	0xdd, 0x30, 0x93, 0x53, 0xa3, 0xa0, 0xca, 0x14, 0x43, 0xe6, 0x58, 0xa8, 0x04, 0x60, 0xa5, 0x94,
+	0x15, 0x8b, 0x09, 0x4e, 0x4e, 0x53, 0x3b, 0xae, 0x0e, 0xde, 0x5f, 0x50, 0x1a, 0x0d, 0xdb, 0xa7,
+	0xa7, 0x2d, 0x1c, 0x4b, 0x66, 0x8c, 0xd6, 0x61, 0xd2, 0xfa, 0x98, 0x69, 0xd5, 0x20, 0x30, 0xe0,
+	0xcc, 0xd8, 0x04, 0xa8, 0x09, 0xf3, 0x2c, 0x01, 0xd3, 0x8d, 0x0a, 0xd7, 0x37, 0x4e, 0xf5, 0xdd,
+	0x18, 0x4e, 0xdf, 0x02, 0x07, 0xe3, 0x7a, 0xcf, 0x61, 0xcf, 0xbd, 0x37, 0x4c, 0xcf, 0x0c, 0x16,
+	0xa6, 0x67, 0xfb, 0x85, 0x69, 0xe9, 0x0f, 0x42, 0x10, 0x63, 0xd3, 0x86, 0x5e, 0x86, 0xa5, 0x3d,
+	0x79, 0x77, 0x6f, 0xb7, 0x94, 0xbd, 0x56, 0x29, 0x95, 0xb3, 0xe5, 0xfd, 0x52, 0xa5, 0xb8, 0x73,
+	0x23, 0x7b, 0xad, 0xb8, 0x91, 0x98, 0x10, 0x97, 0xef, 0x3f, 0x48, 0x3f, 0x65, 0x8b, 0xc9, 0x08,
+	0x8a, 0xda, 0x5d, 0xa5, 0xa1, 0xd6, 0xd0, 0x3a, 0x2c, 0x77, 0xd3, 0x95, 0xf6, 0x73, 0xdb, 0xc5,
+	0x72, 0xb9, 0xb0, 0x91, 0x10, 0xc4, 0xf3, 0xf7, 0x1f, 0xa4, 0x97, 0xbc, 0x94, 0x25, 0xdb, 0xa7,
+	0xd1, 0x4b, 0xf0, 0x74, 0x37, 0x6d, 0xfe, 0xda, 0x6e, 0xa9, 0xb0, 0x91, 0x08, 0x89, 0xc9, 0xfb,
+	0x0f, 0xd2, 0x8b, 0x5e, 0xc2, 0x7c, 0x43, 0x27, 0xb8, 0xe6, 0x27, 0x69, 0x36, 0xb7, 0x2b, 0x5b,
+	0xfc, 0xc2, 0x7e, 0x92, 0x66, 0x0f, 0x74, 0xc3, 0xc4, 0xbe, 0x92, 0xde, 0x2c, 0x96, 0xdf, 0xdc,
+	0x90, 0xb3, 0x37, 0x77, 0x12, 0x11, 0x3f, 0x49, 0xed, 0xd4, 0x55, 0x13, 0x23, 0xef, 0xff, 0xd6,
+	0xca, 0x84, 0xd5, 0xa9, 0x88, 0xf1, 0x79, 0x70, 0x0b, 0x21, 0x17, 0x4a, 0xfb, 0xd7, 0xca, 0xfd,
+	0xcc, 0xc5, 0x08, 0xfc, 0xcc, 0xc5, 0xe9, 0xf6, 0x77, 0x36, 0x0a, 0x9b, 0xc5, 0x9d, 0x5e, 0x73,
+	0x31, 0xca, 0x7d, 0xad, 0x86, 0x6f, 0xa9, 0x1a, 0xae, 0xa1, 0x57, 0x21, 0xd9, 0x4d, 0x9b, 0xcd,
+	0xe7, 0x0b, 0x7b, 0x65, 0x6a, 0x30, 0xf1, 0xfe, 0x83, 0xf4, 0xd3, 0x5e, 0xd2, 0x6c, 0xb5, 0x8a,
+	0x5b, 0xa6, 0x3f, 0xa5, 0x5c, 0x78, 0xab, 0x90, 0x67, 0x36, 0xf3, 0xa1, 0x94, 0xf1, 0x37, 0x70,
+	0xd5, 0xc4, 0x35, 0xae, 0xf8, 0xf7, 0x42, 0x30, 0xe7, 0x75, 0x4c, 0xb4, 0x05, 0x69, 0x07, 0xb2,
+	0xf0, 0xb5, 0x42, 0x7e, 0xbf, 0xbc, 0x2b, 0xf7, 0x5a, 0xe2, 0x99, 0xfb, 0x0f, 0xd2, 0x17, 0xbd,
+	0x35, 0x8b, 0x6e, 0x78, 0x2d, 0xb2, 0x79, 0x0a, 0xd0, 0xce, 0x6e, 0xb9, 0x22, 0xef, 0xef, 0x24,
+	0x04, 0x31, 0x7d, 0xff, 0x41, 0xfa, 0x82, 0x3f, 0xd0, 0x8e, 0x6e, 0xca, 0x6d, 0xed, 0x54, 0x81,
+	0x4a, 0xfb, 0xf9, 0x7c, 0xa1, 0x54, 0x4a, 0x84, 0x4e, 0x13, 0xa8, 0xd4, 0xae, 0x56, 0x31, 0x21,
+	0xa7, 0x02, 0x6d, 0x66, 0x8b, 0xd7, 0xf6, 0xe5, 0x42, 0x22, 0x7c, 0x1a, 0xd0, 0xa6, 0xa2, 0x36,
+	0xda, 0x06, 0xe6, 0xb6, 0xfb, 0xab, 0x10, 0x44, 0x69, 0xdc, 0x41, 0x57, 0x61, 0xfa, 0x18, 0x93,
+	0x4a, 0x67, 0x13, 0x0b, 0x9e, 0x1d, 0x4f, 0x1d, 0x63, 0x92, 0xa7, 0xbb, 0x57, 0x11, 0xa6, 0x34,
+	0xbd, 0xd2, 0x69, 0x09, 0x07, 0xc7, 0x9a, 0xd4, 0x74, 0x06, 0x55, 0x82, 0x59, 0xe5, 0x80, 0x98,
+	0x8a, 0xaa, 0x71, 0xbc, 0xe1, 0x32, 0xf7, 0x19, 0x0e, 0xc2, 0x40, 0xb7, 0x01, 0x68, 0xb7, 0x88,
+	0x21, 0x46, 0x86, 0x6b, 0x3e, 0x59, 0x08, 0x14, 0x4e, 0xfa, 0x1d, 0x01, 0x50, 0x27, 0x70, 0x95,
+	0x78, 0x28, 0x0b, 0x54, 0xd9, 0x5e, 0x87, 0x19, 0x5a, 0x7a, 0x55, 0x78, 0x7d, 0x12, 0x1a, 0xb9,
+	0x7c, 0x5b, 0x4f, 0x3c, 0x7c, 0x74, 0x69, 0x26, 0xb1, 0x27, 0x3a, 0x4c, 0xac, 0xcf, 0x66, 0xc9,
+	0x5e, 0x39, 0x79, 0x25, 0x16, 0xb0, 0x0e, 0xff, 0x9c, 0x95, 0x64, 0xeb, 0x99, 0x87, 0x8f, 0x2e,
+	0xad, 0x66, 0x92, 0x00, 0xb9, 0x63, 0x5b, 0xe4, 0x84, 0xdc, 0xd1, 0x3d, 0x71, 0xbd, 0x73, 0x6d,
+	0x57, 0x5d, 0xd2, 0x9f, 0x85, 0x61, 0xa1, 0xa7, 0x67, 0x11, 0xc8, 0x10, 0x3e, 0x1b, 0x6c, 0xe8,
+	0x0c, 0x37, 0xd8, 0x2c, 0xc4, 0xd9, 0x13, 0x96, 0xa9, 0x85, 0x07, 0xcc, 0x07, 0xc0, 0x26, 0xca,
+	0x9a, 0xe8, 0x00, 0xe2, 0x56, 0x45, 0xc2, 0x84, 0x25, 0xc9, 0x08, 0xad, 0x49, 0x5e, 0x0b, 0x52,
+	0x93, 0x74, 0xba, 0x3b, 0x14, 0xc3, 0xe6, 0xd1, 0x24, 0x75, 0xf6, 0x80, 0xa0, 0xcb, 0x90, 0xb8,
+	0xa5, 0xa8, 0x0d, 0x5e, 0xfc, 0xa8, 0x5a, 0x0d, 0xdf, 0xa3, 0xf9, 0x60, 0x54, 0x9e, 0x63, 0xcf,
+	0xb7, 0x49, 0xbd, 0x68, 0x3d, 0x45, 0x97, 0x80, 0x3e, 0x69, 0x1b, 0xb8, 0x62, 0x60, 0x85, 0xf0,
+	0x54, 0x70, 0x5a, 0x9e, 0xe5, 0x4f, 0x65, 0xfa, 0x90, 0xbb, 0xf2, 0xbb, 0x2e, 0x57, 0xfe, 0x55,
+	0x01, 0x50, 0xaf, 0x2c, 0x56, 0xcd, 0x63, 0x95, 0x1f, 0x2c, 0x0b, 0x97, 0xe9, 0x35, 0x4a, 0x40,
+	0xb8, 0xa1, 0xd7, 0x79, 0x1a, 0x6d, 0x5d, 0xa2, 0x7d, 0x88, 0xe1, 0xbb, 0x58, 0x33, 0xed, 0xe3,
+	0x43, 0xaf, 0x0c, 0xac, 0xbe, 0xc3, 0xaf, 0x60, 0xd1, 0xdb, 0x3d, 0x5f, 0x06, 0x66, 0x75, 0xb8,
+	0xe6, 0xbc, 0x03, 0x9c, 0x1a, 0x4c, 0xe8, 0xd4, 0x60, 0xe8, 0x16, 0x80, 0x62, 0x9a, 0x86, 0x7a,
+	0xd0, 0x36, 0xb1, 0x7d, 0xea, 0xeb, 0x8d, 0x21, 0x25, 0xc8, 0xda, 0x40, 0xf6, 0x2c, 0x74, 0x90,
+	0xa5, 0x2c, 0x2c, 0xf5, 0x19, 0x6c, 0x99, 0xe4, 0x36, 0x3e, 0xe6, 0x52, 0x59, 0x97, 0x56, 0x09,
+	0x79, 0x57, 0x69, 0xb4, 0xed, 0x6a, 0x91, 0xdd, 0x48, 0xff, 0x15, 0x82, 0x48, 0xe0, 0xce, 0xec,
+	0x16, 0x44, 0x69, 0x63, 0x75, 0x84, 0x2e, 0x18, 0xa5, 0x7f, 0x02, 0x7d, 0xd9, 0x9e, 0xda, 0x27,
+	0x3a, 0x44, 0xed, 0xb3, 0x5e, 0x78, 0xf8, 0xe8, 0x52, 0xd6, 0x15, 0x5e, 0x98, 0xf8, 0x5d, 0xb1,
+	0xc8, 0x35, 0x80, 0x75, 0x83, 0x2c, 0xbb, 0x1a, 0x89, 0x1c, 0x1f, 0x9d, 0x78, 0x43, 0xfa, 0x71,
+	0x18, 0x62, 0x7b, 0x8a, 0xa1, 0x34, 0x09, 0xba, 0x0a, 0xa8, 0xa9, 0xdc, 0xab, 0x70, 0x29, 0x2b,
+	0x0d, 0xac, 0xd5, 0xcd, 0x43, 0x6a, 0xf8, 0xd9, 0xdc, 0xc5, 0x4f, 0x4e, 0x52, 0xcb, 0xc7, 0x4a,
+	0xb3, 0xb1, 0x2e, 0xf5, 0x8e, 0x91, 0xe4, 0x44, 0x53, 0xb9, 0xc7, 0x3f, 0x86, 0x5e, 0xa3, 0x8f,
+	0xd0, 0xd7, 0x61, 0xc9, 0x1a, 0x88, 0xb5, 0x5a, 0xe5, 0xa0, 0xa1, 0x57, 0x6f, 0x57, 0x6c, 0x39,
+	0x08, 0x9d, 0xa1, 0xd9, 0x9c, 0xf4, 0xc9, 0x49, 0x6a, 0xa5, 0x83, 0xe8, 0x33, 0x50, 0x92, 0x17,
+	0x9b, 0xca, 0xbd, 0x82, 0x56, 0xcb, 0x59, 0xcf, 0x6d, 0x9d, 0xac, 0x6c, 0x64, 0xc1, 0xa2, 0x70,
+	0x92, 0xfe, 0x4a, 0x5d, 0x21, 0x74, 0xb2, 0x22, 0xb9, 0x0b, 0x9f, 0x9c, 0xa4, 0x92, 0x1d, 0x50,
+	0xcf, 0x10, 0x49, 0x9e, 0x6b, 0x2a, 0xf7, 0xb2, 0xbc, 0x32, 0xd8, 0x52, 0x08, 0xd2, 0x01, 0xd9,
+	0xcc, 0x2a, 0x06, 0x36, 0xb1, 0x66, 0xda, 0x1d, 0xce, 0x53, 0x3f, 0x96, 0x5c, 0xb2, 0x26, 0xa3,
+	0x63, 0x8f, 0x5e, 0x08, 0xe9, 0x83, 0x7f, 0x4b, 0x09, 0xf2, 0x42, 0xcb, 0xc9, 0x1f, 0xf9, 0x73,
+	0x74, 0x9b, 0x49, 0x7e, 0x57, 0x37, 0x55, 0xad, 0x5e, 0x39, 0x52, 0xb5, 0x9a, 0x7e, 0xf4, 0xe9,
+	0x1f, 0x67, 0x9e, 0xe3, 0xfc, 0x5c, 0x8a, 0x79, 0x10, 0x18, 0xbb, 0xf9, 0xa6, 0x72, 0xef, 0x06,
+	0x7d, 0x7c, 0x93, 0x3e, 0x5d, 0x9f, 0xfa, 0xe0, 0xc3, 0xd4, 0xc4, 0x7f, 0x7c, 0x98, 0x12, 0xa4,
+	0xff, 0x8b, 0xc2, 0xcc, 0x16, 0xd6, 0x30, 0x51, 0x09, 0xab, 0xee, 0xb6, 0xed, 0x39, 0xe7, 0x6d,
+	0x97, 0xc1, 0x7d, 0x9c, 0x91, 0xd9, 0x21, 0x88, 0xdd, 0xa1, 0x97, 0x20, 0x46, 0x87, 0x11, 0xbe,
+	0xf8, 0x2e, 0x3c, 0x3e, 0x49, 0x25, 0xb1, 0x56, 0xd5, 0x6b, 0xaa, 0x56, 0x5f, 0xfb, 0x06, 0xd1,
+	0xb5, 0x55, 0x59, 0x39, 0xda, 0xc6, 0x84, 0x28, 0x75, 0x2c, 0xf3, 0xb1, 0x56, 0xdd, 0x46, 0xaf,
+	0x2a, 0x04, 0xdf, 0x61, 0xd3, 0x27, 0x4f, 0xd1, 0x07, 0x25, 0x7c, 0x07, 0x65, 0xed, 0xca, 0xde,
+	0x3e, 0xa7, 0x1a, 0x19, 0x00, 0x99, 0xd5, 0xfd, 0xf6, 0x11, 0xd1, 0x3c, 0xcc, 0x79, 0xea, 0x7e,
+	0x92, 0x8c, 0x0e, 0x80, 0x31, 0xeb, 0x6e, 0x07, 0x10, 0xf4, 0x02, 0x2c, 0x78, 0x40, 0xa8, 0xb0,
+	0xac, 0x71, 0x30, 0xef, 0x1e, 0x69, 0xc9, 0xbc, 0x0e, 0xd3, 0x1d, 0x27, 0x9f, 0x1c, 0x80, 0x57,
+	0x67, 0x38, 0x7a, 0x06, 0x66, 0xec, 0x1b, 0xca, 0x62, 0x8a, 0xb2, 0x88, 0xdb, 0xcf, 0x2c, 0xf8,
+	0x0c, 0x8b, 0x70, 0x24, 0x39, 0x3d, 0x00, 0x34, 0x1b, 0x8a, 0x76, 0x61, 0xb1, 0x53, 0xf5, 0x56,
+	0xec, 0x72, 0x97, 0x24, 0x61, 0x00, 0x88, 0x73, 0xb8, 0x27, 0x69, 0x23, 0xe8, 0x1d, 0x38, 0xef,
+	0x03, 0xe8, 0xcc, 0x52, 0x7c, 0x00, 0xdc, 0x65, 0xdc, 0x27, 0x19, 0x24, 0x68, 0x1b, 0xce, 0x39,
+	0x56, 0x70, 0xbe, 0xe1, 0x90, 0xe4, 0xcc, 0x00, 0xa8, 0xce, 0x4a, 0x76, 0x76, 0x1f, 0xe2, 0x5a,
+	0x01, 0xaf, 0x40, 0x7c, 0xcf, 0x1a, 0x85, 0xef, 0xb4, 0x31, 0xa1, 0x1b, 0x64, 0xcb, 0xfe, 0x78,
+	0x3e, 0x2b, 0xd3, 0x6b, 0x6b, 0x2f, 0x6a, 0xa8, 0x4d, 0x95, 0xa5, 0x52, 0xb3, 0x32, 0xbb, 0x91,
+	0x5e, 0x86, 0x85, 0xeb, 0x6d, 0x6c, 0x1c, 0xf3, 0xa3, 0xcb, 0x8c, 0x7c, 0x80, 0x93, 0xcb, 0x87,
+	0x80, 0xdc, 0x74, 0xfc, 0x3b, 0x97, 0xec, 0x26, 0x8c, 0x67, 0x5e, 0x0e, 0xd6, 0xed, 0x74, 0xda,
+	0x9c, 0x6c, 0xf5, 0x71, 0x4e, 0xbf, 0x2e, 0x40, 0xb2, 0xc3, 0xca, 0x39, 0x3c, 0x3c, 0xa8, 0xa4,
+	0xa8, 0x0c, 0xd0, 0x52, 0xea, 0xaa, 0x46, 0x63, 0x0d, 0x6f, 0x8f, 0xbe, 0x14, 0x20, 0x1e, 0x38,
+	0x56, 0x95, 0x5d, 0x38, 0xd2, 0x1d, 0x58, 0xf6, 0x11, 0x8a, 0x9b, 0xa1, 0xdc, 0x39, 0x7e, 0x2e,
+	0xa4, 0xc3, 0x81, 0xf8, 0xb9, 0xf0, 0xba, 0xce, 0xa0, 0x4b, 0x7f, 0x2e, 0xb8, 0x79, 0x12, 0xde,
+	0x4c, 0xb5, 0x2d, 0x31, 0xb6, 0xd3, 0x47, 0x67, 0x63, 0x2f, 0x03, 0x44, 0x3f, 0xd9, 0x1d, 0x83,
+	0xd9, 0x01, 0x96, 0xd9, 0x6b, 0x34, 0xc7, 0xe1, 0x58, 0xd2, 0xf7, 0x85, 0x2e, 0xa6, 0xcc, 0xae,
+	0xb6, 0xc5, 0x3a, 0xe5, 0x96, 0x30, 0x6a, 0xb9, 0x75, 0x36, 0x36, 0xfb, 0x39, 0x38, 0xef, 0x2b,
+	0x3e, 0x37, 0xda, 0x3b, 0x10, 0xef, 0x7c, 0x2e, 0x1c, 0x87, 0xa7, 0xb9, 0xe1, 0x24, 0xc3, 0xbd,
+	0xea, 0x9c, 0x13, 0xa8, 0xcc, 0x72, 0x67, 0x75, 0x00, 0xf5, 0x7d, 0x8f, 0x87, 0x77, 0x1f, 0x7b,
+	0xbd, 0xed, 0xc7, 0x35, 0x48, 0x92, 0xdf, 0xe7, 0x13, 0x0d, 0xd7, 0xde, 0x2b, 0xca, 0xb7, 0x04,
+	0x48, 0xf7, 0x88, 0x42, 0x72, 0x41, 0xe3, 0xe4, 0x19, 0x79, 0xc6, 0xaf, 0x09, 0xf0, 0xcc, 0x29,
+	0xd2, 0x71, 0x83, 0x35, 0x7b, 0x12, 0x04, 0x21, 0x1d, 0x1e, 0xa3, 0xc5, 0xbc, 0xa9, 0x84, 0xf4,
+	0x97, 0x7d, 0x4c, 0xf6, 0x93, 0x14, 0xa6, 0xfa, 0x19, 0xd6, 0x1b, 0xae, 0x9e, 0xb0, 0x61, 0x73,
+	0xd6, 0x99, 0x31, 0x6c, 0x1c, 0x77, 0xfa, 0xb5, 0xcc, 0x96, 0x01, 0xca, 0x47, 0xa9, 0x08, 0x4f,
+	0x75, 0x61, 0x70, 0x5d, 0x5e, 0xec, 0x02, 0x89, 0x67, 0x16, 0x7b, 0x32, 0xf5, 0xac, 0x76, 0xec,
+	0x82, 0xba, 0x0a, 0x17, 0x3d, 0x50, 0xae, 0x6e, 0x41, 0x70, 0xb9, 0xde, 0x13, 0x60, 0xa5, 0x1f,
+	0x1a, 0x97, 0xf0, 0x67, 0xc7, 0x7a, 0x78, 0xc6, 0xfe, 0x14, 0xd4, 0x39, 0x42, 0xf3, 0x23, 0x01,
+	0x9e, 0xf5, 0x88, 0x60, 0x2f, 0xa4, 0x27, 0x13, 0xf5, 0xce, 0xc8, 0x93, 0xdf, 0x86, 0xe7, 0x4e,
+	0x57, 0x8a, 0x5b, 0x37, 0xe3, 0x4e, 0xea, 0x99, 0x1b, 0xfb, 0x3b, 0x40, 0x67, 0x98, 0xf4, 0x37,
+	0x02, 0xa4, 0xba, 0xc1, 0xd9, 0x65, 0x67, 0x77, 0xdd, 0xb6, 0x9d, 0x60, 0x94, 0xfd, 0xd5, 0x81,
+	0x38, 0x23, 0x23, 0xdd, 0xe0, 0x11, 0xcb, 0x57, 0x8f, 0x11, 0x0c, 0xf4, 0x4d, 0xdb, 0x40, 0x56,
+	0x3b, 0xa2, 0xd3, 0xae, 0xb0, 0xee, 0x8c, 0x21, 0x56, 0xc9, 0xd8, 0x9a, 0x3f, 0xd2, 0x6d, 0xae,
+	0xb0, 0xaf, 0x5c, 0x5c, 0xe1, 0x2d, 0x88, 0x58, 0x83, 0xf9, 0x52, 0xbb, 0x32, 0xb0, 0x91, 0x29,
+	0x26, 0x3f, 0x9d, 0x60, 0x01, 0x48, 0xdf, 0x11, 0x78, 0x02, 0x63, 0xbd, 0x21, 0xb9, 0x51, 0xe2,
+	0xd7, 0x19, 0xcd, 0xbf, 0x0a, 0x17, 0xfc, 0x05, 0xe4, 0xa6, 0x28, 0xda, 0x25, 0x29, 0x9b, 0xf7,
+	0xa1, 0x6c, 0xc1, 0x10, 0xa4, 0xef, 0xd9, 0x65, 0x0c, 0xe7, 0xe5, 0xf1, 0x05, 0x67, 0x7e, 0x85,
+	0x11, 0x9b, 0x7b, 0x67, 0x63, 0xa6, 0x5b, 0x3c, 0x2d, 0xf3, 0x8a, 0x3e, 0x76, 0x1b, 0xbd, 0xf0,
+	0x3a, 0xc4, 0x58, 0x8f, 0x11, 0xc5, 0x61, 0x72, 0x7f, 0xe7, 0xea, 0xce, 0xee, 0xcd, 0x9d, 0xc4,
+	0x04, 0x8a, 0x41, 0x68, 0x67, 0x37, 0x21, 0xa0, 0x49, 0x08, 0x7f, 0xbd, 0x50, 0x4a, 0x84, 0xac,
+	0xb7, 0xd9, 0x5c, 0xa9, 0x9c, 0x2d, 0xee, 0x24, 0xc2, 0x68, 0x0a, 0x22, 0x37, 0x0a, 0xe5, 0xdd,
+	0x44, 0x24, 0xf3, 0xa7, 0xb3, 0x10, 0xde, 0x26, 0x75, 0xf4, 0xf3, 0x02, 0xc4, 0xdd, 0xbf, 0x15,
+	0xbf, 0x32, 0xe4, 0x1f, 0x61, 0xe2, 0x57, 0x87, 0x24, 0x74, 0x6c, 0xf3, 0x9b, 0x02, 0x20, 0x9f,
+	0x9f, 0x7b, 0xbf, 0x32, 0xe4, 0xaf, 0x33, 0x9c, 0x5e, 0xdc, 0x1c, 0x8d, 0xde, 0x11, 0xef, 0x9b,
+	0x02, 0x24, 0x7a, 0x7e, 0xbb, 0xfd, 0xf2, 0x90, 0xe0, 0x94, 0x5a, 0xdc, 0x18, 0x85, 0xba, 0x9f,
+	0xdd, 0xec, 0x3f, 0x6c, 0x86, 0xb5, 0x1b, 0xa7, 0x17, 0x37, 0x47, 0xa3, 0x77, 0xc4, 0xfb, 0x8e,
+	0x00, 0xa8, 0xf7, 0xff, 0x3c, 0x94, 0x1d, 0xed, 0xcf, 0xc3, 0x92, 0x59, 0x13, 0x37, 0x47, 0x83,
+	0x70, 0x24, 0xfc, 0x7d, 0x01, 0x96, 0xfa, 0xfd, 0xc7, 0x97, 0x1f, 0xed, 0xc7, 0x2d, 0x36, 0xcf,
+	0x57, 0xc7, 0x00, 0xe2, 0x48, 0xfb, 0xb7, 0x02, 0xa4, 0x3f, 0xed, 0x3f, 0x2c, 0xb4, 0x3b, 0x1a,
+	0xc7, 0x9e, 0xdf, 0xd9, 0xc4, 0xf2, 0x38, 0x01, 0x1d, 0x5d, 0xfe, 0x48, 0x80, 0xe5, 0xfe, 0xff,
+	0x88, 0x15, 0x46, 0xe3, 0x69, 0x3b, 0xf2, 0xf6, 0x58, 0x60, 0x1c, 0x99, 0x0d, 0xfe, 0x3d, 0x2a,
+	0xd0, 0x21, 0x4a, 0x8b, 0x42, 0x7c, 0x35, 0x28, 0x85, 0x9b, 0x27, 0x3d, 0xc0, 0xf4, 0x62, 0xd0,
+	0x8f, 0xa4, 0xe2, 0xab, 0x41, 0x29, 0x3c, 0xf1, 0xae, 0xe7, 0xa4, 0x7c, 0xa0, 0x78, 0xd7, 0x4d,
+	0x2d, 0x6e, 0x8c, 0x42, 0x6d, 0x0b, 0x96, 0xf9, 0xc7, 0x05, 0x88, 0xd2, 0x1d, 0x16, 0xbd, 0x27,
+	0xf0, 0x33, 0xdd, 0x68, 0x3d, 0xc0, 0xef, 0x67, 0x5d, 0x0d, 0x5c, 0xf1, 0xb5, 0xa1, 0x68, 0xb9,
+	0x95, 0x7e, 0x43, 0x80, 0x19, 0xf7, 0x76, 0x81, 0xb2, 0x43, 0xa0, 0x79, 0xfb, 0xb4, 0x62, 0x6e,
+	0x14, 0x08, 0x2e, 0xd7, 0x07, 0x02, 0xff, 0x79, 0xc0, 0xae, 0xc7, 0xd1, 0x30, 0xa8, 0x5d, 0x0d,
+	0x09, 0x31, 0x3f, 0x12, 0x06, 0x17, 0xed, 0x5b, 0x02, 0xcc, 0x79, 0xbb, 0x74, 0x68, 0x48, 0x5c,
+	0x4f, 0x8b, 0x52, 0xdc, 0x18, 0x0d, 0xa4, 0x7b, 0x42, 0x83, 0x6f, 0x54, 0xfd, 0x5a, 0x80, 0x62,
+	0x6e, 0x14, 0x08, 0x2e, 0xd7, 0x1f, 0x0a, 0xb0, 0xe8, 0xd7, 0xc0, 0x42, 0xc5, 0xe1, 0xc1, 0xbb,
+	0x5a, 0x74, 0xe2, 0x5b, 0xe3, 0x80, 0xea, 0x2f, 0x2f, 0xf3, 0xc3, 0xd1, 0xe4, 0xf5, 0xb8, 0xe3,
+	0x5b, 0xe3, 0x80, 0xe2, 0xf2, 0xfe, 0x82, 0x00, 0x53, 0x4e, 0x98, 0x7b, 0x3d, 0x18, 0x70, 0x57,
+	0xad, 0x26, 0x7e, 0x65, 0x58, 0x72, 0x2e, 0xcb, 0x6f, 0x0b, 0x7e, 0x87, 0x82, 0x36, 0x87, 0x43,
+	0xed, 0xee, 0x38, 0x89, 0x5b, 0x23, 0xe3, 0x70, 0x31, 0xff, 0x44, 0x80, 0xa5, 0x3e, 0x1d, 0x13,
+	0x74, 0x6d, 0x38, 0x26, 0xfe, 0xdd, 0x24, 0x71, 0x7b, 0x4c, 0x68, 0x5c, 0xf0, 0xdf, 0x13, 0xe0,
+	0x9c, 0x4f, 0x17, 0x03, 0xbd, 0x39, 0x34, 0x9b, 0xae, 0x86, 0x8e, 0x58, 0x1c, 0x03, 0x92, 0x4b,
+	0x58, 0x9f, 0x0e, 0x44, 0x50, 0x61, 0xfb, 0x37, 0x57, 0x82, 0x0a, 0x7b, 0x5a, 0x3b, 0xe4, 0xdb,
+	0x02, 0xcc, 0x77, 0xf5, 0x07, 0xd0, 0x46, 0x70, 0xf8, 0xde, 0xfe, 0x87, 0x58, 0x18, 0x11, 0xc5,
+	0x15, 0xde, 0xdd, 0x95, 0x79, 0xd0, 0xf0, 0xee, 0xd3, 0x90, 0x08, 0x1a, 0xde, 0xfd, 0x1a, 0x03,
+	0xb9, 0xfc, 0x0f, 0x3e, 0x5a, 0x11, 0x7e, 0xf8, 0xd1, 0x8a, 0xf0, 0xef, 0x1f, 0xad, 0x08, 0xbf,
+	0xf2, 0xf1, 0xca, 0xc4, 0x0f, 0x3f, 0x5e, 0x99, 0xf8, 0xe7, 0x8f, 0x57, 0x26, 0xde, 0xfe, 0xe9,
+	0xde, 0xde, 0x06, 0xe7, 0xb3, 0xe6, 0xf0, 0x59, 0xa3, 0x7c, 0x0e, 0x62, 0xb4, 0xc5, 0xf6, 0xa5,
+	0xff, 0x1f, 0x00, 0x59, 0xe3, 0xee, 0x17, 0x12, 0x4d, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.MaxCommentLength != that1.MaxCommentLength {
+		return false
 	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
+	if this.MaxEndBlockProposals != that1.MaxEndBlockProposals {
+		return false
 	}
-	l = m.TotalWeight.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	if this.MaxAutoExecGas != that1.MaxAutoExecGas {
+		return false
+	}
+	if this.ProposalRetention != that1.ProposalRetention {
+		return false
+	}
+	if this.MaxVotingWindow != that1.MaxVotingWindow {
+		return false
+	}
+	return true
 }
+func (this *GenesisState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GroupMember) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*GenesisState)
+	if !ok {
+		that2, ok := that.(GenesisState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Member)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.Params.Equal(&that1.Params) {
+		return false
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Groups, that1.Groups) {
+		return false
 	}
-	return n
-}
-
-func (m *GroupAccountMetadataBase) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.GroupSeq != that1.GroupSeq {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.GroupAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.GroupMembers, that1.GroupMembers) {
+		return false
 	}
-	if m.Group != 0 {
-		n += 1 + sovTypes(uint64(m.Group))
+	if !bytes.Equal(this.GroupAccounts, that1.GroupAccounts) {
+		return false
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.GroupAccountSeq != that1.GroupAccountSeq {
+		return false
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Proposals, that1.Proposals) {
+		return false
 	}
-	if m.Version != 0 {
-		n += 1 + sovTypes(uint64(m.Version))
+	if this.ProposalSeq != that1.ProposalSeq {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.Votes, that1.Votes) {
+		return false
+	}
+	if !bytes.Equal(this.ElectorateSnapshots, that1.ElectorateSnapshots) {
+		return false
+	}
+	if !bytes.Equal(this.ElectorateSnapshotMembers, that1.ElectorateSnapshotMembers) {
+		return false
+	}
+	if !bytes.Equal(this.ProposalExecutions, that1.ProposalExecutions) {
+		return false
+	}
+	return true
 }
 
-func (m *StdGroupAccountMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Base.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.DecisionPolicy.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error)
+	UpdateGroupMembers(ctx context.Context, in *MsgUpdateGroupMembers, opts ...grpc.CallOption) (*MsgUpdateGroupMembersResponse, error)
+	UpdateGroupAdmin(ctx context.Context, in *MsgUpdateGroupAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAdminResponse, error)
+	UpdateGroupComment(ctx context.Context, in *MsgUpdateGroupComment, opts ...grpc.CallOption) (*MsgUpdateGroupCommentResponse, error)
+	CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAccountStd, opts ...grpc.CallOption) (*MsgCreateGroupAccountResponse, error)
+	UpdateGroupAccountAdmin(ctx context.Context, in *MsgUpdateGroupAccountAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAccountAdminResponse, error)
+	UpdateGroupAccountDecisionPolicy(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicyStd, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
+	UpdateGroupAccountComment(ctx context.Context, in *MsgUpdateGroupAccountComment, opts ...grpc.CallOption) (*MsgUpdateGroupAccountCommentResponse, error)
+	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 }
 
-func (m *ProposalBase) Size() (n int) {
-	if m == nil {
-		return 0
+type msgClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMsgClient(cc *grpc.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateGroup(ctx context.Context, in *MsgCreateGroup, opts ...grpc.CallOption) (*MsgCreateGroupResponse, error) {
+	out := new(MsgCreateGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.GroupAccount)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupMembers(ctx context.Context, in *MsgUpdateGroupMembers, opts ...grpc.CallOption) (*MsgUpdateGroupMembersResponse, error) {
+	out := new(MsgUpdateGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupAdmin(ctx context.Context, in *MsgUpdateGroupAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAdminResponse, error) {
+	out := new(MsgUpdateGroupAdminResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Proposers) > 0 {
-		for _, b := range m.Proposers {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupComment(ctx context.Context, in *MsgUpdateGroupComment, opts ...grpc.CallOption) (*MsgUpdateGroupCommentResponse, error) {
+	out := new(MsgUpdateGroupCommentResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupComment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.SubmittedAt.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.GroupVersion != 0 {
-		n += 1 + sovTypes(uint64(m.GroupVersion))
+	return out, nil
+}
+
+func (c *msgClient) CreateGroupAccount(ctx context.Context, in *MsgCreateGroupAccountStd, opts ...grpc.CallOption) (*MsgCreateGroupAccountResponse, error) {
+	out := new(MsgCreateGroupAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/CreateGroupAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupAccountVersion != 0 {
-		n += 1 + sovTypes(uint64(m.GroupAccountVersion))
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupAccountAdmin(ctx context.Context, in *MsgUpdateGroupAccountAdmin, opts ...grpc.CallOption) (*MsgUpdateGroupAccountAdminResponse, error) {
+	out := new(MsgUpdateGroupAccountAdminResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAccountAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		n += 1 + sovTypes(uint64(m.Status))
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupAccountDecisionPolicy(ctx context.Context, in *MsgUpdateGroupAccountDecisionPolicyStd, opts ...grpc.CallOption) (*MsgUpdateGroupAccountDecisionPolicyResponse, error) {
+	out := new(MsgUpdateGroupAccountDecisionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAccountDecisionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Result != 0 {
-		n += 1 + sovTypes(uint64(m.Result))
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupAccountComment(ctx context.Context, in *MsgUpdateGroupAccountComment, opts ...grpc.CallOption) (*MsgUpdateGroupAccountCommentResponse, error) {
+	out := new(MsgUpdateGroupAccountCommentResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAccountComment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.VoteState.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.Timeout.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.ExecutorResult != 0 {
-		n += 1 + sovTypes(uint64(m.ExecutorResult))
+	return out, nil
+}
+
+func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error) {
+	out := new(MsgVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *Tally) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
+	out := new(MsgExecResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/Exec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.YesCount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.NoCount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.AbstainCount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.VetoCount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error) {
+	out := new(MsgWithdrawProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Msg/WithdrawProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Proposal != 0 {
-		n += 1 + sovTypes(uint64(m.Proposal))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateGroup(context.Context, *MsgCreateGroup) (*MsgCreateGroupResponse, error)
+	UpdateGroupMembers(context.Context, *MsgUpdateGroupMembers) (*MsgUpdateGroupMembersResponse, error)
+	UpdateGroupAdmin(context.Context, *MsgUpdateGroupAdmin) (*MsgUpdateGroupAdminResponse, error)
+	UpdateGroupComment(context.Context, *MsgUpdateGroupComment) (*MsgUpdateGroupCommentResponse, error)
+	CreateGroupAccount(context.Context, *MsgCreateGroupAccountStd) (*MsgCreateGroupAccountResponse, error)
+	UpdateGroupAccountAdmin(context.Context, *MsgUpdateGroupAccountAdmin) (*MsgUpdateGroupAccountAdminResponse, error)
+	UpdateGroupAccountDecisionPolicy(context.Context, *MsgUpdateGroupAccountDecisionPolicyStd) (*MsgUpdateGroupAccountDecisionPolicyResponse, error)
+	UpdateGroupAccountComment(context.Context, *MsgUpdateGroupAccountComment) (*MsgUpdateGroupAccountCommentResponse, error)
+	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateGroup(ctx context.Context, req *MsgCreateGroup) (*MsgCreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupMembers(ctx context.Context, req *MsgUpdateGroupMembers) (*MsgUpdateGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupMembers not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupAdmin(ctx context.Context, req *MsgUpdateGroupAdmin) (*MsgUpdateGroupAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAdmin not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupComment(ctx context.Context, req *MsgUpdateGroupComment) (*MsgUpdateGroupCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupComment not implemented")
+}
+func (*UnimplementedMsgServer) CreateGroupAccount(ctx context.Context, req *MsgCreateGroupAccountStd) (*MsgCreateGroupAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupAccount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupAccountAdmin(ctx context.Context, req *MsgUpdateGroupAccountAdmin) (*MsgUpdateGroupAccountAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountAdmin not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupAccountDecisionPolicy(ctx context.Context, req *MsgUpdateGroupAccountDecisionPolicyStd) (*MsgUpdateGroupAccountDecisionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountDecisionPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupAccountComment(ctx context.Context, req *MsgUpdateGroupAccountComment) (*MsgUpdateGroupAccountCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupAccountComment not implemented")
+}
+func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProposal not implemented")
+}
+
+func RegisterMsgServer(s *grpc.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGroup)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGroup(ctx, in)
 	}
-	if m.Choice != 0 {
-		n += 1 + sovTypes(uint64(m.Choice))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/CreateGroup",
 	}
-	l = len(m.Comment)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGroup(ctx, req.(*MsgCreateGroup))
 	}
-	l = m.SubmittedAt.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupMembers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MaxCommentLength != 0 {
-		n += 1 + sovTypes(uint64(m.MaxCommentLength))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupMembers(ctx, req.(*MsgUpdateGroupMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupAdmin(ctx, req.(*MsgUpdateGroupAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupComment(ctx, req.(*MsgUpdateGroupComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGroupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGroupAccountStd)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGroupAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/CreateGroupAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGroupAccount(ctx, req.(*MsgCreateGroupAccountStd))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupAccountAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupAccountAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupAccountAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAccountAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupAccountAdmin(ctx, req.(*MsgUpdateGroupAccountAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupAccountDecisionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupAccountDecisionPolicyStd)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupAccountDecisionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAccountDecisionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupAccountDecisionPolicy(ctx, req.(*MsgUpdateGroupAccountDecisionPolicyStd))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupAccountComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupAccountComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupAccountComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/UpdateGroupAccountComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupAccountComment(ctx, req.(*MsgUpdateGroupAccountComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Vote(ctx, req.(*MsgVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExec)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/Exec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Exec(ctx, req.(*MsgExec))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Msg/WithdrawProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawProposal(ctx, req.(*MsgWithdrawProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos_modules.incubator.group.v1_alpha.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Msg_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroupMembers",
+			Handler:    _Msg_UpdateGroupMembers_Handler,
+		},
+		{
+			MethodName: "UpdateGroupAdmin",
+			Handler:    _Msg_UpdateGroupAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateGroupComment",
+			Handler:    _Msg_UpdateGroupComment_Handler,
+		},
+		{
+			MethodName: "CreateGroupAccount",
+			Handler:    _Msg_CreateGroupAccount_Handler,
+		},
+		{
+			MethodName: "UpdateGroupAccountAdmin",
+			Handler:    _Msg_UpdateGroupAccountAdmin_Handler,
+		},
+		{
+			MethodName: "UpdateGroupAccountDecisionPolicy",
+			Handler:    _Msg_UpdateGroupAccountDecisionPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateGroupAccountComment",
+			Handler:    _Msg_UpdateGroupAccountComment_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Msg_Vote_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _Msg_Exec_Handler,
+		},
+		{
+			MethodName: "WithdrawProposal",
+			Handler:    _Msg_WithdrawProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "types.proto",
+}
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Group(ctx context.Context, in *QueryGroupRequest, opts ...grpc.CallOption) (*QueryGroupResponse, error)
+	GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error)
+	GroupsByAdmin(ctx context.Context, in *QueryGroupsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupsByAdminResponse, error)
+	GroupsByMember(ctx context.Context, in *QueryGroupsByMemberRequest, opts ...grpc.CallOption) (*QueryGroupsByMemberResponse, error)
+	GroupAccount(ctx context.Context, in *QueryGroupAccountRequest, opts ...grpc.CallOption) (*QueryGroupAccountResponse, error)
+	GroupAccountsByGroup(ctx context.Context, in *QueryGroupAccountsByGroupRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByGroupResponse, error)
+	GroupAccountsByAdmin(ctx context.Context, in *QueryGroupAccountsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByAdminResponse, error)
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	ProposalExecution(ctx context.Context, in *QueryProposalExecutionRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResponse, error)
+	ProposalsByGroupAccount(ctx context.Context, in *QueryProposalsByGroupAccountRequest, opts ...grpc.CallOption) (*QueryProposalsByGroupAccountResponse, error)
+	ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error)
+	VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error)
+	VotesByProposal(ctx context.Context, in *QueryVotesByProposalRequest, opts ...grpc.CallOption) (*QueryVotesByProposalResponse, error)
+	VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error)
+}
+
+type queryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryClient(cc *grpc.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Group(ctx context.Context, in *QueryGroupRequest, opts ...grpc.CallOption) (*QueryGroupResponse, error) {
+	out := new(QueryGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/Group", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupMembers(ctx context.Context, in *QueryGroupMembersRequest, opts ...grpc.CallOption) (*QueryGroupMembersResponse, error) {
+	out := new(QueryGroupMembersResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/GroupMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupsByAdmin(ctx context.Context, in *QueryGroupsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupsByAdminResponse, error) {
+	out := new(QueryGroupsByAdminResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/GroupsByAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupsByMember(ctx context.Context, in *QueryGroupsByMemberRequest, opts ...grpc.CallOption) (*QueryGroupsByMemberResponse, error) {
+	out := new(QueryGroupsByMemberResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/GroupsByMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupAccount(ctx context.Context, in *QueryGroupAccountRequest, opts ...grpc.CallOption) (*QueryGroupAccountResponse, error) {
+	out := new(QueryGroupAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/GroupAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupAccountsByGroup(ctx context.Context, in *QueryGroupAccountsByGroupRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByGroupResponse, error) {
+	out := new(QueryGroupAccountsByGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/GroupAccountsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GroupAccountsByAdmin(ctx context.Context, in *QueryGroupAccountsByAdminRequest, opts ...grpc.CallOption) (*QueryGroupAccountsByAdminResponse, error) {
+	out := new(QueryGroupAccountsByAdminResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/GroupAccountsByAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/Proposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalExecution(ctx context.Context, in *QueryProposalExecutionRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResponse, error) {
+	out := new(QueryProposalExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/ProposalExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByGroupAccount(ctx context.Context, in *QueryProposalsByGroupAccountRequest, opts ...grpc.CallOption) (*QueryProposalsByGroupAccountResponse, error) {
+	out := new(QueryProposalsByGroupAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/ProposalsByGroupAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalsByProposer(ctx context.Context, in *QueryProposalsByProposerRequest, opts ...grpc.CallOption) (*QueryProposalsByProposerResponse, error) {
+	out := new(QueryProposalsByProposerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/ProposalsByProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteByProposalVoter(ctx context.Context, in *QueryVoteByProposalVoterRequest, opts ...grpc.CallOption) (*QueryVoteByProposalVoterResponse, error) {
+	out := new(QueryVoteByProposalVoterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/VoteByProposalVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotesByProposal(ctx context.Context, in *QueryVotesByProposalRequest, opts ...grpc.CallOption) (*QueryVotesByProposalResponse, error) {
+	out := new(QueryVotesByProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/VotesByProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotesByVoter(ctx context.Context, in *QueryVotesByVoterRequest, opts ...grpc.CallOption) (*QueryVotesByVoterResponse, error) {
+	out := new(QueryVotesByVoterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos_modules.incubator.group.v1_alpha.Query/VotesByVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Group(context.Context, *QueryGroupRequest) (*QueryGroupResponse, error)
+	GroupMembers(context.Context, *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error)
+	GroupsByAdmin(context.Context, *QueryGroupsByAdminRequest) (*QueryGroupsByAdminResponse, error)
+	GroupsByMember(context.Context, *QueryGroupsByMemberRequest) (*QueryGroupsByMemberResponse, error)
+	GroupAccount(context.Context, *QueryGroupAccountRequest) (*QueryGroupAccountResponse, error)
+	GroupAccountsByGroup(context.Context, *QueryGroupAccountsByGroupRequest) (*QueryGroupAccountsByGroupResponse, error)
+	GroupAccountsByAdmin(context.Context, *QueryGroupAccountsByAdminRequest) (*QueryGroupAccountsByAdminResponse, error)
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	ProposalExecution(context.Context, *QueryProposalExecutionRequest) (*QueryProposalExecutionResponse, error)
+	ProposalsByGroupAccount(context.Context, *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error)
+	ProposalsByProposer(context.Context, *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error)
+	VoteByProposalVoter(context.Context, *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error)
+	VotesByProposal(context.Context, *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error)
+	VotesByVoter(context.Context, *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Group(ctx context.Context, req *QueryGroupRequest) (*QueryGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Group not implemented")
+}
+func (*UnimplementedQueryServer) GroupMembers(ctx context.Context, req *QueryGroupMembersRequest) (*QueryGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupMembers not implemented")
+}
+func (*UnimplementedQueryServer) GroupsByAdmin(ctx context.Context, req *QueryGroupsByAdminRequest) (*QueryGroupsByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupsByAdmin not implemented")
+}
+func (*UnimplementedQueryServer) GroupsByMember(ctx context.Context, req *QueryGroupsByMemberRequest) (*QueryGroupsByMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupsByMember not implemented")
+}
+func (*UnimplementedQueryServer) GroupAccount(ctx context.Context, req *QueryGroupAccountRequest) (*QueryGroupAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAccount not implemented")
+}
+func (*UnimplementedQueryServer) GroupAccountsByGroup(ctx context.Context, req *QueryGroupAccountsByGroupRequest) (*QueryGroupAccountsByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAccountsByGroup not implemented")
+}
+func (*UnimplementedQueryServer) GroupAccountsByAdmin(ctx context.Context, req *QueryGroupAccountsByAdminRequest) (*QueryGroupAccountsByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroupAccountsByAdmin not implemented")
+}
+func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) ProposalExecution(ctx context.Context, req *QueryProposalExecutionRequest) (*QueryProposalExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecution not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByGroupAccount(ctx context.Context, req *QueryProposalsByGroupAccountRequest) (*QueryProposalsByGroupAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByGroupAccount not implemented")
+}
+func (*UnimplementedQueryServer) ProposalsByProposer(ctx context.Context, req *QueryProposalsByProposerRequest) (*QueryProposalsByProposerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsByProposer not implemented")
+}
+func (*UnimplementedQueryServer) VoteByProposalVoter(ctx context.Context, req *QueryVoteByProposalVoterRequest) (*QueryVoteByProposalVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteByProposalVoter not implemented")
+}
+func (*UnimplementedQueryServer) VotesByProposal(ctx context.Context, req *QueryVotesByProposalRequest) (*QueryVotesByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotesByProposal not implemented")
+}
+func (*UnimplementedQueryServer) VotesByVoter(ctx context.Context, req *QueryVotesByVoterRequest) (*QueryVotesByVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotesByVoter not implemented")
+}
+
+func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Group_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Group(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/Group",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Group(ctx, req.(*QueryGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/GroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupMembers(ctx, req.(*QueryGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupsByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/GroupsByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupsByAdmin(ctx, req.(*QueryGroupsByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupsByMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupsByMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupsByMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/GroupsByMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupsByMember(ctx, req.(*QueryGroupsByMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/GroupAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAccount(ctx, req.(*QueryGroupAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupAccountsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAccountsByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAccountsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/GroupAccountsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAccountsByGroup(ctx, req.(*QueryGroupAccountsByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GroupAccountsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupAccountsByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GroupAccountsByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/GroupAccountsByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GroupAccountsByAdmin(ctx, req.(*QueryGroupAccountsByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/Proposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/ProposalExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecution(ctx, req.(*QueryProposalExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByGroupAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByGroupAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByGroupAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/ProposalsByGroupAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByGroupAccount(ctx, req.(*QueryProposalsByGroupAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalsByProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsByProposerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsByProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/ProposalsByProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsByProposer(ctx, req.(*QueryProposalsByProposerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteByProposalVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteByProposalVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteByProposalVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/VoteByProposalVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteByProposalVoter(ctx, req.(*QueryVoteByProposalVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotesByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesByProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotesByProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/VotesByProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotesByProposal(ctx, req.(*QueryVotesByProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotesByVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesByVoterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotesByVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos_modules.incubator.group.v1_alpha.Query/VotesByVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotesByVoter(ctx, req.(*QueryVotesByVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos_modules.incubator.group.v1_alpha.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Group",
+			Handler:    _Query_Group_Handler,
+		},
+		{
+			MethodName: "GroupMembers",
+			Handler:    _Query_GroupMembers_Handler,
+		},
+		{
+			MethodName: "GroupsByAdmin",
+			Handler:    _Query_GroupsByAdmin_Handler,
+		},
+		{
+			MethodName: "GroupsByMember",
+			Handler:    _Query_GroupsByMember_Handler,
+		},
+		{
+			MethodName: "GroupAccount",
+			Handler:    _Query_GroupAccount_Handler,
+		},
+		{
+			MethodName: "GroupAccountsByGroup",
+			Handler:    _Query_GroupAccountsByGroup_Handler,
+		},
+		{
+			MethodName: "GroupAccountsByAdmin",
+			Handler:    _Query_GroupAccountsByAdmin_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "ProposalExecution",
+			Handler:    _Query_ProposalExecution_Handler,
+		},
+		{
+			MethodName: "ProposalsByGroupAccount",
+			Handler:    _Query_ProposalsByGroupAccount_Handler,
+		},
+		{
+			MethodName: "ProposalsByProposer",
+			Handler:    _Query_ProposalsByProposer_Handler,
+		},
+		{
+			MethodName: "VoteByProposalVoter",
+			Handler:    _Query_VoteByProposalVoter_Handler,
+		},
+		{
+			MethodName: "VotesByProposal",
+			Handler:    _Query_VotesByProposal_Handler,
+		},
+		{
+			MethodName: "VotesByVoter",
+			Handler:    _Query_VotesByVoter_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "types.proto",
+}
+
+func (this *StdDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if x := this.GetThreshold(); x != nil {
+		return x
+	}
+	if x := this.GetPercentage(); x != nil {
+		return x
+	}
+	if x := this.GetQuorum(); x != nil {
+		return x
+	}
+	return nil
+}
+
+func (this *StdDecisionPolicy) SetDecisionPolicy(value DecisionPolicy) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
+	}
+	switch vt := value.(type) {
+	case *ThresholdDecisionPolicy:
+		this.Sum = &StdDecisionPolicy_Threshold{vt}
+		return nil
+	case *PercentageDecisionPolicy:
+		this.Sum = &StdDecisionPolicy_Percentage{vt}
+		return nil
+	case *QuorumDecisionPolicy:
+		this.Sum = &StdDecisionPolicy_Quorum{vt}
+		return nil
+	}
+	return fmt.Errorf("can't encode value of type %T as message StdDecisionPolicy", value)
+}
+
+func (m *MsgCreateGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MemberUpdates) > 0 {
+		for iNdEx := len(m.MemberUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupComment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupComment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupCommentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupCommentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupCommentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGroupAccountBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGroupAccountBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGroupAccountBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedMsgTypes) > 0 {
+		for iNdEx := len(m.DeniedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeniedMsgTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMsgTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SnapshotElectorate {
+		i--
+		if m.SnapshotElectorate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoExec {
+		i--
+		if m.AutoExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGroupAccountStd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGroupAccountStd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGroupAccountStd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGroupAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGroupAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGroupAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyStd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyStd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyStd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountComment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountComment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupAccountCommentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupAccountCommentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupAccountCommentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StdDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdDecisionPolicy_Threshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdDecisionPolicy_Threshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Threshold != nil {
+		{
+			size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdDecisionPolicy_Percentage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdDecisionPolicy_Percentage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Percentage != nil {
+		{
+			size, err := m.Percentage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StdDecisionPolicy_Quorum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdDecisionPolicy_Quorum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Quorum != nil {
+		{
+			size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ThresholdDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinExecutionPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Timout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PercentageDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentageDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinExecutionPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Timout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinExecutionPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Timout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VetoThreshold.Size()
+		i -= size
+		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Choice != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Choice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MembershipVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MembershipVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAccountMetadataBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupAccountMetadataBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupAccountMetadataBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedMsgTypes) > 0 {
+		for iNdEx := len(m.DeniedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeniedMsgTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMsgTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.SnapshotElectorate {
+		i--
+		if m.SnapshotElectorate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoExec {
+		i--
+		if m.AutoExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Version != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdGroupAccountMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdGroupAccountMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdGroupAccountMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DecisionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotElectorate {
+		i--
+		if m.SnapshotElectorate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AutoExec {
+		i--
+		if m.AutoExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExecutorResult != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorResult))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.VoteState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GroupAccountVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupAccountVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GroupVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proposers[iNdEx])
+			copy(dAtA[i:], m.Proposers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VetoCount.Size()
+		i -= size
+		if _, err := m.VetoCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AbstainCount.Size()
+		i -= size
+		if _, err := m.AbstainCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NoCount.Size()
+		i -= size
+		if _, err := m.NoCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.YesCount.Size()
+		i -= size
+		if _, err := m.YesCount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ElectorateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElectorateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElectorateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ElectorateSnapshotMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElectorateSnapshotMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElectorateSnapshotMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FailedMsgIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailedMsgIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExecutorResult != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutorResult))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionEventAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionEventAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionEventAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SubmittedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Choice != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Choice))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxVotingWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxVotingWindow):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintTypes(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x2a
+	n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProposalRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalRetention):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintTypes(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxAutoExecGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxAutoExecGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxEndBlockProposals != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxEndBlockProposals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxCommentLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCommentLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalExecutions) > 0 {
+		i -= len(m.ProposalExecutions)
+		copy(dAtA[i:], m.ProposalExecutions)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposalExecutions)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ElectorateSnapshotMembers) > 0 {
+		i -= len(m.ElectorateSnapshotMembers)
+		copy(dAtA[i:], m.ElectorateSnapshotMembers)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ElectorateSnapshotMembers)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ElectorateSnapshots) > 0 {
+		i -= len(m.ElectorateSnapshots)
+		copy(dAtA[i:], m.ElectorateSnapshots)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ElectorateSnapshots)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Votes) > 0 {
+		i -= len(m.Votes)
+		copy(dAtA[i:], m.Votes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Votes)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ProposalSeq != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalSeq))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Proposals) > 0 {
+		i -= len(m.Proposals)
+		copy(dAtA[i:], m.Proposals)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposals)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GroupAccountSeq != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupAccountSeq))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.GroupAccounts) > 0 {
+		i -= len(m.GroupAccounts)
+		copy(dAtA[i:], m.GroupAccounts)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccounts)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.GroupMembers) > 0 {
+		i -= len(m.GroupMembers)
+		copy(dAtA[i:], m.GroupMembers)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupMembers)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GroupSeq != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Groups) > 0 {
+		i -= len(m.Groups)
+		copy(dAtA[i:], m.Groups)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Groups)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Page != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsByAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsByAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsByMemberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsByMemberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByMemberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupsByMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupsByMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupsByMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memberships) > 0 {
+		for iNdEx := len(m.Memberships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Memberships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GroupAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAccountsByGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountsByGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountsByGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Group != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Group))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAccountsByGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountsByGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountsByGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupAccounts) > 0 {
+		for iNdEx := len(m.GroupAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAccountsByAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountsByAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountsByAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGroupAccountsByAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGroupAccountsByAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGroupAccountsByAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupAccounts) > 0 {
+		for iNdEx := len(m.GroupAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GroupAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByGroupAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByGroupAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByGroupAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupAccount) > 0 {
+		i -= len(m.GroupAccount)
+		copy(dAtA[i:], m.GroupAccount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByGroupAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByGroupAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByGroupAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByProposerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByProposerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByProposerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsByProposerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsByProposerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsByProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteByProposalVoterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteByProposalVoterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteByProposalVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteByProposalVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteByProposalVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteByProposalVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesByProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesByProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesByProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Proposal))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesByProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesByProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesByVoterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesByVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	if len(m.MemberUpdates) > 0 {
+		for _, e := range m.MemberUpdates {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupComment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupCommentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupAccountBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AutoExec {
+		n += 2
+	}
+	if m.SnapshotElectorate {
+		n += 2
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, e := range m.AllowedMsgTypes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedMsgTypes) > 0 {
+		for _, e := range m.DeniedMsgTypes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateGroupAccountStd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Base.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.DecisionPolicy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *MsgCreateGroupAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupAccountAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupAccountAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupAccountBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyStd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Base.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.DecisionPolicy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupAccountComment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupAccountCommentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StdDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *StdDecisionPolicy_Threshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != nil {
+		l = m.Threshold.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *StdDecisionPolicy_Percentage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentage != nil {
+		l = m.Percentage.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *StdDecisionPolicy_Quorum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ThresholdDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Timout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinExecutionPeriod.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PercentageDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Percentage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Timout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinExecutionPeriod.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QuorumDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quorum.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Timout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinExecutionPeriod.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *MsgProposeBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Proposers) > 0 {
+		for _, b := range m.Proposers {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	if len(m.Voters) > 0 {
+		for _, b := range m.Voters {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Choice != 0 {
+		n += 1 + sovTypes(uint64(m.Choice))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GroupMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MembershipVersion != 0 {
+		n += 1 + sovTypes(uint64(m.MembershipVersion))
+	}
+	return n
+}
+
+func (m *GroupMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GroupAccountMetadataBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTypes(uint64(m.Version))
+	}
+	if m.AutoExec {
+		n += 2
+	}
+	if m.SnapshotElectorate {
+		n += 2
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, e := range m.AllowedMsgTypes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedMsgTypes) > 0 {
+		for _, e := range m.DeniedMsgTypes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *StdGroupAccountMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Base.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.DecisionPolicy.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ProposalBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Proposers) > 0 {
+		for _, b := range m.Proposers {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.SubmittedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.GroupVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupVersion))
+	}
+	if m.GroupAccountVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupAccountVersion))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	l = m.VoteState.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Timeout.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ExecutorResult != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutorResult))
+	}
+	if m.AutoExec {
+		n += 2
+	}
+	if m.SnapshotElectorate {
+		n += 2
+	}
+	return n
+}
+
+func (m *Tally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.YesCount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.NoCount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AbstainCount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.VetoCount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ElectorateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ElectorateSnapshotMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ProposalExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	if m.ExecutorResult != 0 {
+		n += 1 + sovTypes(uint64(m.ExecutorResult))
+	}
+	l = m.ExecutedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.FailedMsgIndex != 0 {
+		n += 1 + sovTypes(uint64(m.FailedMsgIndex))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionEventAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Choice != 0 {
+		n += 1 + sovTypes(uint64(m.Choice))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SubmittedAt.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxCommentLength != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCommentLength))
+	}
+	if m.MaxEndBlockProposals != 0 {
+		n += 1 + sovTypes(uint64(m.MaxEndBlockProposals))
+	}
+	if m.MaxAutoExecGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxAutoExecGas))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalRetention)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxVotingWindow)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Groups)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupSeq != 0 {
+		n += 1 + sovTypes(uint64(m.GroupSeq))
+	}
+	l = len(m.GroupMembers)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.GroupAccounts)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GroupAccountSeq != 0 {
+		n += 1 + sovTypes(uint64(m.GroupAccountSeq))
+	}
+	l = len(m.Proposals)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ProposalSeq != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalSeq))
+	}
+	l = len(m.Votes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ElectorateSnapshots)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ElectorateSnapshotMembers)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ProposalExecutions)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovTypes(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTypes(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	return n
+}
+
+func (m *QueryGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Group.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QueryGroupMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupsByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupsByMemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupsByMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Memberships) > 0 {
+		for _, e := range m.Memberships {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GroupAccount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QueryGroupAccountsByGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != 0 {
+		n += 1 + sovTypes(uint64(m.Group))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAccountsByGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupAccounts) > 0 {
+		for _, e := range m.GroupAccounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGroupAccountsByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupAccountsByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GroupAccounts) > 0 {
+		for _, e := range m.GroupAccounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	return n
+}
+
+func (m *QueryProposalExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Execution.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QueryProposalsByGroupAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GroupAccount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByGroupAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalsByProposerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsByProposerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteByProposalVoterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteByProposalVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vote.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QueryVotesByProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != 0 {
+		n += 1 + sovTypes(uint64(m.Proposal))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesByProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVotesByVoterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesByVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberUpdates = append(m.MemberUpdates, Member{})
+			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = append(m.NewAdmin[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewAdmin == nil {
+				m.NewAdmin = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupComment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupComment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupComment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupCommentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupCommentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupCommentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Member) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroupAccountBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroupAccountBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroupAccountBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExec = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotElectorate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotElectorate = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, MsgType{})
+			if err := m.AllowedMsgTypes[len(m.AllowedMsgTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMsgTypes = append(m.DeniedMsgTypes, MsgType{})
+			if err := m.DeniedMsgTypes[len(m.DeniedMsgTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroupAccountStd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroupAccountStd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroupAccountStd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGroupAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGroupAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGroupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = append(m.NewAdmin[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewAdmin == nil {
+				m.NewAdmin = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyStd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyStd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyStd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountDecisionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountComment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountComment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountComment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupAccountCommentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountCommentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupAccountCommentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ThresholdDecisionPolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &StdDecisionPolicy_Threshold{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PercentageDecisionPolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &StdDecisionPolicy_Percentage{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QuorumDecisionPolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &StdDecisionPolicy_Quorum{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinExecutionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinExecutionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinExecutionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, make([]byte, postIndex-iNdEx))
+			copy(m.Proposers[len(m.Proposers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proposal |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, make([]byte, postIndex-iNdEx))
+			copy(m.Voters[len(m.Voters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
+			}
+			m.Choice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Choice |= Choice(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proposal |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &ProposalExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proposal |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipVersion", wireType)
+			}
+			m.MembershipVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MembershipVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
+			if m.Member == nil {
+				m.Member = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupAccountMetadataBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupAccountMetadataBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupAccountMetadataBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= GroupID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExec = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotElectorate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotElectorate = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, MsgType{})
+			if err := m.AllowedMsgTypes[len(m.AllowedMsgTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMsgTypes = append(m.DeniedMsgTypes, MsgType{})
+			if err := m.DeniedMsgTypes[len(m.DeniedMsgTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StdGroupAccountMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StdGroupAccountMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StdGroupAccountMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, make([]byte, postIndex-iNdEx))
+			copy(m.Proposers[len(m.Proposers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+			}
+			m.GroupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccountVersion", wireType)
+			}
+			m.GroupAccountVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupAccountVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalBase_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalBase_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+			}
+			m.ExecutorResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorResult |= ProposalBase_ExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExec = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotElectorate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SnapshotElectorate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.YesCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbstainCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElectorateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectorateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectorateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proposal |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElectorateSnapshotMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectorateSnapshotMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectorateSnapshotMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proposal |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
+			if m.Member == nil {
+				m.Member = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Msg) Unmarshal(dAtA []byte) error {
+func (m *ProposalExecution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4258,17 +15743,17 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Msg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalExecution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Msg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalExecution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateGroup", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var msglen int
+			m.Proposal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4278,32 +15763,16 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Proposal |= ProposalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MsgCreateGroup{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sum = &Msg_CreateGroup{v}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGroupMembers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
 			}
-			var msglen int
+			m.ExecutorResult = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4313,30 +15782,14 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExecutorResult |= ProposalBase_ExecutorResult(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MsgUpdateGroupMembers{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sum = &Msg_UpdateGroupMembers{v}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGroupAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4363,15 +15816,13 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgUpdateGroupAdmin{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Msg_UpdateGroupAdmin{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGroupComment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4398,17 +15849,16 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgUpdateGroupComment{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MsgResults = append(m.MsgResults, MsgExecutionResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Msg_UpdateGroupComment{v}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateGroupAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedMsgIndex", wireType)
 			}
-			var msglen int
+			m.FailedMsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4418,32 +15868,16 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FailedMsgIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &MsgCreateGroupAccountStd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sum = &Msg_CreateGroupAccount{v}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGroupAccountAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4453,67 +15887,82 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgUpdateGroupAccountAdmin{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sum = &Msg_UpdateGroupAccountAdmin{v}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGroupAccountDecisionPolicy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgUpdateGroupAccountDecisionPolicyStd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			m.Sum = &Msg_UpdateGroupAccountDecisionPolicy{v}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGroupAccountComment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4523,32 +15972,31 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgUpdateGroupAccountComment{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.Sum = &Msg_UpdateGroupAccountComment{v}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4558,30 +16006,27 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgVote{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sum = &Msg_Vote{v}
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4608,11 +16053,10 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgExec{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, ExecutionEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Msg_Exec{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4638,7 +16082,7 @@ func (m *Msg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+func (m *ExecutionEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4653,59 +16097,25 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4715,31 +16125,29 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4749,23 +16157,25 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			m.Attributes = append(m.Attributes, ExecutionEventAttribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4791,7 +16201,7 @@ func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupMembers) Unmarshal(dAtA []byte) error {
+func (m *ExecutionEventAttribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4814,17 +16224,17 @@ func (m *MsgUpdateGroupMembers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupMembers: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionEventAttribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionEventAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4834,50 +16244,29 @@ func (m *MsgUpdateGroupMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4887,25 +16276,23 @@ func (m *MsgUpdateGroupMembers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemberUpdates = append(m.MemberUpdates, Member{})
-			if err := m.MemberUpdates[len(m.MemberUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4931,7 +16318,7 @@ func (m *MsgUpdateGroupMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupAdmin) Unmarshal(dAtA []byte) error {
+func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4954,15 +16341,34 @@ func (m *MsgUpdateGroupAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Proposal |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4989,16 +16395,16 @@ func (m *MsgUpdateGroupAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
 			}
-			m.Group = 0
+			m.Choice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5008,16 +16414,16 @@ func (m *MsgUpdateGroupAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
+				m.Choice |= Choice(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5027,24 +16433,55 @@ func (m *MsgUpdateGroupAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = append(m.NewAdmin[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewAdmin == nil {
-				m.NewAdmin = []byte{}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5071,7 +16508,7 @@ func (m *MsgUpdateGroupAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupComment) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5094,17 +16531,17 @@ func (m *MsgUpdateGroupComment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupComment: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupComment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommentLength", wireType)
 			}
-			var byteLen int
+			m.MaxCommentLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5114,31 +16551,54 @@ func (m *MsgUpdateGroupComment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxCommentLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndBlockProposals", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.MaxEndBlockProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEndBlockProposals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoExecGas", wireType)
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.MaxAutoExecGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoExecGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRetention", wireType)
 			}
-			m.Group = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5148,16 +16608,30 @@ func (m *MsgUpdateGroupComment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ProposalRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5167,23 +16641,24 @@ func (m *MsgUpdateGroupComment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxVotingWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5209,7 +16684,7 @@ func (m *MsgUpdateGroupComment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5232,17 +16707,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5252,31 +16727,30 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5286,31 +16760,50 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Groups = append(m.Groups[:0], dAtA[iNdEx:postIndex]...)
+			if m.Groups == nil {
+				m.Groups = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSeq", wireType)
+			}
+			m.GroupSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupMembers", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5320,80 +16813,82 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			m.GroupMembers = append(m.GroupMembers[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupMembers == nil {
+				m.GroupMembers = []byte{}
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccounts", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateGroupAccountBase) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			m.GroupAccounts = append(m.GroupAccounts[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccounts == nil {
+				m.GroupAccounts = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccountSeq", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.GroupAccountSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupAccountSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroupAccountBase: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroupAccountBase: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5420,16 +16915,16 @@ func (m *MsgCreateGroupAccountBase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.Proposals = append(m.Proposals[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposals == nil {
+				m.Proposals = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalSeq", wireType)
 			}
-			m.Group = 0
+			m.ProposalSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5439,16 +16934,16 @@ func (m *MsgCreateGroupAccountBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
+				m.ProposalSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5458,82 +16953,65 @@ func (m *MsgCreateGroupAccountBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			m.Votes = append(m.Votes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Votes == nil {
+				m.Votes = []byte{}
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateSnapshots", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateGroupAccountStd) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ElectorateSnapshots = append(m.ElectorateSnapshots[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateSnapshots == nil {
+				m.ElectorateSnapshots = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateGroupAccountStd: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateGroupAccountStd: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateSnapshotMembers", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5543,30 +17021,31 @@ func (m *MsgCreateGroupAccountStd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ElectorateSnapshotMembers = append(m.ElectorateSnapshotMembers[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateSnapshotMembers == nil {
+				m.ElectorateSnapshotMembers = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExecutions", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5576,23 +17055,24 @@ func (m *MsgCreateGroupAccountStd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposalExecutions = append(m.ProposalExecutions[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalExecutions == nil {
+				m.ProposalExecutions = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5619,7 +17099,7 @@ func (m *MsgCreateGroupAccountStd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
+func (m *PageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5642,17 +17122,17 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: PageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var byteLen int
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5662,31 +17142,16 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Page |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var byteLen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5696,31 +17161,69 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupAccount == nil {
-				m.GroupAccount = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5730,26 +17233,11 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Group |= GroupID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewAdmin = append(m.NewAdmin[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewAdmin == nil {
-				m.NewAdmin = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5774,7 +17262,7 @@ func (m *MsgUpdateGroupAccountAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupAccountBase) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5797,17 +17285,17 @@ func (m *MsgUpdateGroupAccountBase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountBase: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountBase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5817,45 +17305,25 @@ func (m *MsgUpdateGroupAccountBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5880,7 +17348,7 @@ func (m *MsgUpdateGroupAccountBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupAccountDecisionPolicyStd) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5903,17 +17371,17 @@ func (m *MsgUpdateGroupAccountDecisionPolicyStd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyStd: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountDecisionPolicyStd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -5923,28 +17391,14 @@ func (m *MsgUpdateGroupAccountDecisionPolicyStd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Group |= GroupID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5971,7 +17425,10 @@ func (m *MsgUpdateGroupAccountDecisionPolicyStd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5999,7 +17456,7 @@ func (m *MsgUpdateGroupAccountDecisionPolicyStd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateGroupAccountComment) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6022,17 +17479,17 @@ func (m *MsgUpdateGroupAccountComment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountComment: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateGroupAccountComment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6042,29 +17499,82 @@ func (m *MsgUpdateGroupAccountComment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.Members = append(m.Members, GroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupsByAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6091,16 +17601,16 @@ func (m *MsgUpdateGroupAccountComment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupAccount == nil {
-				m.GroupAccount = []byte{}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6110,23 +17620,27 @@ func (m *MsgUpdateGroupAccountComment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6152,7 +17666,7 @@ func (m *MsgUpdateGroupAccountComment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StdDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6175,15 +17689,15 @@ func (m *StdDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StdDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6210,11 +17724,10 @@ func (m *StdDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ThresholdDecisionPolicy{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Groups = append(m.Groups, GroupMetadata{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &StdDecisionPolicy_Threshold{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6240,7 +17753,7 @@ func (m *StdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6263,17 +17776,17 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6283,29 +17796,29 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
+			if m.Member == nil {
+				m.Member = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6332,7 +17845,10 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6360,7 +17876,7 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeBase) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6383,17 +17899,17 @@ func (m *MsgProposeBase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeBase: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeBase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memberships", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6403,63 +17919,84 @@ func (m *MsgProposeBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupAccount == nil {
-				m.GroupAccount = []byte{}
+			m.Memberships = append(m.Memberships, GroupMember{})
+			if err := m.Memberships[len(m.Memberships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, make([]byte, postIndex-iNdEx))
-			copy(m.Proposers[len(m.Proposers)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6469,23 +18006,25 @@ func (m *MsgProposeBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupAccount == nil {
+				m.GroupAccount = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6511,7 +18050,7 @@ func (m *MsgProposeBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6534,36 +18073,17 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
-			}
-			m.Proposal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Proposal |= ProposalID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6573,29 +18093,83 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voters = append(m.Voters, make([]byte, postIndex-iNdEx))
-			copy(m.Voters[len(m.Voters)-1], dAtA[iNdEx:postIndex])
+			if err := m.GroupAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupAccountsByGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupAccountsByGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupAccountsByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.Choice = 0
+			m.Group = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6605,16 +18179,16 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Choice |= Choice(b&0x7F) << shift
+				m.Group |= GroupID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6624,23 +18198,27 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6666,7 +18244,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAccountsByGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6689,36 +18267,17 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAccountsByGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAccountsByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
-			}
-			m.Proposal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Proposal |= ProposalID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccounts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6728,24 +18287,24 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signer == nil {
-				m.Signer = []byte{}
+			m.GroupAccounts = append(m.GroupAccounts, StdGroupAccountMetadata{})
+			if err := m.GroupAccounts[len(m.GroupAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6772,7 +18331,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMetadata) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAccountsByAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6795,32 +18354,13 @@ func (m *GroupMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAccountsByAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAccountsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
@@ -6854,11 +18394,11 @@ func (m *GroupMetadata) Unmarshal(dAtA []byte) error {
 				m.Admin = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -6868,74 +18408,25 @@ func (m *GroupMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
 			}
-			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6963,7 +18454,7 @@ func (m *GroupMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMember) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupAccountsByAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6986,36 +18477,17 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupAccountsByAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupAccountsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccounts", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7025,65 +18497,84 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
-			if m.Member == nil {
-				m.Member = []byte{}
+			m.GroupAccounts = append(m.GroupAccounts, StdGroupAccountMetadata{})
+			if err := m.GroupAccounts[len(m.GroupAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7093,24 +18584,11 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				m.Proposal |= ProposalID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7135,7 +18613,7 @@ func (m *GroupMember) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupAccountMetadataBase) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7158,17 +18636,17 @@ func (m *GroupAccountMetadataBase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupAccountMetadataBase: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupAccountMetadataBase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7178,116 +18656,86 @@ func (m *GroupAccountMetadataBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupAccount = append(m.GroupAccount[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupAccount == nil {
-				m.GroupAccount = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= GroupID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Proposal == nil {
+				m.Proposal = &types.Any{}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			m.Version = 0
+			m.Proposal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7297,7 +18745,7 @@ func (m *GroupAccountMetadataBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				m.Proposal |= ProposalID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7326,7 +18774,7 @@ func (m *GroupAccountMetadataBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StdGroupAccountMetadata) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7349,48 +18797,15 @@ func (m *StdGroupAccountMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StdGroupAccountMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StdGroupAccountMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7417,7 +18832,7 @@ func (m *StdGroupAccountMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DecisionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7445,7 +18860,7 @@ func (m *StdGroupAccountMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposalBase) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByGroupAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7468,10 +18883,10 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalBase: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByGroupAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalBase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByGroupAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7510,9 +18925,9 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7522,59 +18937,84 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByGroupAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, make([]byte, postIndex-iNdEx))
-			copy(m.Proposers[len(m.Proposers)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByGroupAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByGroupAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7601,91 +19041,69 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubmittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, &types.Any{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
-			}
-			m.GroupVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupAccountVersion", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.GroupAccountVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupAccountVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalBase_Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsByProposerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ProposalBase_Result(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsByProposerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsByProposerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7695,28 +19113,29 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7743,29 +19162,13 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
-			}
-			m.ExecutorResult = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutorResult |= ProposalBase_ExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -7790,7 +19193,7 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tally) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByProposerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7813,17 +19216,17 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tally: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByProposerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tally: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7833,31 +19236,84 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.YesCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, &types.Any{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			m.Proposal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7867,31 +19323,16 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Proposal |= ProposalID(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7901,31 +19342,84 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbstainCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -7935,23 +19429,22 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VetoCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7979,7 +19472,7 @@ func (m *Tally) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8002,10 +19495,10 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8029,9 +19522,9 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -8041,80 +19534,84 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
-			}
-			m.Choice = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Choice |= Choice(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comment = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8141,7 +19638,8 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SubmittedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8169,7 +19667,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8192,17 +19690,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			m.MaxCommentLength = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -8212,11 +19710,62 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxCommentLength |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -8241,7 +19790,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8264,15 +19813,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8299,7 +19848,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -59,7 +59,7 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcVotingDuration: time.Millisecond,
 			expResult:         DecisionPolicyResult{Allow: false, Final: true},
 		},
-		"expired when on timeout": {
+		"accept when threshold reached on timeout": {
 			srcPolicy: ThresholdDecisionPolicy{
 				Threshold: sdk.OneDec(),
 				Timout:    proto.Duration{Seconds: 1},
@@ -67,14 +67,24 @@ func TestThresholdDecisionPolicy(t *testing.T) {
 			srcTally:          Tally{YesCount: sdk.NewDec(2)},
 			srcTotalPower:     sdk.NewDec(3),
 			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"expired when on timeout": {
+			srcPolicy: ThresholdDecisionPolicy{
+				Threshold: sdk.NewDec(2),
+				Timout:    proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.OneDec(), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			srcTotalPower:     sdk.NewDec(3),
+			srcVotingDuration: time.Second,
 			expResult:         DecisionPolicyResult{Allow: false, Final: true},
 		},
 		"expired when after timeout": {
 			srcPolicy: ThresholdDecisionPolicy{
-				Threshold: sdk.OneDec(),
+				Threshold: sdk.NewDec(2),
 				Timout:    proto.Duration{Seconds: 1},
 			},
-			srcTally:          Tally{YesCount: sdk.NewDec(2)},
+			srcTally:          Tally{YesCount: sdk.OneDec(), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
 			srcTotalPower:     sdk.NewDec(3),
 			srcVotingDuration: time.Second + time.Nanosecond,
 			expResult:         DecisionPolicyResult{Allow: false, Final: true},
@@ -162,6 +172,342 @@ func TestThresholdDecisionPolicyValidation(t *testing.T) {
 		},
 			expErr: true,
 		},
+		"min execution period lower than timeout": {src: ThresholdDecisionPolicy{
+			Threshold:          sdk.OneDec(),
+			Timout:             proto.Duration{Seconds: 2},
+			MinExecutionPeriod: proto.Duration{Seconds: 1},
+		}},
+		"min execution period equal to timeout": {src: ThresholdDecisionPolicy{
+			Threshold:          sdk.OneDec(),
+			Timout:             proto.Duration{Seconds: 1},
+			MinExecutionPeriod: proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no negative min execution period": {src: ThresholdDecisionPolicy{
+			Threshold:          sdk.OneDec(),
+			Timout:             proto.Duration{Seconds: 1},
+			MinExecutionPeriod: proto.Duration{Seconds: -1},
+		},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			assert.Equal(t, spec.expErr, err != nil, err)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicy(t *testing.T) {
+	specs := map[string]struct {
+		srcPolicy         PercentageDecisionPolicy
+		srcTally          Tally
+		srcTotalPower     sdk.Dec
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+		expErr            error
+	}{
+		"accept when yes count greater than percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.NewDec(2)},
+			srcTotalPower:     sdk.NewDec(3),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"accept when yes count equal to percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.NewDec(2), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			srcTotalPower:     sdk.NewDec(4),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"not final when yes count lower than percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.OneDec(), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			srcTotalPower:     sdk.NewDec(3),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final when remaining votes can't reach percentage": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.ZeroDec(), NoCount: sdk.NewDec(2), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			srcTotalPower:     sdk.NewDec(3),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"relative to total power": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.NewDec(2), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			srcTotalPower:     sdk.NewDec(5),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject as final without total power": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.ZeroDec(), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			srcTotalPower:     sdk.ZeroDec(),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"accept when percentage reached on timeout": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.NewDec(2)},
+			srcTotalPower:     sdk.NewDec(3),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"expired when on timeout": {
+			srcPolicy: PercentageDecisionPolicy{
+				Percentage: sdk.NewDecWithPrec(5, 1),
+				Timout:     proto.Duration{Seconds: 1},
+			},
+			srcTally:          Tally{YesCount: sdk.OneDec(), NoCount: sdk.ZeroDec(), AbstainCount: sdk.ZeroDec(), VetoCount: sdk.ZeroDec()},
+			srcTotalPower:     sdk.NewDec(3),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := spec.srcPolicy.Allow(spec.srcTally, spec.srcTotalPower, spec.srcVotingDuration)
+			if spec.expErr != nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicyValidation(t *testing.T) {
+	specs := map[string]struct {
+		src    PercentageDecisionPolicy
+		expErr bool
+	}{
+		"all good": {src: PercentageDecisionPolicy{
+			Percentage: sdk.NewDecWithPrec(5, 1),
+			Timout:     proto.Duration{Seconds: 1},
+		}},
+		"one hundred percent": {src: PercentageDecisionPolicy{
+			Percentage: sdk.OneDec(),
+			Timout:     proto.Duration{Seconds: 1},
+		}},
+		"percentage missing": {src: PercentageDecisionPolicy{
+			Timout: proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no zero percentage": {src: PercentageDecisionPolicy{
+			Percentage: sdk.ZeroDec(),
+			Timout:     proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no negative percentage": {src: PercentageDecisionPolicy{
+			Percentage: sdk.NewDec(-1),
+			Timout:     proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no percentage greater than one": {src: PercentageDecisionPolicy{
+			Percentage: sdk.NewDecWithPrec(11, 1),
+			Timout:     proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"timeout missing": {src: PercentageDecisionPolicy{
+			Percentage: sdk.NewDecWithPrec(5, 1),
+		},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			assert.Equal(t, spec.expErr, err != nil, err)
+		})
+	}
+}
+
+func TestQuorumDecisionPolicy(t *testing.T) {
+	policy := QuorumDecisionPolicy{
+		Quorum:        sdk.NewDecWithPrec(4, 1),
+		Threshold:     sdk.NewDecWithPrec(5, 1),
+		VetoThreshold: sdk.NewDecWithPrec(334, 3),
+		Timout:        proto.Duration{Seconds: 1},
+	}
+	tally := func(yes, no, abstain, veto int64) Tally {
+		return Tally{YesCount: sdk.NewDec(yes), NoCount: sdk.NewDec(no), AbstainCount: sdk.NewDec(abstain), VetoCount: sdk.NewDec(veto)}
+	}
+	specs := map[string]struct {
+		srcTally          Tally
+		srcTotalPower     sdk.Dec
+		srcVotingDuration time.Duration
+		expResult         DecisionPolicyResult
+	}{
+		"accept early when undecided votes can not change result": {
+			srcTally:          tally(7, 0, 0, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"accept early with abstain excluded from threshold": {
+			srcTally:          tally(4, 0, 4, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"not final when undecided votes can veto": {
+			srcTally:          tally(4, 0, 0, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: false},
+		},
+		"reject early when veto threshold exceeded": {
+			srcTally:          tally(0, 0, 0, 4),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject early when threshold can not be exceeded": {
+			srcTally:          tally(0, 5, 0, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"accept on timeout": {
+			srcTally:          tally(3, 1, 0, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: true, Final: true},
+		},
+		"reject on timeout without quorum": {
+			srcTally:          tally(3, 0, 0, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject on timeout with veto threshold exceeded": {
+			srcTally:          tally(3, 0, 0, 2),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject on timeout when threshold not exceeded": {
+			srcTally:          tally(2, 2, 0, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject on timeout with abstain only": {
+			srcTally:          tally(0, 0, 5, 0),
+			srcTotalPower:     sdk.NewDec(10),
+			srcVotingDuration: time.Second,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+		"reject without total power": {
+			srcTally:          tally(0, 0, 0, 0),
+			srcTotalPower:     sdk.ZeroDec(),
+			srcVotingDuration: time.Millisecond,
+			expResult:         DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			res, err := policy.Allow(spec.srcTally, spec.srcTotalPower, spec.srcVotingDuration)
+			require.NoError(t, err)
+			assert.Equal(t, spec.expResult, res)
+		})
+	}
+}
+
+func TestQuorumDecisionPolicyValidation(t *testing.T) {
+	specs := map[string]struct {
+		src    QuorumDecisionPolicy
+		expErr bool
+	}{
+		"all good": {src: QuorumDecisionPolicy{
+			Quorum:        sdk.NewDecWithPrec(4, 1),
+			Threshold:     sdk.NewDecWithPrec(5, 1),
+			VetoThreshold: sdk.NewDecWithPrec(334, 3),
+			Timout:        proto.Duration{Seconds: 1},
+		}},
+		"quorum missing": {src: QuorumDecisionPolicy{
+			Threshold:     sdk.NewDecWithPrec(5, 1),
+			VetoThreshold: sdk.NewDecWithPrec(334, 3),
+			Timout:        proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"quorum greater than one": {src: QuorumDecisionPolicy{
+			Quorum:        sdk.NewDecWithPrec(11, 1),
+			Threshold:     sdk.NewDecWithPrec(5, 1),
+			VetoThreshold: sdk.NewDecWithPrec(334, 3),
+			Timout:        proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"threshold missing": {src: QuorumDecisionPolicy{
+			Quorum:        sdk.NewDecWithPrec(4, 1),
+			VetoThreshold: sdk.NewDecWithPrec(334, 3),
+			Timout:        proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"threshold of one can never pass": {src: QuorumDecisionPolicy{
+			Quorum:        sdk.NewDecWithPrec(4, 1),
+			Threshold:     sdk.OneDec(),
+			VetoThreshold: sdk.NewDecWithPrec(334, 3),
+			Timout:        proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"veto threshold missing": {src: QuorumDecisionPolicy{
+			Quorum:    sdk.NewDecWithPrec(4, 1),
+			Threshold: sdk.NewDecWithPrec(5, 1),
+			Timout:    proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"no zero veto threshold": {src: QuorumDecisionPolicy{
+			Quorum:        sdk.NewDecWithPrec(4, 1),
+			Threshold:     sdk.NewDecWithPrec(5, 1),
+			VetoThreshold: sdk.ZeroDec(),
+			Timout:        proto.Duration{Seconds: 1},
+		},
+			expErr: true,
+		},
+		"timeout missing": {src: QuorumDecisionPolicy{
+			Quorum:        sdk.NewDecWithPrec(4, 1),
+			Threshold:     sdk.NewDecWithPrec(5, 1),
+			VetoThreshold: sdk.NewDecWithPrec(334, 3),
+		},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -336,6 +682,28 @@ func TestGroupAccountMetadataBase(t *testing.T) {
 				Version:      1,
 			},
 		},
+		"all good with msg types": {
+			src: GroupAccountMetadataBase{
+				Group:           1,
+				GroupAccount:    []byte("valid--group-address"),
+				Admin:           []byte("valid--admin-address"),
+				Comment:         "any",
+				Version:         1,
+				AllowedMsgTypes: []MsgType{{Route: "bank", Type: "send"}},
+				DeniedMsgTypes:  []MsgType{{Route: "staking"}},
+			},
+		},
+		"invalid msg type": {
+			src: GroupAccountMetadataBase{
+				Group:          1,
+				GroupAccount:   []byte("valid--group-address"),
+				Admin:          []byte("valid--admin-address"),
+				Comment:        "any",
+				Version:        1,
+				DeniedMsgTypes: []MsgType{{Type: "send"}},
+			},
+			expErr: true,
+		},
 		"invalid group": {
 			src: GroupAccountMetadataBase{
 				Group:        0,
@@ -44,3 +44,29 @@ func (a AccAddresses) ValidateBasic() error {
 	}
 	return nil
 }
+
+type MsgTypes []MsgType
+
+func (ts MsgTypes) ValidateBasic() error {
+	index := make(map[MsgType]struct{}, len(ts))
+	for i := range ts {
+		if err := ts[i].ValidateBasic(); err != nil {
+			return err
+		}
+		if _, exists := index[ts[i]]; exists {
+			return errors.Wrapf(ErrDuplicate, "msg type: %s/%s", ts[i].Route, ts[i].Type)
+		}
+		index[ts[i]] = struct{}{}
+	}
+	return nil
+}
+
+// Match returns true when any of the types matches the msg.
+func (ts MsgTypes) Match(msg sdk.Msg) bool {
+	for i := range ts {
+		if ts[i].Match(msg) {
+			return true
+		}
+	}
+	return false
+}
@@ -42,10 +42,14 @@ type AutoUInt64Table struct {
 }
 
 // Create a new persistent object with an auto generated uint64 primary key. They key is returned.
-// Create iterates though the registered callbacks and may add secondary index keys by them.
+// Create iterates though the registered callbacks and may add secondary index keys by them. The sequence is not
+// incremented when the create fails.
 func (a AutoUInt64Table) Create(ctx HasKVStore, obj Persistent) (uint64, error) {
-	autoIncID := a.seq.NextVal(ctx)
-	err := a.table.Create(ctx, EncodeSequence(autoIncID), obj)
+	var autoIncID uint64
+	err := atomic(ctx, func(ctx HasKVStore) error {
+		autoIncID = a.seq.NextVal(ctx)
+		return a.table.Create(ctx, EncodeSequence(autoIncID), obj)
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -118,6 +122,13 @@ func (a AutoUInt64Table) ReversePrefixScan(ctx HasKVStore, start uint64, end uin
 	return a.table.ReversePrefixScan(ctx, EncodeSequence(start), EncodeSequence(end))
 }
 
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. The scan is continued at the key of the page request which must be within the domain.
+// See `Paginate` for details.
+func (a AutoUInt64Table) PaginatedPrefixScan(ctx HasKVStore, start, end uint64, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	return a.table.PaginatedPrefixScan(ctx, EncodeSequence(start), EncodeSequence(end), pageRequest, dest)
+}
+
 // Sequence returns the sequence used by this table
 func (a AutoUInt64Table) Sequence() Sequence {
 	return a.seq
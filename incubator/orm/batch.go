@@ -0,0 +1,67 @@
+package orm
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ HasKVStore = &Batch{}
+
+// Batch stages all writes to the KVStores of the parent context in cache wrapped stores. Nothing is written to the
+// parent before Commit is called so that multiple orm operations can be applied all or nothing. Reads through the
+// batch include the staged writes.
+//
+// Example:
+//			batch := NewBatch(ctx)
+//			if err := myTable.Create(batch, rowID, obj); err != nil {
+//				return err
+//			}
+//			if err := otherTable.Delete(batch, otherRowID); err != nil {
+//				return err
+//			}
+//			batch.Commit()
+//
+// A Batch is not safe for concurrent use.
+type Batch struct {
+	parent HasKVStore
+	keys   []sdk.StoreKey
+	stores map[sdk.StoreKey]sdk.CacheKVStore
+}
+
+// NewBatch creates a new Batch on top of the given context.
+func NewBatch(parent HasKVStore) *Batch {
+	return &Batch{
+		parent: parent,
+		stores: make(map[sdk.StoreKey]sdk.CacheKVStore),
+	}
+}
+
+// KVStore returns the cache wrapped store for the key. It satisfies the HasKVStore interface so that the batch can
+// be passed as context to any orm operation.
+func (b *Batch) KVStore(key sdk.StoreKey) sdk.KVStore {
+	if s, ok := b.stores[key]; ok {
+		return s
+	}
+	s := cachekv.NewStore(b.parent.KVStore(key))
+	b.keys = append(b.keys, key)
+	b.stores[key] = s
+	return s
+}
+
+// Commit writes the staged changes to the parent stores in the order the stores were first accessed. The batch can
+// be used further afterwards.
+func (b *Batch) Commit() {
+	for _, k := range b.keys {
+		b.stores[k].Write()
+	}
+}
+
+// atomic runs the operation on a new Batch and commits it only when no error was returned.
+func atomic(ctx HasKVStore, operation func(ctx HasKVStore) error) error {
+	batch := NewBatch(ctx)
+	if err := operation(batch); err != nil {
+		return err
+	}
+	batch.Commit()
+	return nil
+}
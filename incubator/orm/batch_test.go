@@ -0,0 +1,138 @@
+package orm
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtomicOperations(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	const anyPrefix = 0x10
+	tableBuilder := NewNaturalKeyTableBuilder(anyPrefix, storeKey, &testdata.GroupMember{}, Max255DynamicLengthIndexKeyCodec{})
+	// the first index succeeds before the second one fails
+	groupIndex := NewIndex(tableBuilder, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]RowID, error) {
+		return []RowID{RowID(val.(*testdata.GroupMember).Group)}, nil
+	})
+	NewUniqueIndex(tableBuilder, GroupMemberByMemberIndexPrefix, func(val interface{}) (RowID, error) {
+		return EncodeSequence(val.(*testdata.GroupMember).Weight), nil
+	})
+	var deleteErr error
+	tableBuilder.AddAfterDeleteInterceptor(func(ctx HasKVStore, rowID RowID, value Persistent) error {
+		return deleteErr
+	})
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+	members := createGroupMembers(t, ctx, myTable, 1, 2)
+	before := storeSnapshot(ctx, storeKey)
+
+	// create fails on the unique index
+	m := testdata.GroupMember{Group: sdk.AccAddress(EncodeSequence(3)), Member: sdk.AccAddress("other-member"), Weight: 1}
+	err := myTable.Create(ctx, &m)
+	require.True(t, ErrUniqueConstraint.Is(err))
+	assert.False(t, myTable.Has(ctx, m.NaturalKey()))
+	assert.False(t, groupIndex.Has(ctx, m.Group))
+	assert.Equal(t, before, storeSnapshot(ctx, storeKey))
+
+	// save fails on the unique index
+	update := members[1]
+	update.Weight = 1
+	err = myTable.Save(ctx, &update)
+	require.True(t, ErrUniqueConstraint.Is(err))
+	var loaded testdata.GroupMember
+	require.NoError(t, myTable.GetOne(ctx, members[1].NaturalKey(), &loaded))
+	assert.Equal(t, members[1], loaded)
+	assert.Equal(t, before, storeSnapshot(ctx, storeKey))
+
+	// delete fails on the last interceptor
+	deleteErr = errors.New("test")
+	err = myTable.Delete(ctx, &members[0])
+	require.Error(t, err)
+	assert.True(t, myTable.Has(ctx, members[0].NaturalKey()))
+	assert.True(t, groupIndex.Has(ctx, members[0].Group))
+	assert.Equal(t, before, storeSnapshot(ctx, storeKey))
+
+	require.NoError(t, groupIndex.Verify(ctx, myTable))
+}
+
+func TestAtomicAutoUInt64Create(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	const anyPrefix = 0x10
+	tableBuilder := NewAutoUInt64TableBuilder(anyPrefix, 0x1, storeKey, &testdata.GroupMetadata{})
+	NewUniqueIndex(tableBuilder, 0x2, func(val interface{}) (RowID, error) {
+		return []byte(val.(*testdata.GroupMetadata).Admin), nil
+	})
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+	g := testdata.GroupMetadata{Description: "my group", Admin: sdk.AccAddress("admin-address")}
+	id, err := myTable.Create(ctx, &g)
+	require.NoError(t, err)
+	before := storeSnapshot(ctx, storeKey)
+
+	_, err = myTable.Create(ctx, &g)
+	require.True(t, ErrUniqueConstraint.Is(err))
+	assert.Equal(t, id, myTable.Sequence().CurVal(ctx))
+	assert.Equal(t, before, storeSnapshot(ctx, storeKey))
+}
+
+func TestBatch(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	const anyPrefix = 0x10
+	tableBuilder := NewNaturalKeyTableBuilder(anyPrefix, storeKey, &testdata.GroupMember{}, Max255DynamicLengthIndexKeyCodec{})
+	groupIndex := NewIndex(tableBuilder, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]RowID, error) {
+		return []RowID{RowID(val.(*testdata.GroupMember).Group)}, nil
+	})
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+	existing := createGroupMembers(t, ctx, myTable, 1)[0]
+	before := storeSnapshot(ctx, storeKey)
+
+	m := testdata.GroupMember{Group: sdk.AccAddress(EncodeSequence(2)), Member: sdk.AccAddress("other-member"), Weight: 2}
+
+	// staged writes are visible in the batch only
+	batch := NewBatch(ctx)
+	require.NoError(t, myTable.Create(batch, &m))
+	require.NoError(t, myTable.Delete(batch, &existing))
+	assert.True(t, myTable.Has(batch, m.NaturalKey()))
+	assert.False(t, myTable.Has(batch, existing.NaturalKey()))
+	assert.True(t, groupIndex.Has(batch, m.Group))
+	assert.False(t, myTable.Has(ctx, m.NaturalKey()))
+	assert.True(t, myTable.Has(ctx, existing.NaturalKey()))
+	assert.Equal(t, before, storeSnapshot(ctx, storeKey))
+
+	// a failed operation does not discard the other staged writes
+	err := myTable.Create(batch, &m)
+	require.True(t, ErrUniqueConstraint.Is(err))
+	assert.True(t, myTable.Has(batch, m.NaturalKey()))
+
+	// commit writes all to the parent
+	batch.Commit()
+	assert.True(t, myTable.Has(ctx, m.NaturalKey()))
+	assert.False(t, myTable.Has(ctx, existing.NaturalKey()))
+	assert.True(t, groupIndex.Has(ctx, m.Group))
+	assert.False(t, groupIndex.Has(ctx, existing.Group))
+	require.NoError(t, groupIndex.Verify(ctx, myTable))
+
+	// discarded batch
+	batch = NewBatch(ctx)
+	require.NoError(t, myTable.Delete(batch, &m))
+	assert.True(t, myTable.Has(ctx, m.NaturalKey()))
+}
+
+// storeSnapshot returns all key value pairs of the store.
+func storeSnapshot(ctx HasKVStore, storeKey sdk.StoreKey) map[string]string {
+	res := make(map[string]string)
+	it := ctx.KVStore(storeKey).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		res[string(it.Key())] = string(it.Value())
+	}
+	return res
+}
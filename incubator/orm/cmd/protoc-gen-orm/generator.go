@@ -0,0 +1,679 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/gogoproto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+)
+
+const (
+	sdkImportPath    = "github.com/cosmos/cosmos-sdk/types"
+	errorsImportPath = "github.com/cosmos/cosmos-sdk/types/errors"
+	ormImportPath    = "github.com/cosmos/modules/incubator/orm"
+)
+
+// generate returns the typed tables for all files to generate or the first error.
+func generate(req *plugin.CodeGeneratorRequest) *plugin.CodeGeneratorResponse {
+	var resp plugin.CodeGeneratorResponse
+	files := make(map[string]*descriptor.FileDescriptorProto, len(req.ProtoFile))
+	messages := make(map[string]*descriptor.DescriptorProto)
+	for _, f := range req.ProtoFile {
+		files[f.GetName()] = f
+		registerMessages(messages, "."+f.GetPackage(), f.MessageType)
+	}
+	for _, name := range req.FileToGenerate {
+		f, ok := files[name]
+		if !ok {
+			return errorResponse(fmt.Errorf("file %s not in request", name))
+		}
+		content, err := generateFile(f, messages)
+		if err != nil {
+			return errorResponse(fmt.Errorf("%s: %s", name, err))
+		}
+		if content == nil {
+			continue
+		}
+		resp.File = append(resp.File, &plugin.CodeGeneratorResponse_File{
+			Name:    proto.String(strings.TrimSuffix(name, ".proto") + ".orm.go"),
+			Content: proto.String(string(content)),
+		})
+	}
+	return &resp
+}
+
+func errorResponse(err error) *plugin.CodeGeneratorResponse {
+	return &plugin.CodeGeneratorResponse{Error: proto.String(err.Error())}
+}
+
+// registerMessages adds the messages and their nested types by fully qualified name.
+func registerMessages(dest map[string]*descriptor.DescriptorProto, prefix string, msgs []*descriptor.DescriptorProto) {
+	for _, m := range msgs {
+		name := prefix + "." + m.GetName()
+		dest[name] = m
+		registerMessages(dest, name, m.NestedType)
+	}
+}
+
+// tableDescriptor returns the table option of the message or nil when not set.
+func tableDescriptor(msg *descriptor.DescriptorProto) (*orm.TableDescriptor, error) {
+	if msg.Options == nil || !proto.HasExtension(msg.Options, orm.E_Table) {
+		return nil, nil
+	}
+	ext, err := proto.GetExtension(msg.Options, orm.E_Table)
+	if err != nil {
+		return nil, err
+	}
+	return ext.(*orm.TableDescriptor), nil
+}
+
+// generateFile returns the formatted source for the tables of the file or nil when the file has no table options.
+func generateFile(f *descriptor.FileDescriptorProto, messages map[string]*descriptor.DescriptorProto) ([]byte, error) {
+	g := fileGen{
+		Source:     f.GetName(),
+		Package:    goPackageName(f),
+		importPath: goImportPath(f),
+		imports:    map[string]string{sdkImportPath: "sdk", errorsImportPath: "errors", ormImportPath: "orm"},
+		messages:   messages,
+	}
+	for _, msg := range f.MessageType {
+		for _, nested := range msg.NestedType {
+			if d, err := tableDescriptor(nested); err != nil || d != nil {
+				return nil, fmt.Errorf("table option on nested message %s.%s not supported", msg.GetName(), nested.GetName())
+			}
+		}
+		d, err := tableDescriptor(msg)
+		if err != nil {
+			return nil, err
+		}
+		if d == nil {
+			continue
+		}
+		t, err := g.newTable(msg, d)
+		if err != nil {
+			return nil, fmt.Errorf("message %s: %s", msg.GetName(), err)
+		}
+		g.Tables = append(g.Tables, t)
+	}
+	if len(g.Tables) == 0 {
+		return nil, nil
+	}
+	for p, alias := range g.imports {
+		g.Imports = append(g.Imports, goImport{Alias: alias, Path: p})
+	}
+	sort.Slice(g.Imports, func(i, j int) bool { return g.Imports[i].Path < g.Imports[j].Path })
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, g); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// goImportPath returns the import path from the go_package option without a package name suffix.
+func goImportPath(f *descriptor.FileDescriptorProto) string {
+	p := f.GetOptions().GetGoPackage()
+	if i := strings.Index(p, ";"); i >= 0 {
+		return p[:i]
+	}
+	return p
+}
+
+// goPackageName returns the package name from the go_package option or the proto package.
+func goPackageName(f *descriptor.FileDescriptorProto) string {
+	p := f.GetOptions().GetGoPackage()
+	if i := strings.Index(p, ";"); i >= 0 {
+		return p[i+1:]
+	}
+	if p != "" {
+		return path.Base(p)
+	}
+	return strings.Replace(f.GetPackage(), ".", "_", -1)
+}
+
+type goImport struct {
+	Alias, Path string
+}
+
+type fileGen struct {
+	Source  string
+	Package string
+	Imports []goImport
+	Tables  []table
+
+	importPath string
+	imports    map[string]string
+	messages   map[string]*descriptor.DescriptorProto
+}
+
+type table struct {
+	Name        string
+	Model       string
+	Prefix      string
+	AutoUInt64  bool
+	SeqPrefix   string
+	PrimaryKey  []field
+	Indexes     []index
+	keyCapacity string
+}
+
+// KeyCapacity returns the expression for the length of the natural key.
+func (t table) KeyCapacity() string {
+	return t.keyCapacity
+}
+
+type index struct {
+	Name   string
+	Prefix string
+	Field  field
+	Unique bool
+}
+
+// UInt64 is set when the index is an orm.UInt64Index.
+func (i index) UInt64() bool {
+	return i.Field.Kind == descriptor.FieldDescriptorProto_TYPE_UINT64 && !i.Unique
+}
+
+// OrmType returns the orm index type.
+func (i index) OrmType() string {
+	switch {
+	case i.UInt64():
+		return "orm.UInt64Index"
+	case i.Unique:
+		return "orm.UniqueIndex"
+	default:
+		return "orm.MultiKeyIndex"
+	}
+}
+
+// FieldName returns the unexported struct field name of the index.
+func (i index) FieldName() string {
+	return strings.ToLower(i.Name[:1]) + i.Name[1:] + "Index"
+}
+
+// KeyExpr returns the expression that converts the given Go value into the index key.
+func (i index) KeyExpr(v string) string {
+	if i.UInt64() {
+		return "uint64(" + v + ")"
+	}
+	return i.Field.BytesExpr(v)
+}
+
+type field struct {
+	// Accessor is the selector of the field on the message, for example "Base.Admin".
+	Accessor string
+	// GoType is the Go type of the field or of the elements for repeated fields.
+	GoType   string
+	Kind     descriptor.FieldDescriptorProto_Type
+	Repeated bool
+}
+
+// BytesExpr returns the expression that converts the given Go value of the field into bytes.
+func (f field) BytesExpr(v string) string {
+	if f.Kind == descriptor.FieldDescriptorProto_TYPE_UINT64 {
+		return "orm.EncodeSequence(uint64(" + v + "))"
+	}
+	return "[]byte(" + v + ")"
+}
+
+// FixedLength is set for fields with a fixed length encoding.
+func (f field) FixedLength() bool {
+	return f.Kind == descriptor.FieldDescriptorProto_TYPE_UINT64
+}
+
+func (g *fileGen) newTable(msg *descriptor.DescriptorProto, d *orm.TableDescriptor) (table, error) {
+	t := table{
+		Name:       d.Name,
+		Model:      generator.CamelCase(msg.GetName()),
+		Prefix:     prefixLiteral(d.Prefix),
+		AutoUInt64: d.AutoUint64,
+	}
+	if t.Name == "" {
+		t.Name = t.Model
+	}
+	if d.Prefix > 0xff {
+		return t, fmt.Errorf("prefix %d exceeds a byte", d.Prefix)
+	}
+	if d.AutoUint64 {
+		if d.SequencePrefix > 0xff {
+			return t, fmt.Errorf("sequence prefix %d exceeds a byte", d.SequencePrefix)
+		}
+		if d.SequencePrefix == d.Prefix {
+			return t, fmt.Errorf("sequence prefix must differ from table prefix")
+		}
+		if len(d.PrimaryKey) != 0 {
+			return t, fmt.Errorf("primary key not supported with auto uint64 table")
+		}
+		t.SeqPrefix = prefixLiteral(d.SequencePrefix)
+	}
+	var capacity []string
+	var fixed int
+	for i, name := range d.PrimaryKey {
+		f, err := g.resolveField(msg, name)
+		if err != nil {
+			return t, fmt.Errorf("primary key: %s", err)
+		}
+		switch {
+		case f.Repeated:
+			return t, fmt.Errorf("primary key: repeated field %s not supported", name)
+		case f.FixedLength():
+			fixed += orm.EncodedSeqLength
+		case i != len(d.PrimaryKey)-1:
+			return t, fmt.Errorf("primary key: variable length field %s must be last", name)
+		default:
+			capacity = append(capacity, "len(m."+f.Accessor+")")
+		}
+		t.PrimaryKey = append(t.PrimaryKey, f)
+	}
+	t.keyCapacity = strings.Join(append([]string{strconv.Itoa(fixed)}, capacity...), "+")
+
+	prefixes := map[uint32]bool{d.Prefix: true}
+	if d.AutoUint64 {
+		prefixes[d.SequencePrefix] = true
+	}
+	names := make(map[string]bool)
+	for _, idx := range d.Index {
+		switch {
+		case idx.Name == "":
+			return t, fmt.Errorf("index name must not be empty")
+		case names[idx.Name]:
+			return t, fmt.Errorf("duplicate index name %s", idx.Name)
+		case idx.Prefix > 0xff:
+			return t, fmt.Errorf("index %s: prefix %d exceeds a byte", idx.Name, idx.Prefix)
+		case prefixes[idx.Prefix]:
+			return t, fmt.Errorf("index %s: duplicate prefix %d", idx.Name, idx.Prefix)
+		}
+		names[idx.Name], prefixes[idx.Prefix] = true, true
+		f, err := g.resolveField(msg, idx.Field)
+		if err != nil {
+			return t, fmt.Errorf("index %s: %s", idx.Name, err)
+		}
+		if idx.Unique && f.Repeated {
+			return t, fmt.Errorf("index %s: unique index on repeated field not supported", idx.Name)
+		}
+		t.Indexes = append(t.Indexes, index{
+			Name:   generator.CamelCase(idx.Name),
+			Prefix: prefixLiteral(idx.Prefix),
+			Field:  f,
+			Unique: idx.Unique,
+		})
+	}
+	return t, nil
+}
+
+// resolveField returns the field for the given dot separated path of proto field names. All but the last element
+// must be non nullable message fields.
+func (g *fileGen) resolveField(msg *descriptor.DescriptorProto, fieldPath string) (field, error) {
+	if fieldPath == "" {
+		return field{}, fmt.Errorf("field must not be empty")
+	}
+	elems := strings.Split(fieldPath, ".")
+	var accessors []string
+	for i, name := range elems {
+		fd := findField(msg, name)
+		if fd == nil {
+			return field{}, fmt.Errorf("unknown field %s in %s", name, fieldPath)
+		}
+		if gogoproto.IsEmbed(fd) {
+			return field{}, fmt.Errorf("embedded field %s not supported", name)
+		}
+		goName := generator.CamelCase(fd.GetName())
+		if n := gogoproto.GetCustomName(fd); n != "" {
+			goName = n
+		}
+		accessors = append(accessors, goName)
+		if i == len(elems)-1 {
+			return g.leafField(fd, strings.Join(accessors, "."))
+		}
+		if fd.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE || fd.IsRepeated() || gogoproto.IsNullable(fd) {
+			return field{}, fmt.Errorf("field %s must be a non nullable message", name)
+		}
+		if msg = g.messages[fd.GetTypeName()]; msg == nil {
+			return field{}, fmt.Errorf("unknown message type %s", fd.GetTypeName())
+		}
+	}
+	panic("unreachable")
+}
+
+func findField(msg *descriptor.DescriptorProto, name string) *descriptor.FieldDescriptorProto {
+	for _, fd := range msg.Field {
+		if fd.GetName() == name {
+			return fd
+		}
+	}
+	return nil
+}
+
+func (g *fileGen) leafField(fd *descriptor.FieldDescriptorProto, accessor string) (field, error) {
+	f := field{Accessor: accessor, Kind: fd.GetType(), Repeated: fd.IsRepeated()}
+	if gogoproto.IsCustomType(fd) {
+		return f, fmt.Errorf("custom type of field %s not supported", fd.GetName())
+	}
+	switch f.Kind {
+	case descriptor.FieldDescriptorProto_TYPE_UINT64:
+		f.GoType = "uint64"
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		f.GoType = "[]byte"
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		f.GoType = "string"
+	default:
+		return f, fmt.Errorf("type %s of field %s not supported", f.Kind, fd.GetName())
+	}
+	if gogoproto.IsCastType(fd) {
+		f.GoType = g.qualifiedType(gogoproto.GetCastType(fd))
+	}
+	return f, nil
+}
+
+// qualifiedType returns the type name with the package alias and registers the import.
+func (g *fileGen) qualifiedType(name string) string {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return name
+	}
+	importPath, typeName := name[:i], name[i+1:]
+	if importPath == g.importPath {
+		return typeName
+	}
+	alias, ok := g.imports[importPath]
+	if !ok {
+		alias = strings.Replace(path.Base(importPath), "-", "_", -1)
+		for n := 1; g.hasAlias(alias); n++ {
+			alias = fmt.Sprintf("%s%d", path.Base(importPath), n)
+		}
+		g.imports[importPath] = alias
+	}
+	return alias + "." + typeName
+}
+
+func (g *fileGen) hasAlias(alias string) bool {
+	for _, a := range g.imports {
+		if a == alias {
+			return true
+		}
+	}
+	return false
+}
+
+func prefixLiteral(p uint32) string {
+	return fmt.Sprintf("0x%x", p)
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by protoc-gen-orm. DO NOT EDIT.
+// source: {{.Source}}
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{.Alias}} "{{.Path}}"
+{{- end}}
+)
+
+{{range .Tables}}{{$t := .}}
+const (
+	{{.Name}}TablePrefix byte = {{.Prefix}}
+{{- if .AutoUInt64}}
+	{{.Name}}TableSeqPrefix byte = {{.SeqPrefix}}
+{{- end}}
+{{- range .Indexes}}
+	{{$t.Name}}{{.Name}}IndexPrefix byte = {{.Prefix}}
+{{- end}}
+)
+{{if .PrimaryKey}}
+// NaturalKey returns the natural key of the {{.Model}} in the {{.Name}} table.
+func (m {{.Model}}) NaturalKey() []byte {
+	result := make([]byte, 0, {{.KeyCapacity}})
+{{- range .PrimaryKey}}
+	result = append(result, {{.BytesExpr (print "m." .Accessor)}}...)
+{{- end}}
+	return result
+}
+{{end}}
+{{- if .AutoUInt64}}
+// {{.Name}}Table is a typed orm.AutoUInt64Table of {{.Model}} objects.
+type {{.Name}}Table struct {
+	table orm.AutoUInt64Table
+{{- range .Indexes}}
+	{{.FieldName}} {{.OrmType}}
+{{- end}}
+}
+
+// New{{.Name}}Table creates the {{.Name}} table with its indexes.
+func New{{.Name}}Table(storeKey sdk.StoreKey) {{.Name}}Table {
+	builder := orm.NewAutoUInt64TableBuilder({{.Name}}TablePrefix, {{.Name}}TableSeqPrefix, storeKey, &{{.Model}}{})
+{{- else}}
+// {{.Name}}Table is a typed orm.NaturalKeyTable of {{.Model}} objects.
+type {{.Name}}Table struct {
+	table orm.NaturalKeyTable
+{{- range .Indexes}}
+	{{.FieldName}} {{.OrmType}}
+{{- end}}
+}
+
+// New{{.Name}}Table creates the {{.Name}} table with its indexes.
+func New{{.Name}}Table(storeKey sdk.StoreKey) {{.Name}}Table {
+	builder := orm.NewNaturalKeyTableBuilder({{.Name}}TablePrefix, storeKey, &{{.Model}}{}, orm.Max255DynamicLengthIndexKeyCodec{})
+{{- end}}
+	builder.DisableTypeCheck()
+	var t {{.Name}}Table
+{{- range .Indexes}}
+{{- if .UInt64}}
+	t.{{.FieldName}} = orm.NewUInt64Index(builder, {{$t.Name}}{{.Name}}IndexPrefix, func(value interface{}) ([]uint64, error) {
+{{- if .Field.Repeated}}
+		values := value.(*{{$t.Model}}).{{.Field.Accessor}}
+		keys := make([]uint64, len(values))
+		for i := range values {
+			keys[i] = uint64(values[i])
+		}
+		return keys, nil
+{{- else}}
+		return []uint64{uint64(value.(*{{$t.Model}}).{{.Field.Accessor}})}, nil
+{{- end}}
+	})
+{{- else if .Unique}}
+	t.{{.FieldName}} = orm.NewUniqueIndex(builder, {{$t.Name}}{{.Name}}IndexPrefix, func(value interface{}) (orm.RowID, error) {
+		return {{.Field.BytesExpr (print "value.(*" $t.Model ")." .Field.Accessor)}}, nil
+	})
+{{- else}}
+	t.{{.FieldName}} = orm.NewIndex(builder, {{$t.Name}}{{.Name}}IndexPrefix, func(value interface{}) ([]orm.RowID, error) {
+{{- if .Field.Repeated}}
+		values := value.(*{{$t.Model}}).{{.Field.Accessor}}
+		keys := make([]orm.RowID, len(values))
+		for i := range values {
+			keys[i] = {{.Field.BytesExpr "values[i]"}}
+		}
+		return keys, nil
+{{- else}}
+		return []orm.RowID{ {{- .Field.BytesExpr (print "value.(*" $t.Model ")." .Field.Accessor) -}} }, nil
+{{- end}}
+	})
+{{- end}}
+{{- end}}
+	t.table = builder.Build()
+	return t
+}
+{{if .AutoUInt64}}
+// Create persists the {{.Model}} with an auto generated uint64 primary key. The key is returned.
+func (t {{.Name}}Table) Create(ctx orm.HasKVStore, obj *{{.Model}}) (uint64, error) {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the {{.Model}} with the given rowID.
+func (t {{.Name}}Table) Save(ctx orm.HasKVStore, rowID uint64, obj *{{.Model}}) error {
+	return t.table.Save(ctx, rowID, obj)
+}
+
+// Delete removes the {{.Model}} with the given rowID.
+func (t {{.Name}}Table) Delete(ctx orm.HasKVStore, rowID uint64) error {
+	return t.table.Delete(ctx, rowID)
+}
+
+// Has checks if a {{.Model}} with the given rowID exists.
+func (t {{.Name}}Table) Has(ctx orm.HasKVStore, rowID uint64) bool {
+	return t.table.Has(ctx, rowID)
+}
+
+// Get returns the {{.Model}} with the given rowID. An orm.ErrNotFound is returned when it does not exist.
+func (t {{.Name}}Table) Get(ctx orm.HasKVStore, rowID uint64) ({{.Model}}, error) {
+	var obj {{.Model}}
+	_, err := t.table.GetOne(ctx, rowID, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the rowIDs from start to the exclusive end in ascending order.
+func (t {{.Name}}Table) PrefixScan(ctx orm.HasKVStore, start, end uint64) ({{.Name}}Iterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return {{.Name}}Iterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the rowIDs from start to the exclusive end in descending order.
+func (t {{.Name}}Table) ReversePrefixScan(ctx orm.HasKVStore, start, end uint64) ({{.Name}}Iterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return {{.Name}}Iterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the rowIDs from start to the exclusive end in ascending order.
+func (t {{.Name}}Table) PaginatedPrefixScan(ctx orm.HasKVStore, start, end uint64, pageRequest *orm.PageRequest) ([]{{.Model}}, *orm.PageResponse, error) {
+	var objs []{{.Model}}
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+
+// Sequence returns the sequence of the table.
+func (t {{.Name}}Table) Sequence() orm.Sequence {
+	return t.table.Sequence()
+}
+{{else}}
+// Create persists the {{.Model}} under its natural key. An orm.ErrUniqueConstraint is returned when the key exists.
+func (t {{.Name}}Table) Create(ctx orm.HasKVStore, obj *{{.Model}}) error {
+	return t.table.Create(ctx, obj)
+}
+
+// Save updates the {{.Model}} stored under its natural key.
+func (t {{.Name}}Table) Save(ctx orm.HasKVStore, obj *{{.Model}}) error {
+	return t.table.Save(ctx, obj)
+}
+
+// Delete removes the {{.Model}} stored under its natural key.
+func (t {{.Name}}Table) Delete(ctx orm.HasKVStore, obj *{{.Model}}) error {
+	return t.table.Delete(ctx, obj)
+}
+
+// Has checks if a {{.Model}} with the given natural key exists.
+func (t {{.Name}}Table) Has(ctx orm.HasKVStore, naturalKey []byte) bool {
+	return t.table.Has(ctx, naturalKey)
+}
+
+// Contains checks if a {{.Model}} with the natural key of the given object exists.
+func (t {{.Name}}Table) Contains(ctx orm.HasKVStore, obj *{{.Model}}) bool {
+	return t.table.Contains(ctx, obj)
+}
+
+// Get returns the {{.Model}} with the given natural key. An orm.ErrNotFound is returned when it does not exist.
+func (t {{.Name}}Table) Get(ctx orm.HasKVStore, naturalKey []byte) ({{.Model}}, error) {
+	var obj {{.Model}}
+	err := t.table.GetOne(ctx, naturalKey, &obj)
+	return obj, err
+}
+
+// PrefixScan returns an iterator over the natural keys from start to the exclusive end in ascending order.
+func (t {{.Name}}Table) PrefixScan(ctx orm.HasKVStore, start, end []byte) ({{.Name}}Iterator, error) {
+	it, err := t.table.PrefixScan(ctx, start, end)
+	return {{.Name}}Iterator{it: it}, err
+}
+
+// ReversePrefixScan returns an iterator over the natural keys from start to the exclusive end in descending order.
+func (t {{.Name}}Table) ReversePrefixScan(ctx orm.HasKVStore, start, end []byte) ({{.Name}}Iterator, error) {
+	it, err := t.table.ReversePrefixScan(ctx, start, end)
+	return {{.Name}}Iterator{it: it}, err
+}
+
+// PaginatedPrefixScan returns a page of the natural keys from start to the exclusive end in ascending order.
+func (t {{.Name}}Table) PaginatedPrefixScan(ctx orm.HasKVStore, start, end []byte, pageRequest *orm.PageRequest) ([]{{.Model}}, *orm.PageResponse, error) {
+	var objs []{{.Model}}
+	res, err := t.table.PaginatedPrefixScan(ctx, start, end, pageRequest, &objs)
+	return objs, res, err
+}
+{{end}}
+{{- range .Indexes}}
+// Has{{.Name}} checks if the {{.Name}} index contains the key.
+func (t {{$t.Name}}Table) Has{{.Name}}(ctx orm.HasKVStore, key {{.Field.GoType}}) bool {
+	return t.{{.FieldName}}.Has(ctx, {{.KeyExpr "key"}})
+}
+
+// Get{{.Name}} returns an iterator over all {{$t.Model}} objects with the key in the {{.Name}} index.
+func (t {{$t.Name}}Table) Get{{.Name}}(ctx orm.HasKVStore, key {{.Field.GoType}}) ({{$t.Name}}Iterator, error) {
+	it, err := t.{{.FieldName}}.Get(ctx, {{.KeyExpr "key"}})
+	return {{$t.Name}}Iterator{it: it}, err
+}
+
+// PrefixScan{{.Name}} returns an iterator over the {{.Name}} index from start to the exclusive end in ascending order.
+func (t {{$t.Name}}Table) PrefixScan{{.Name}}(ctx orm.HasKVStore, start, end {{.Field.GoType}}) ({{$t.Name}}Iterator, error) {
+	it, err := t.{{.FieldName}}.PrefixScan(ctx, {{.KeyExpr "start"}}, {{.KeyExpr "end"}})
+	return {{$t.Name}}Iterator{it: it}, err
+}
+{{end}}
+// VerifyIndexes checks that the indexes of the {{.Name}} table are consistent with the table data.
+func (t {{.Name}}Table) VerifyIndexes(ctx orm.HasKVStore) error {
+{{- range .Indexes}}
+	if err := t.{{.FieldName}}.Verify(ctx, t.table); err != nil {
+		return errors.Wrap(err, "{{.Name}}")
+	}
+{{- end}}
+	return nil
+}
+
+// Table satisfies the orm.TableExportable interface and must not be used otherwise.
+func (t {{.Name}}Table) Table() orm.Table {
+	return t.table.Table()
+}
+
+// {{.Name}}Iterator is an orm.Iterator over {{.Model}} objects.
+type {{.Name}}Iterator struct {
+	it orm.Iterator
+}
+
+// LoadNext loads the next {{.Model}} into dest which must be a *{{.Model}}. An orm.ErrIteratorDone is returned when
+// there are no more elements.
+func (i {{.Name}}Iterator) LoadNext(dest orm.Persistent) (orm.RowID, error) {
+	obj, ok := dest.(*{{.Model}})
+	if !ok {
+		return nil, errors.Wrapf(orm.ErrType, "can not use %T with {{.Model}}", dest)
+	}
+	return i.it.LoadNext(obj)
+}
+
+// Close releases the iterator.
+func (i {{.Name}}Iterator) Close() error {
+	return i.it.Close()
+}
+
+// ReadAll loads all remaining {{.Model}} objects and closes the iterator.
+func (i {{.Name}}Iterator) ReadAll() ([]{{.Model}}, error) {
+	defer i.it.Close()
+	result := make([]{{.Model}}, 0)
+	for {
+		var obj {{.Model}}
+		_, err := i.it.LoadNext(&obj)
+		switch {
+		case err == nil:
+			result = append(result, obj)
+		case orm.ErrIteratorDone.Is(err):
+			return result, nil
+		default:
+			return nil, err
+		}
+	}
+}
+{{end}}`))
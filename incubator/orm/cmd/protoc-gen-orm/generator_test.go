@@ -0,0 +1,299 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/modules/incubator/orm"
+	"github.com/gogo/protobuf/gogoproto"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	specs := map[string]struct {
+		src         *orm.TableDescriptor
+		expErr      bool
+		expContains []string
+	}{
+		"natural key table": {
+			src: &orm.TableDescriptor{
+				Prefix:     0x10,
+				PrimaryKey: []string{"group", "address"},
+				Index: []*orm.IndexDescriptor{
+					{Name: "ByGroup", Prefix: 0x11, Field: "group"},
+					{Name: "ByOwner", Prefix: 0x12, Field: "owners"},
+					{Name: "ByName", Prefix: 0x13, Field: "name", Unique: true},
+					{Name: "ByAdmin", Prefix: 0x14, Field: "base.admin"},
+				},
+			},
+			expContains: []string{
+				"MyAccountTablePrefix byte = 0x10",
+				"MyAccountByAdminIndexPrefix byte = 0x14",
+				"result := make([]byte, 0, 8+len(m.Address))",
+				"result = append(result, orm.EncodeSequence(uint64(m.Group))...)",
+				"result = append(result, []byte(m.Address)...)",
+				"func NewMyAccountTable(storeKey sdk.StoreKey) MyAccountTable {",
+				"orm.NewNaturalKeyTableBuilder(MyAccountTablePrefix, storeKey, &MyAccount{}, orm.Max255DynamicLengthIndexKeyCodec{})",
+				"builder.DisableTypeCheck()",
+				"byGroupIndex orm.UInt64Index",
+				"byOwnerIndex orm.MultiKeyIndex",
+				"byNameIndex orm.UniqueIndex",
+				"keys[i] = []byte(values[i])",
+				"return []orm.RowID{[]byte(value.(*MyAccount).Base.Admin)}, nil",
+				"func (t MyAccountTable) Get(ctx orm.HasKVStore, naturalKey []byte) (MyAccount, error) {",
+				"func (t MyAccountTable) GetByGroup(ctx orm.HasKVStore, key GroupID) (MyAccountIterator, error) {",
+				"func (t MyAccountTable) GetByOwner(ctx orm.HasKVStore, key sdk.AccAddress) (MyAccountIterator, error) {",
+				"func (t MyAccountTable) HasByName(ctx orm.HasKVStore, key string) bool {",
+				"func (i MyAccountIterator) ReadAll() ([]MyAccount, error) {",
+			},
+		},
+		"natural key implemented by message": {
+			src: &orm.TableDescriptor{Prefix: 0x10},
+			expContains: []string{
+				"func NewMyAccountTable(storeKey sdk.StoreKey) MyAccountTable {",
+			},
+		},
+		"auto uint64 table with custom name": {
+			src: &orm.TableDescriptor{
+				Name:           "Account",
+				Prefix:         0x0,
+				AutoUint64:     true,
+				SequencePrefix: 0x1,
+				Index:          []*orm.IndexDescriptor{{Name: "ByGroup", Prefix: 0x2, Field: "group", Unique: true}},
+			},
+			expContains: []string{
+				"AccountTableSeqPrefix byte = 0x1",
+				"orm.NewAutoUInt64TableBuilder(AccountTablePrefix, AccountTableSeqPrefix, storeKey, &MyAccount{})",
+				"return orm.EncodeSequence(uint64(value.(*MyAccount).Group)), nil",
+				"func (t AccountTable) Create(ctx orm.HasKVStore, obj *MyAccount) (uint64, error) {",
+				"func (t AccountTable) Sequence() orm.Sequence {",
+				"func (t AccountTable) HasByGroup(ctx orm.HasKVStore, key GroupID) bool {\n\treturn t.byGroupIndex.Has(ctx, orm.EncodeSequence(uint64(key)))",
+			},
+		},
+		"unknown field": {
+			src:    &orm.TableDescriptor{Index: []*orm.IndexDescriptor{{Name: "ByX", Prefix: 1, Field: "unknown"}}},
+			expErr: true,
+		},
+		"unsupported field type": {
+			src:    &orm.TableDescriptor{Index: []*orm.IndexDescriptor{{Name: "ByX", Prefix: 1, Field: "active"}}},
+			expErr: true,
+		},
+		"nullable message in path": {
+			src:    &orm.TableDescriptor{Index: []*orm.IndexDescriptor{{Name: "ByX", Prefix: 1, Field: "optional.admin"}}},
+			expErr: true,
+		},
+		"duplicate index prefix": {
+			src:    &orm.TableDescriptor{Index: []*orm.IndexDescriptor{{Name: "ByX", Prefix: 0, Field: "group"}}},
+			expErr: true,
+		},
+		"duplicate index name": {
+			src: &orm.TableDescriptor{Index: []*orm.IndexDescriptor{
+				{Name: "ByX", Prefix: 1, Field: "group"},
+				{Name: "ByX", Prefix: 2, Field: "name"},
+			}},
+			expErr: true,
+		},
+		"prefix exceeds byte": {
+			src:    &orm.TableDescriptor{Prefix: 0x100},
+			expErr: true,
+		},
+		"variable length primary key field not last": {
+			src:    &orm.TableDescriptor{PrimaryKey: []string{"address", "group"}},
+			expErr: true,
+		},
+		"repeated primary key field": {
+			src:    &orm.TableDescriptor{PrimaryKey: []string{"owners"}},
+			expErr: true,
+		},
+		"primary key with auto uint64": {
+			src:    &orm.TableDescriptor{AutoUint64: true, SequencePrefix: 1, PrimaryKey: []string{"group"}},
+			expErr: true,
+		},
+		"same sequence prefix": {
+			src:    &orm.TableDescriptor{AutoUint64: true},
+			expErr: true,
+		},
+		"unique index on repeated field": {
+			src:    &orm.TableDescriptor{Index: []*orm.IndexDescriptor{{Name: "ByX", Prefix: 1, Field: "owners", Unique: true}}},
+			expErr: true,
+		},
+	}
+	fset := token.NewFileSet()
+	imp := exportDataImporter(t, fset)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			resp := generate(myRequest(t, spec.src))
+			if spec.expErr {
+				require.NotNil(t, resp.Error)
+				return
+			}
+			require.Nil(t, resp.Error, resp.GetError())
+			require.Len(t, resp.File, 1)
+			assert.Equal(t, "foo/bar.orm.go", resp.File[0].GetName())
+			src := resp.File[0].GetContent()
+			formatted, err := format.Source([]byte(src))
+			require.NoError(t, err)
+			assert.Equal(t, string(formatted), src)
+			// the message implements the natural key when the table has no primary key option
+			typeCheck(t, fset, imp, src, !spec.src.AutoUint64 && len(spec.src.PrimaryKey) == 0)
+
+			// ignore the alignment by gofmt
+			content := regexp.MustCompile(` +`).ReplaceAllString(src, " ")
+			for _, exp := range spec.expContains {
+				assert.Contains(t, content, exp)
+			}
+		})
+	}
+}
+
+func TestGenerateWithoutTables(t *testing.T) {
+	resp := generate(myRequest(t, nil))
+	require.Nil(t, resp.Error)
+	assert.Empty(t, resp.File)
+}
+
+func TestGenerateNestedTable(t *testing.T) {
+	req := myRequest(t, nil)
+	nested := &descriptor.DescriptorProto{Name: proto.String("Nested"), Options: &descriptor.MessageOptions{}}
+	require.NoError(t, proto.SetExtension(nested.Options, orm.E_Table, &orm.TableDescriptor{}))
+	req.ProtoFile[0].MessageType[0].NestedType = append(req.ProtoFile[0].MessageType[0].NestedType, nested)
+	resp := generate(req)
+	assert.NotNil(t, resp.Error)
+}
+
+// myModelSource is the Go code for the messages of myRequest as generated by protoc-gen-gogo.
+const myModelSource = `package foo
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type GroupID uint64
+
+type MyAccount struct {
+	Address  sdk.AccAddress
+	Group    GroupID
+	Owners   []sdk.AccAddress
+	Name     string
+	Active   bool
+	Base     MyBase
+	Optional *MyBase
+}
+
+func (m *MyAccount) Marshal() ([]byte, error) { return nil, nil }
+
+func (m *MyAccount) Unmarshal(bz []byte) error { return nil }
+
+type MyBase struct {
+	Admin sdk.AccAddress
+}
+`
+
+// exportDataImporter returns an importer for the packages used by the generated code that reads the export data of
+// the go build cache.
+func exportDataImporter(t *testing.T, fset *token.FileSet) types.Importer {
+	out, err := exec.Command("go", "list", "-export", "-deps", "-f", "{{.ImportPath}} {{.Export}}",
+		sdkImportPath, errorsImportPath, ormImportPath).Output()
+	require.NoError(t, err)
+	exports := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 {
+			exports[fields[0]] = fields[1]
+		}
+	}
+	return importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	})
+}
+
+// typeCheck fails the test when the generated source does not compile together with the messages of myRequest.
+func typeCheck(t *testing.T, fset *token.FileSet, imp types.Importer, src string, withNaturalKey bool) {
+	model := myModelSource
+	if withNaturalKey {
+		model += "\nfunc (m MyAccount) NaturalKey() []byte { return m.Address }\n"
+	}
+	var files []*ast.File
+	for name, content := range map[string]string{"bar.pb.go": model, "bar.orm.go": src} {
+		f, err := parser.ParseFile(fset, name, content, 0)
+		require.NoError(t, err)
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: imp}
+	_, err := conf.Check("example.com/foo", fset, files, nil)
+	require.NoError(t, err)
+}
+
+// myRequest returns a request for a file with the MyAccount message that has the given table option when not nil.
+func myRequest(t *testing.T, table *orm.TableDescriptor) *plugin.CodeGeneratorRequest {
+	castType := func(f *descriptor.FieldDescriptorProto, name string) *descriptor.FieldDescriptorProto {
+		f.Options = &descriptor.FieldOptions{}
+		require.NoError(t, proto.SetExtension(f.Options, gogoproto.E_Casttype, proto.String(name)))
+		return f
+	}
+	nonNullable := func(f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+		f.Options = &descriptor.FieldOptions{}
+		require.NoError(t, proto.SetExtension(f.Options, gogoproto.E_Nullable, proto.Bool(false)))
+		return f
+	}
+	newField := func(name string, number int32, tp descriptor.FieldDescriptorProto_Type) *descriptor.FieldDescriptorProto {
+		return &descriptor.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Type:   tp.Enum(),
+			Label:  descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+	}
+	owners := castType(newField("owners", 3, descriptor.FieldDescriptorProto_TYPE_BYTES), "github.com/cosmos/cosmos-sdk/types.AccAddress")
+	owners.Label = descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	base := nonNullable(newField("base", 6, descriptor.FieldDescriptorProto_TYPE_MESSAGE))
+	base.TypeName = proto.String(".foo.MyBase")
+	optional := newField("optional", 7, descriptor.FieldDescriptorProto_TYPE_MESSAGE)
+	optional.TypeName = proto.String(".foo.MyBase")
+
+	account := &descriptor.DescriptorProto{
+		Name: proto.String("MyAccount"),
+		Field: []*descriptor.FieldDescriptorProto{
+			castType(newField("address", 1, descriptor.FieldDescriptorProto_TYPE_BYTES), "github.com/cosmos/cosmos-sdk/types.AccAddress"),
+			castType(newField("group", 2, descriptor.FieldDescriptorProto_TYPE_UINT64), "GroupID"),
+			owners,
+			newField("name", 4, descriptor.FieldDescriptorProto_TYPE_STRING),
+			newField("active", 5, descriptor.FieldDescriptorProto_TYPE_BOOL),
+			base,
+			optional,
+		},
+	}
+	if table != nil {
+		account.Options = &descriptor.MessageOptions{}
+		require.NoError(t, proto.SetExtension(account.Options, orm.E_Table, table))
+	}
+	myBase := &descriptor.DescriptorProto{
+		Name: proto.String("MyBase"),
+		Field: []*descriptor.FieldDescriptorProto{
+			castType(newField("admin", 1, descriptor.FieldDescriptorProto_TYPE_BYTES), "github.com/cosmos/cosmos-sdk/types.AccAddress"),
+		},
+	}
+	file := &descriptor.FileDescriptorProto{
+		Name:        proto.String("foo/bar.proto"),
+		Package:     proto.String("foo"),
+		MessageType: []*descriptor.DescriptorProto{account, myBase},
+		Options:     &descriptor.FileOptions{GoPackage: proto.String("example.com/foo")},
+	}
+	return &plugin.CodeGeneratorRequest{FileToGenerate: []string{"foo/bar.proto"}, ProtoFile: []*descriptor.FileDescriptorProto{file}}
+}
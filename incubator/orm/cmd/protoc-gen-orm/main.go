@@ -0,0 +1,45 @@
+/*
+Command protoc-gen-orm is a protoc plugin that generates typed tables for messages with the
+`(cosmos_modules.incubator.orm.v1_alpha.table)` option. See `orm.proto` for the supported options.
+
+For every proto file with table options a `<name>.orm.go` file is written relative to the proto file, like
+with the `paths=source_relative` option of the go plugins. The generated code wraps `orm.NaturalKeyTable` or
+`orm.AutoUInt64Table` with methods for the message type and disables the runtime type checks of the orm.
+
+Example:
+
+	protoc -I=. -I=$(go list -f "{{ .Dir }}" -m github.com/cosmos/modules/incubator/orm)/.. --orm_out=. types.proto
+*/
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	plugin "github.com/gogo/protobuf/protoc-gen-gogo/plugin"
+)
+
+func main() {
+	data, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		fail(err)
+	}
+	var req plugin.CodeGeneratorRequest
+	if err := proto.Unmarshal(data, &req); err != nil {
+		fail(err)
+	}
+	resp, err := proto.Marshal(generate(&req))
+	if err != nil {
+		fail(err)
+	}
+	if _, err := os.Stdout.Write(resp); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "protoc-gen-orm: %s\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,140 @@
+package orm
+
+import "github.com/cosmos/cosmos-sdk/types/errors"
+
+// CompositeIndexerFunc creates one or multiple composite index keys for the source object.
+type CompositeIndexerFunc func(value interface{}) ([]Tuple, error)
+
+// CompositeMultiKeyAdapter converts CompositeIndexerFunc to IndexerFunc
+func CompositeMultiKeyAdapter(indexer CompositeIndexerFunc) IndexerFunc {
+	return func(value interface{}) ([]RowID, error) {
+		d, err := indexer(value)
+		if err != nil {
+			return nil, err
+		}
+		r := make([]RowID, len(d))
+		for i, v := range d {
+			if r[i], err = EncodeKey(v...); err != nil {
+				return nil, err
+			}
+		}
+		return r, nil
+	}
+}
+
+// CompositeIndex is a typed index with keys of multiple fields. The keys are encoded with EncodeKey so that any
+// leading elements of a key can be used as a prefix to query the index.
+type CompositeIndex struct {
+	multiKeyIndex MultiKeyIndex
+}
+
+// NewCompositeIndex creates a typed secondary index with composite keys.
+func NewCompositeIndex(builder Indexable, prefix byte, indexer CompositeIndexerFunc) CompositeIndex {
+	return CompositeIndex{
+		multiKeyIndex: NewIndex(builder, prefix, CompositeMultiKeyAdapter(indexer)),
+	}
+}
+
+// Has checks if a key with the given leading elements exists. Panics on nil or unsupported elements.
+func (i CompositeIndex) Has(ctx HasKVStore, prefix Tuple) bool {
+	if prefix == nil {
+		panic("nil key not allowed")
+	}
+	return i.multiKeyIndex.Has(ctx, mustEncodeKey(prefix...))
+}
+
+// Get returns a result iterator for all keys that start with the given elements. An empty tuple matches all keys.
+// Parameters must not be nil.
+func (i CompositeIndex) Get(ctx HasKVStore, prefix Tuple) (Iterator, error) {
+	if prefix == nil {
+		return NewInvalidIterator(), errors.Wrap(ErrArgument, "prefix must not be nil")
+	}
+	key, err := EncodeKey(prefix...)
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.Get(ctx, key)
+}
+
+// PrefixScan returns an Iterator over a domain of keys in ascending order. End is exclusive so that all keys starting
+// with the end elements are excluded. Start and end can have fewer elements than the index keys. A nil or empty
+// tuple is an open bound. Start must be less than end, or the Iterator is invalid and error is returned.
+// Iterator must be closed by caller.
+// To iterate over entire domain, use PrefixScan(nil, nil)
+//
+// WARNING: The use of a PrefixScan can be very expensive in terms of Gas. Please make sure you do not expose
+// this as an endpoint to the public without further limits.
+// Example:
+//			it, err := idx.PrefixScan(ctx, Tuple{groupID, startTime}, Tuple{groupID, endTime})
+//			if err !=nil {
+//				return err
+//			}
+//			const defaultLimit = 20
+//			it = LimitIterator(it, defaultLimit)
+//
+// CONTRACT: No writes may happen within a domain while an iterator exists over it.
+func (i CompositeIndex) PrefixScan(ctx HasKVStore, start, end Tuple) (Iterator, error) {
+	startKey, endKey, err := encodeRange(start, end)
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.PrefixScan(ctx, startKey, endKey)
+}
+
+// ReversePrefixScan returns an Iterator over a domain of keys in descending order. End is exclusive.
+// See PrefixScan for the bounds.
+// Iterator must be closed by caller.
+//
+// WARNING: The use of a ReversePrefixScan can be very expensive in terms of Gas. Please make sure you do not expose
+// this as an endpoint to the public without further limits. See `LimitIterator`
+//
+// CONTRACT: No writes may happen within a domain while an iterator exists over it.
+func (i CompositeIndex) ReversePrefixScan(ctx HasKVStore, start, end Tuple) (Iterator, error) {
+	startKey, endKey, err := encodeRange(start, end)
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.ReversePrefixScan(ctx, startKey, endKey)
+}
+
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. The scan is continued at the key of the page request which must be within the domain.
+// See `MultiKeyIndex.PaginatedPrefixScan` for details.
+func (i CompositeIndex) PaginatedPrefixScan(ctx HasKVStore, start, end Tuple, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	startKey, endKey, err := encodeRange(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return i.multiKeyIndex.PaginatedPrefixScan(ctx, startKey, endKey, pageRequest, dest)
+}
+
+// Verify checks that the index and the given table are consistent. See MultiKeyIndex.Verify for details.
+func (i CompositeIndex) Verify(ctx HasKVStore, table TableExportable) error {
+	return i.multiKeyIndex.Verify(ctx, table)
+}
+
+// encodeRange encodes the tuples of a scan. Nil or empty tuples are returned as nil for an open bound.
+func encodeRange(start, end Tuple) ([]byte, []byte, error) {
+	var startKey, endKey []byte
+	var err error
+	if len(start) != 0 {
+		if startKey, err = EncodeKey(start...); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(end) != 0 {
+		if endKey, err = EncodeKey(end...); err != nil {
+			return nil, nil, err
+		}
+	}
+	return startKey, endKey, nil
+}
+
+// mustEncodeKey encodes the elements with EncodeKey and panics on unsupported values.
+func mustEncodeKey(elems ...interface{}) []byte {
+	key, err := EncodeKey(elems...)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
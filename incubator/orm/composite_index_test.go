@@ -0,0 +1,100 @@
+package orm
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompositeIndex(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const anyPrefix = 0x10
+	tableBuilder := NewNaturalKeyTableBuilder(anyPrefix, storeKey, &testdata.GroupMember{}, Max255DynamicLengthIndexKeyCodec{})
+	// the variable length member address comes first
+	myIndex := NewCompositeIndex(tableBuilder, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]Tuple, error) {
+		m := val.(*testdata.GroupMember)
+		return []Tuple{{m.Member, m.Weight}}, nil
+	})
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+
+	members := []testdata.GroupMember{
+		{Group: sdk.AccAddress(EncodeSequence(1)), Member: sdk.AccAddress("member"), Weight: 2},
+		{Group: sdk.AccAddress(EncodeSequence(2)), Member: sdk.AccAddress("member"), Weight: 1},
+		{Group: sdk.AccAddress(EncodeSequence(3)), Member: sdk.AccAddress("member-2"), Weight: 1},
+	}
+	for i := range members {
+		require.NoError(t, myTable.Create(ctx, &members[i]))
+	}
+
+	// Has
+	assert.True(t, myIndex.Has(ctx, Tuple{sdk.AccAddress("member")}))
+	assert.True(t, myIndex.Has(ctx, Tuple{sdk.AccAddress("member"), uint64(1)}))
+	assert.False(t, myIndex.Has(ctx, Tuple{sdk.AccAddress("member"), uint64(3)}))
+	assert.False(t, myIndex.Has(ctx, Tuple{sdk.AccAddress("mem")}))
+	assert.Panics(t, func() { myIndex.Has(ctx, nil) })
+	assert.Panics(t, func() { myIndex.Has(ctx, Tuple{1}) })
+
+	// Get by prefix does not match the longer address
+	it, err := myIndex.Get(ctx, Tuple{sdk.AccAddress("member")})
+	require.NoError(t, err)
+	var loaded []testdata.GroupMember
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[1], members[0]}, loaded)
+
+	// Get by full key
+	it, err = myIndex.Get(ctx, Tuple{sdk.AccAddress("member"), uint64(2)})
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[0]}, loaded)
+
+	// Get with invalid arguments
+	_, err = myIndex.Get(ctx, nil)
+	assert.True(t, ErrArgument.Is(err))
+	_, err = myIndex.Get(ctx, Tuple{"member", 1})
+	assert.True(t, ErrArgument.Is(err))
+
+	// PrefixScan with end exclusive for all keys starting with it
+	it, err = myIndex.PrefixScan(ctx, Tuple{sdk.AccAddress("member"), uint64(2)}, Tuple{sdk.AccAddress("member-2")})
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[0]}, loaded)
+
+	// PrefixScan with open bounds
+	it, err = myIndex.PrefixScan(ctx, nil, nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[1], members[0], members[2]}, loaded)
+
+	// PrefixScan with start not less than end
+	_, err = myIndex.PrefixScan(ctx, Tuple{sdk.AccAddress("member-2")}, Tuple{sdk.AccAddress("member")})
+	assert.True(t, ErrArgument.Is(err))
+
+	// ReversePrefixScan
+	it, err = myIndex.ReversePrefixScan(ctx, Tuple{sdk.AccAddress("member")}, nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[0], members[1]}, loaded)
+
+	// PaginatedPrefixScan
+	res, err := myIndex.PaginatedPrefixScan(ctx, Tuple{}, Tuple{}, &PageRequest{Limit: 2}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[1], members[0]}, loaded)
+	res, err = myIndex.PaginatedPrefixScan(ctx, Tuple{}, Tuple{}, &PageRequest{Key: res.NextKey, Limit: 2}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2]}, loaded)
+	assert.Nil(t, res.NextKey)
+
+	// Verify
+	require.NoError(t, myIndex.Verify(ctx, myTable))
+}
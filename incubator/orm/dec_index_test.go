@@ -0,0 +1,159 @@
+package orm
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecIndex(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const anyPrefix = 0x10
+	tableBuilder := NewNaturalKeyTableBuilder(anyPrefix, storeKey, &testdata.GroupMember{}, Max255DynamicLengthIndexKeyCodec{})
+	// weights are converted into fractions with negative values
+	myIndex := NewDecIndex(tableBuilder, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]sdk.Dec, error) {
+		return []sdk.Dec{weightDec(val.(*testdata.GroupMember).Weight)}, nil
+	})
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+	members := createGroupMembers(t, ctx, myTable, 20, 10, 1, 11, 9, 2000)
+
+	// Has
+	assert.True(t, myIndex.Has(ctx, sdk.NewDecWithPrec(-9, 1)))
+	assert.False(t, myIndex.Has(ctx, sdk.NewDecWithPrec(-9, 2)))
+	assert.Panics(t, func() { myIndex.Has(ctx, sdk.Dec{}) })
+
+	// Get
+	it, err := myIndex.Get(ctx, sdk.NewDecWithPrec(-1, 1))
+	require.NoError(t, err)
+	var loaded []testdata.GroupMember
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[4]}, loaded)
+	_, err = myIndex.Get(ctx, sdk.Dec{})
+	assert.True(t, ErrArgument.Is(err))
+
+	// range bounds are pointers, nil is an open bound
+	ptr := func(v sdk.Dec) *sdk.Dec { return &v }
+
+	// PrefixScan range across zero
+	it, err = myIndex.PrefixScan(ctx, ptr(sdk.NewDecWithPrec(-5, 1)), ptr(sdk.NewDecWithPrec(5, 1)))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[4], members[1], members[3]}, loaded)
+
+	// PrefixScan over values of different magnitude
+	it, err = myIndex.PrefixScan(ctx, ptr(sdk.NewDecWithPrec(1, 1)), ptr(sdk.NewDec(1000)))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[3], members[0], members[5]}, loaded)
+
+	// PrefixScan with start not less than end
+	_, err = myIndex.PrefixScan(ctx, ptr(sdk.OneDec()), ptr(sdk.ZeroDec()))
+	assert.True(t, ErrArgument.Is(err))
+	_, err = myIndex.PrefixScan(ctx, ptr(sdk.Dec{}), ptr(sdk.ZeroDec()))
+	assert.True(t, ErrArgument.Is(err))
+
+	// ReversePrefixScan
+	it, err = myIndex.ReversePrefixScan(ctx, ptr(sdk.NewDec(-1)), ptr(sdk.NewDec(1000)))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[5], members[0], members[3], members[1], members[4], members[2]}, loaded)
+
+	// PaginatedPrefixScan
+	res, err := myIndex.PaginatedPrefixScan(ctx, ptr(sdk.NewDec(-1)), ptr(sdk.NewDec(1000)), &PageRequest{Limit: 3}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4], members[1]}, loaded)
+	_, err = myIndex.PaginatedPrefixScan(ctx, ptr(sdk.NewDec(-1)), ptr(sdk.NewDec(1000)), &PageRequest{Key: res.NextKey, Limit: 3}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[3], members[0], members[5]}, loaded)
+
+	// PrefixScan with open start
+	it, err = myIndex.PrefixScan(ctx, nil, ptr(sdk.ZeroDec()))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4]}, loaded)
+
+	// PrefixScan with open end
+	it, err = myIndex.PrefixScan(ctx, ptr(sdk.NewDecWithPrec(1, 1)), nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[3], members[0], members[5]}, loaded)
+
+	// ReversePrefixScan unbounded
+	it, err = myIndex.ReversePrefixScan(ctx, nil, nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[5], members[0], members[3], members[1], members[4], members[2]}, loaded)
+
+	// Verify
+	require.NoError(t, myIndex.Verify(ctx, myTable))
+}
+
+func TestDecMultiKeyAdapter(t *testing.T) {
+	specs := map[string]struct {
+		srcFunc DecIndexerFunc
+		exp     []RowID
+		expErr  bool
+	}{
+		"zero": {
+			srcFunc: func(value interface{}) ([]sdk.Dec, error) {
+				return []sdk.Dec{sdk.ZeroDec()}, nil
+			},
+			exp: []RowID{{0x80}},
+		},
+		"multi key": {
+			srcFunc: func(value interface{}) ([]sdk.Dec, error) {
+				return []sdk.Dec{sdk.NewDecWithPrec(1, 18), sdk.NewDecWithPrec(-256, 18)}, nil
+			},
+			exp: []RowID{{0x81, 1}, {0x7e, 0xfe, 0xff}},
+		},
+		"nil key": {
+			srcFunc: func(value interface{}) ([]sdk.Dec, error) {
+				return nil, nil
+			},
+			exp: []RowID{},
+		},
+		"nil decimal": {
+			srcFunc: func(value interface{}) ([]sdk.Dec, error) {
+				return []sdk.Dec{{}}, nil
+			},
+			expErr: true,
+		},
+		"error case": {
+			srcFunc: func(value interface{}) ([]sdk.Dec, error) {
+				return nil, errors.New("test")
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			fn := DecMultiKeyAdapter(spec.srcFunc)
+			r, err := fn(nil)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, r)
+		})
+	}
+}
+
+// weightDec returns (weight - 10) / 10 as decimal.
+func weightDec(weight uint64) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(weight)-10, 1)
+}
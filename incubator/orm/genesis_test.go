@@ -80,3 +80,20 @@ func TestImportTableData(t *testing.T) {
 	}
 
 }
+
+func TestExportImportEmptyTableData(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	const prefix = iota
+	table := NewAutoUInt64TableBuilder(prefix, 0x1, storeKey, &testdata.GroupMetadata{}).Build()
+
+	ctx := NewMockContext()
+	jsonModels, seqValue, err := ExportTableData(ctx, table)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(jsonModels))
+	assert.Equal(t, uint64(0), seqValue)
+
+	// when
+	err = ImportTableData(NewMockContext(), table, jsonModels, seqValue)
+	// then
+	require.NoError(t, err)
+}
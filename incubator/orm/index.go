@@ -2,6 +2,7 @@ package orm
 
 import (
 	"bytes"
+	"reflect"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -14,6 +15,7 @@ type indexer interface {
 	OnCreate(store sdk.KVStore, rowID RowID, value interface{}) error
 	OnDelete(store sdk.KVStore, rowID RowID, value interface{}) error
 	OnUpdate(store sdk.KVStore, rowID RowID, newValue, oldValue interface{}) error
+	IndexKeys(value interface{}) ([]RowID, error)
 }
 
 // MultiKeyIndex is an index where multiple entries can point to the same underlying object as opposite to a unique index
@@ -115,6 +117,89 @@ func (i MultiKeyIndex) ReversePrefixScan(ctx HasKVStore, start []byte, end []byt
 	return indexIterator{ctx: ctx, it: it, rowGetter: i.rowGetter, keyCodec: i.indexKeyCodec}, nil
 }
 
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. The scan is continued at the key of the page request which must be within the domain. The page keys
+// are raw index keys including the IndexKeyCodec suffix so that multiple entries for a searchable key are not
+// skipped. See `Paginate` for details.
+func (i MultiKeyIndex) PaginatedPrefixScan(ctx HasKVStore, start []byte, end []byte, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	start, err := pageStart(start, end, pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	it, err := i.PrefixScan(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return Paginate(it, pageRequest, dest)
+}
+
+// Verify checks that every index entry references an existing row in the given table and that every row of the
+// table is indexed with all keys returned by the indexer. The table must be the one this index was built for.
+// An ErrIndexInconsistent error is returned for the first mismatch found.
+//
+// WARNING: Verify iterates over the whole index and table. It is intended for invariant checks and tests only.
+func (i MultiKeyIndex) Verify(ctx HasKVStore, table TableExportable) error {
+	t := table.Table()
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
+
+	// every index entry must point to an existing row that creates this entry
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		rowID := i.indexKeyCodec.StripRowID(it.Key())
+		obj := reflect.New(t.model).Interface().(Persistent)
+		switch err := i.rowGetter(ctx, rowID, obj); {
+		case ErrNotFound.Is(err):
+			return errors.Wrapf(ErrIndexInconsistent, "index %X: no row for %X", i.prefix, rowID)
+		case err != nil:
+			return err
+		}
+		if !i.hasIndexKey(obj, rowID, it.Key()) {
+			return errors.Wrapf(ErrIndexInconsistent, "index %X: stale entry %X", i.prefix, it.Key())
+		}
+	}
+
+	// every row must be indexed
+	rowIt, err := t.PrefixScan(ctx, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer rowIt.Close()
+	for {
+		obj := reflect.New(t.model).Interface().(Persistent)
+		rowID, err := rowIt.LoadNext(obj)
+		switch {
+		case ErrIteratorDone.Is(err):
+			return nil
+		case err != nil:
+			return err
+		}
+		keys, err := i.indexer.IndexKeys(obj)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if !store.Has(i.indexKeyCodec.BuildIndexKey(k, rowID)) {
+				return errors.Wrapf(ErrIndexInconsistent, "index %X: missing entry %X for %X", i.prefix, k, rowID)
+			}
+		}
+	}
+}
+
+// hasIndexKey returns true when the indexer creates the given persistent index key for the object.
+func (i MultiKeyIndex) hasIndexKey(obj Persistent, rowID RowID, indexKey []byte) bool {
+	keys, err := i.indexer.IndexKeys(obj)
+	if err != nil {
+		return false
+	}
+	for _, k := range keys {
+		if bytes.Equal(i.indexKeyCodec.BuildIndexKey(k, rowID), indexKey) {
+			return true
+		}
+	}
+	return false
+}
+
 func (i MultiKeyIndex) onSave(ctx HasKVStore, rowID RowID, newValue, oldValue Persistent) error {
 	store := prefix.NewStore(ctx.KVStore(i.storeKey), []byte{i.prefix})
 	if oldValue == nil {
@@ -166,6 +251,17 @@ func (i indexIterator) Close() error {
 	return nil
 }
 
+func (i indexIterator) nextKey() []byte {
+	if !i.it.Valid() {
+		return nil
+	}
+	return i.it.Key()
+}
+
+func (i indexIterator) skip() {
+	i.it.Next()
+}
+
 // prefixRange turns a prefix into a (start, end) range. The start is the given prefix value and
 // the end is calculated by adding 1 bit to the start value. Nil is not allowed as prefix.
 // 		Example: []byte{1, 3, 4} becomes []byte{1, 3, 5}
@@ -3,6 +3,7 @@ package orm
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/modules/incubator/orm/testdata"
@@ -305,3 +306,59 @@ func TestPrefixRange(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexVerify(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+		testTableSeqPrefix
+	)
+	tBuilder := NewAutoUInt64TableBuilder(testTablePrefix, testTableSeqPrefix, storeKey, &testdata.GroupMetadata{})
+	idx := NewIndex(tBuilder, GroupByAdminIndexPrefix, func(val interface{}) ([]RowID, error) {
+		return []RowID{[]byte(val.(*testdata.GroupMetadata).Admin)}, nil
+	})
+	tb := tBuilder.Build()
+
+	myAdmin := sdk.AccAddress([]byte("admin-address-a"))
+	specs := map[string]struct {
+		doCorrupt func(store sdk.KVStore)
+		expErr    *errors.Error
+	}{
+		"consistent": {
+			doCorrupt: func(store sdk.KVStore) {},
+		},
+		"entry without row": {
+			doCorrupt: func(store sdk.KVStore) {
+				store.Set(idx.indexKeyCodec.BuildIndexKey(myAdmin, EncodeSequence(99)), []byte{})
+			},
+			expErr: ErrIndexInconsistent,
+		},
+		"stale entry": {
+			doCorrupt: func(store sdk.KVStore) {
+				store.Set(idx.indexKeyCodec.BuildIndexKey([]byte("other-address"), EncodeSequence(1)), []byte{})
+			},
+			expErr: ErrIndexInconsistent,
+		},
+		"missing entry": {
+			doCorrupt: func(store sdk.KVStore) {
+				store.Delete(idx.indexKeyCodec.BuildIndexKey(myAdmin, EncodeSequence(1)))
+			},
+			expErr: ErrIndexInconsistent,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx := NewMockContext()
+			_, err := tb.Create(ctx, &testdata.GroupMetadata{Description: "my test", Admin: myAdmin})
+			require.NoError(t, err)
+
+			spec.doCorrupt(prefix.NewStore(ctx.KVStore(storeKey), []byte{GroupByAdminIndexPrefix}))
+			err = idx.Verify(ctx, tb)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), "%+v", err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -49,6 +49,11 @@ func NewUniqueIndexer(f UniqueIndexerFunc, codec IndexKeyCodec) *Indexer {
 	return idx
 }
 
+// IndexKeys returns the non empty secondary index keys for the object.
+func (i Indexer) IndexKeys(value interface{}) ([]RowID, error) {
+	return i.indexerFunc(value)
+}
+
 // OnCreate persists the secondary index entries for the new object.
 func (i Indexer) OnCreate(store sdk.KVStore, rowID RowID, value interface{}) error {
 	secondaryIndexKeys, err := i.indexerFunc(value)
@@ -0,0 +1,113 @@
+package orm
+
+// Int64IndexerFunc creates one or multiple multiKeyIndex keys of type int64 for the source object.
+type Int64IndexerFunc func(value interface{}) ([]int64, error)
+
+// Int64MultiKeyAdapter converts Int64IndexerFunc to IndexerFunc
+func Int64MultiKeyAdapter(indexer Int64IndexerFunc) IndexerFunc {
+	return func(value interface{}) ([]RowID, error) {
+		d, err := indexer(value)
+		if err != nil {
+			return nil, err
+		}
+		r := make([]RowID, len(d))
+		for i, v := range d {
+			if r[i], err = EncodeKey(v); err != nil {
+				return nil, err
+			}
+		}
+		return r, nil
+	}
+}
+
+// Int64Index is a typed index. Negative values sort before positive values.
+type Int64Index struct {
+	multiKeyIndex MultiKeyIndex
+}
+
+// NewInt64Index creates a typed secondary index
+func NewInt64Index(builder Indexable, prefix byte, indexer Int64IndexerFunc) Int64Index {
+	return Int64Index{
+		multiKeyIndex: NewIndex(builder, prefix, Int64MultiKeyAdapter(indexer)),
+	}
+}
+
+// Has checks if a key exists.
+func (i Int64Index) Has(ctx HasKVStore, key int64) bool {
+	return i.multiKeyIndex.Has(ctx, mustEncodeKey(key))
+}
+
+// Get returns a result iterator for the searchKey.
+func (i Int64Index) Get(ctx HasKVStore, searchKey int64) (Iterator, error) {
+	key, err := EncodeKey(searchKey)
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.Get(ctx, key)
+}
+
+// PrefixScan returns an Iterator over a domain of keys in ascending order. End is exclusive.
+// A nil start or end is an open bound.
+// Start is an MultiKeyIndex key or prefix. It must be less than end, or the Iterator is invalid and error is returned.
+// Iterator must be closed by caller.
+//
+// WARNING: The use of a PrefixScan can be very expensive in terms of Gas. Please make sure you do not expose
+// this as an endpoint to the public without further limits.
+// Example:
+//			it, err := idx.PrefixScan(ctx, start, end)
+//			if err !=nil {
+//				return err
+//			}
+//			const defaultLimit = 20
+//			it = LimitIterator(it, defaultLimit)
+//
+// CONTRACT: No writes may happen within a domain while an iterator exists over it.
+func (i Int64Index) PrefixScan(ctx HasKVStore, start, end *int64) (Iterator, error) {
+	startKey, endKey, err := encodeRange(int64Bound(start), int64Bound(end))
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.PrefixScan(ctx, startKey, endKey)
+}
+
+// ReversePrefixScan returns an Iterator over a domain of keys in descending order. End is exclusive.
+// A nil start or end is an open bound.
+// Start is an MultiKeyIndex key or prefix. It must be less than end, or the Iterator is invalid  and error is returned.
+// Iterator must be closed by caller.
+//
+// WARNING: The use of a ReversePrefixScan can be very expensive in terms of Gas. Please make sure you do not expose
+// this as an endpoint to the public without further limits. See `LimitIterator`
+//
+// CONTRACT: No writes may happen within a domain while an iterator exists over it.
+func (i Int64Index) ReversePrefixScan(ctx HasKVStore, start, end *int64) (Iterator, error) {
+	startKey, endKey, err := encodeRange(int64Bound(start), int64Bound(end))
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.ReversePrefixScan(ctx, startKey, endKey)
+}
+
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. A nil start or end is an open bound. The scan is continued at the key of the page request which must
+// be within the domain.
+// See `MultiKeyIndex.PaginatedPrefixScan` for details.
+func (i Int64Index) PaginatedPrefixScan(ctx HasKVStore, start, end *int64, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	startKey, endKey, err := encodeRange(int64Bound(start), int64Bound(end))
+	if err != nil {
+		return nil, err
+	}
+	return i.multiKeyIndex.PaginatedPrefixScan(ctx, startKey, endKey, pageRequest, dest)
+}
+
+// Verify checks that the index and the given table are consistent. See MultiKeyIndex.Verify for details.
+func (i Int64Index) Verify(ctx HasKVStore, table TableExportable) error {
+	return i.multiKeyIndex.Verify(ctx, table)
+}
+
+// int64Bound returns the range bound for the value or an open bound for nil.
+func int64Bound(v *int64) Tuple {
+	if v == nil {
+		return nil
+	}
+	return Tuple{*v}
+}
@@ -0,0 +1,156 @@
+package orm
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt64Index(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const anyPrefix = 0x10
+	tableBuilder := NewNaturalKeyTableBuilder(anyPrefix, storeKey, &testdata.GroupMember{}, Max255DynamicLengthIndexKeyCodec{})
+	myIndex := NewInt64Index(tableBuilder, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]int64, error) {
+		return []int64{int64(val.(*testdata.GroupMember).Weight) - 10}, nil
+	})
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+	members := createGroupMembers(t, ctx, myTable, 20, 10, 1, 11, 9)
+
+	// Has
+	assert.True(t, myIndex.Has(ctx, -9))
+	assert.False(t, myIndex.Has(ctx, -10))
+
+	// Get
+	it, err := myIndex.Get(ctx, -1)
+	require.NoError(t, err)
+	var loaded []testdata.GroupMember
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[4]}, loaded)
+
+	// range bounds are pointers, nil is an open bound
+	ptr := func(v int64) *int64 { return &v }
+
+	// PrefixScan range across zero
+	it, err = myIndex.PrefixScan(ctx, ptr(-5), ptr(5))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[4], members[1], members[3]}, loaded)
+
+	// PrefixScan end exclusive
+	it, err = myIndex.PrefixScan(ctx, ptr(-9), ptr(0))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4]}, loaded)
+
+	// PrefixScan with start not less than end
+	_, err = myIndex.PrefixScan(ctx, ptr(1), ptr(-1))
+	assert.True(t, ErrArgument.Is(err))
+
+	// ReversePrefixScan
+	it, err = myIndex.ReversePrefixScan(ctx, ptr(-10), ptr(11))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[0], members[3], members[1], members[4], members[2]}, loaded)
+
+	// PaginatedPrefixScan
+	res, err := myIndex.PaginatedPrefixScan(ctx, ptr(-10), ptr(11), &PageRequest{Limit: 3}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4], members[1]}, loaded)
+	_, err = myIndex.PaginatedPrefixScan(ctx, ptr(-10), ptr(11), &PageRequest{Key: res.NextKey, Limit: 3}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[3], members[0]}, loaded)
+
+	// PrefixScan with open start
+	it, err = myIndex.PrefixScan(ctx, nil, ptr(0))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4]}, loaded)
+
+	// PrefixScan with open end
+	it, err = myIndex.PrefixScan(ctx, ptr(1), nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[3], members[0]}, loaded)
+
+	// ReversePrefixScan unbounded
+	it, err = myIndex.ReversePrefixScan(ctx, nil, nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[0], members[3], members[1], members[4], members[2]}, loaded)
+
+	// Verify
+	require.NoError(t, myIndex.Verify(ctx, myTable))
+}
+
+func TestInt64MultiKeyAdapter(t *testing.T) {
+	specs := map[string]struct {
+		srcFunc Int64IndexerFunc
+		exp     []RowID
+		expErr  error
+	}{
+		"single key": {
+			srcFunc: func(value interface{}) ([]int64, error) {
+				return []int64{1}, nil
+			},
+			exp: []RowID{{0x80, 0, 0, 0, 0, 0, 0, 1}},
+		},
+		"multi key": {
+			srcFunc: func(value interface{}) ([]int64, error) {
+				return []int64{0, -1}, nil
+			},
+			exp: []RowID{{0x80, 0, 0, 0, 0, 0, 0, 0}, {0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		},
+		"nil key": {
+			srcFunc: func(value interface{}) ([]int64, error) {
+				return nil, nil
+			},
+			exp: []RowID{},
+		},
+		"error case": {
+			srcFunc: func(value interface{}) ([]int64, error) {
+				return nil, errors.New("test")
+			},
+			expErr: errors.New("test"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			fn := Int64MultiKeyAdapter(spec.srcFunc)
+			r, err := fn(nil)
+			if spec.expErr != nil {
+				require.Equal(t, spec.expErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, r)
+		})
+	}
+}
+
+// createGroupMembers persists a group member for each weight in the given table and returns them in order.
+func createGroupMembers(t *testing.T, ctx HasKVStore, table NaturalKeyTable, weights ...uint64) []testdata.GroupMember {
+	members := make([]testdata.GroupMember, len(weights))
+	for i, w := range weights {
+		members[i] = testdata.GroupMember{
+			Group:  sdk.AccAddress(EncodeSequence(uint64(i + 1))),
+			Member: sdk.AccAddress([]byte("member-address")),
+			Weight: w,
+		}
+		require.NoError(t, table.Create(ctx, &members[i]))
+	}
+	return members
+}
@@ -0,0 +1,243 @@
+package orm
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Tuple is an ordered list of typed key elements that is encoded into a composite index key with EncodeKey.
+// Supported element types are uint64, int64, string, []byte, bool, sdk.AccAddress, time.Time and sdk.Dec. All keys
+// of an index must use the same element type at the same position.
+type Tuple []interface{}
+
+const (
+	// escape and the following escapedZero or terminator byte replace zero bytes and mark the end of variable
+	// length elements so that they are self delimiting and keep their lexicographical order.
+	escape      byte = 0x00
+	escapedZero byte = 0xff
+	terminator  byte = 0x01
+
+	// decZero is the header byte of a zero sdk.Dec. Positive values add and negative values subtract the length of
+	// their magnitude.
+	decZero byte = 0x80
+)
+
+// EncodeKey encodes the tuple elements into an ordered and self delimiting byte representation. Keys sort in the
+// same order as the tuples, element by element, and the encoding of a tuple is a prefix of the encoding of any tuple
+// that starts with the same elements. This makes any leading elements usable as a prefix for scans.
+//
+// The elements are encoded as:
+//  * uint64: 8 bytes big endian
+//  * int64: 8 bytes big endian with the sign bit flipped
+//  * bool: a single 0 or 1 byte
+//  * time.Time: the unix seconds as int64 followed by the nanoseconds as 4 bytes big endian. The location is dropped.
+//  * string, []byte and sdk.AccAddress: the bytes with 0x00 escaped as 0x00 0xff, terminated by 0x00 0x01
+//  * sdk.Dec: a header byte of 0x80 plus the signed length of the big endian magnitude followed by the magnitude.
+//    The magnitude bytes of negative values are inverted.
+func EncodeKey(elems ...interface{}) ([]byte, error) {
+	res := make([]byte, 0, len(elems)*EncodedSeqLength)
+	for i, e := range elems {
+		switch v := e.(type) {
+		case uint64:
+			res = appendUint64(res, v)
+		case int64:
+			res = appendInt64(res, v)
+		case bool:
+			if v {
+				res = append(res, 1)
+			} else {
+				res = append(res, 0)
+			}
+		case time.Time:
+			res = appendInt64(res, v.Unix())
+			res = appendUint32(res, uint32(v.Nanosecond()))
+		case sdk.Dec:
+			var err error
+			if res, err = appendDec(res, v); err != nil {
+				return nil, errors.Wrapf(err, "position %d", i)
+			}
+		case string:
+			res = appendBytes(res, []byte(v))
+		case []byte:
+			res = appendBytes(res, v)
+		case sdk.AccAddress:
+			res = appendBytes(res, v)
+		default:
+			return nil, errors.Wrapf(ErrArgument, "unsupported key element type %T at position %d", e, i)
+		}
+	}
+	return res, nil
+}
+
+// DecodeKey is the reverse operation to EncodeKey. It decodes the leading elements of the key into the given
+// pointers of the element types and returns the remaining bytes.
+func DecodeKey(key []byte, dest ...interface{}) ([]byte, error) {
+	for i, d := range dest {
+		var err error
+		switch v := d.(type) {
+		case *uint64:
+			*v, key, err = readUint64(key)
+		case *int64:
+			*v, key, err = readInt64(key)
+		case *bool:
+			if len(key) < 1 || key[0] > 1 {
+				return nil, errors.Wrapf(ErrArgument, "invalid bool at position %d", i)
+			}
+			*v, key = key[0] == 1, key[1:]
+		case *time.Time:
+			var secs int64
+			var nanos uint32
+			if secs, key, err = readInt64(key); err == nil {
+				if nanos, key, err = readUint32(key); err == nil {
+					*v = time.Unix(secs, int64(nanos)).UTC()
+				}
+			}
+		case *sdk.Dec:
+			*v, key, err = readDec(key)
+		case *string:
+			var bz []byte
+			bz, key, err = readBytes(key)
+			*v = string(bz)
+		case *[]byte:
+			*v, key, err = readBytes(key)
+		case *sdk.AccAddress:
+			*v, key, err = readBytes(key)
+		default:
+			return nil, errors.Wrapf(ErrArgument, "unsupported key element type %T at position %d", d, i)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "position %d", i)
+		}
+	}
+	return key, nil
+}
+
+func appendUint64(dst []byte, v uint64) []byte {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], v)
+	return append(dst, bz[:]...)
+}
+
+func appendInt64(dst []byte, v int64) []byte {
+	return appendUint64(dst, uint64(v)^(1<<63))
+}
+
+func appendUint32(dst []byte, v uint32) []byte {
+	var bz [4]byte
+	binary.BigEndian.PutUint32(bz[:], v)
+	return append(dst, bz[:]...)
+}
+
+func appendBytes(dst []byte, v []byte) []byte {
+	for _, b := range v {
+		if b == escape {
+			dst = append(dst, escape, escapedZero)
+			continue
+		}
+		dst = append(dst, b)
+	}
+	return append(dst, escape, terminator)
+}
+
+func appendDec(dst []byte, v sdk.Dec) ([]byte, error) {
+	if v.IsNil() {
+		return nil, errors.Wrap(ErrArgument, "nil decimal")
+	}
+	// the big.Int of a decimal is not accessible with all sdk versions so that it is parsed from the fixed
+	// precision string representation
+	abs, ok := new(big.Int).SetString(strings.Replace(v.Abs().String(), ".", "", 1), 10)
+	if !ok {
+		return nil, errors.Wrap(ErrArgument, "invalid decimal")
+	}
+	magnitude := abs.Bytes()
+	n := len(magnitude)
+	if n >= int(decZero) {
+		return nil, errors.Wrap(ErrArgument, "decimal too large")
+	}
+	if v.IsNegative() {
+		dst = append(dst, decZero-byte(n))
+		for _, b := range magnitude {
+			dst = append(dst, ^b)
+		}
+		return dst, nil
+	}
+	dst = append(dst, decZero+byte(n))
+	return append(dst, magnitude...), nil
+}
+
+func readUint64(key []byte) (uint64, []byte, error) {
+	if len(key) < 8 {
+		return 0, nil, errors.Wrap(ErrArgument, "key too short")
+	}
+	return binary.BigEndian.Uint64(key), key[8:], nil
+}
+
+func readInt64(key []byte) (int64, []byte, error) {
+	v, rest, err := readUint64(key)
+	return int64(v ^ (1 << 63)), rest, err
+}
+
+func readUint32(key []byte) (uint32, []byte, error) {
+	if len(key) < 4 {
+		return 0, nil, errors.Wrap(ErrArgument, "key too short")
+	}
+	v := binary.BigEndian.Uint32(key)
+	if v >= uint32(time.Second) {
+		return 0, nil, errors.Wrap(ErrArgument, "invalid nanoseconds")
+	}
+	return v, key[4:], nil
+}
+
+func readBytes(key []byte) ([]byte, []byte, error) {
+	res := make([]byte, 0, len(key))
+	for i := 0; i < len(key); i++ {
+		if key[i] != escape {
+			res = append(res, key[i])
+			continue
+		}
+		if i+1 == len(key) {
+			break
+		}
+		switch key[i+1] {
+		case terminator:
+			return res, key[i+2:], nil
+		case escapedZero:
+			res = append(res, escape)
+			i++
+		default:
+			return nil, nil, errors.Wrap(ErrArgument, "invalid escape sequence")
+		}
+	}
+	return nil, nil, errors.Wrap(ErrArgument, "missing terminator")
+}
+
+func readDec(key []byte) (sdk.Dec, []byte, error) {
+	if len(key) < 1 {
+		return sdk.Dec{}, nil, errors.Wrap(ErrArgument, "key too short")
+	}
+	negative := key[0] < decZero
+	n := int(key[0]) - int(decZero)
+	if negative {
+		n = -n
+	}
+	if len(key) < 1+n {
+		return sdk.Dec{}, nil, errors.Wrap(ErrArgument, "key too short")
+	}
+	magnitude := make([]byte, n)
+	copy(magnitude, key[1:1+n])
+	if negative {
+		for i := range magnitude {
+			magnitude[i] = ^magnitude[i]
+		}
+	}
+	v := new(big.Int).SetBytes(magnitude)
+	if negative {
+		v.Neg(v)
+	}
+	return sdk.NewDecFromBigIntWithPrec(v, sdk.Precision), key[1+n:], nil
+}
@@ -0,0 +1,114 @@
+package orm
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeKeyRoundTrip(t *testing.T) {
+	var (
+		u     uint64
+		i     int64
+		s     string
+		bz    []byte
+		b     bool
+		addr  sdk.AccAddress
+		tm    time.Time
+		dec   sdk.Dec
+		srcTm = time.Date(2020, 5, 1, 10, 11, 12, 13, time.UTC)
+	)
+	key, err := EncodeKey(uint64(math.MaxUint64), int64(-7), "a\x00b", []byte{0, 0xff, 1}, true, sdk.AccAddress([]byte("my-address")), srcTm, sdk.NewDecWithPrec(-12345, 3))
+	require.NoError(t, err)
+
+	rest, err := DecodeKey(key, &u, &i, &s, &bz, &b, &addr, &tm, &dec)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, uint64(math.MaxUint64), u)
+	assert.Equal(t, int64(-7), i)
+	assert.Equal(t, "a\x00b", s)
+	assert.Equal(t, []byte{0, 0xff, 1}, bz)
+	assert.True(t, b)
+	assert.Equal(t, sdk.AccAddress([]byte("my-address")), addr)
+	assert.Equal(t, srcTm, tm)
+	assert.Equal(t, sdk.NewDecWithPrec(-12345, 3), dec)
+}
+
+func TestEncodeKeyOrder(t *testing.T) {
+	specs := map[string]struct {
+		lower, higher Tuple
+	}{
+		"uint64":              {lower: Tuple{uint64(1)}, higher: Tuple{uint64(256)}},
+		"negative int64":      {lower: Tuple{int64(-2)}, higher: Tuple{int64(-1)}},
+		"int64 across zero":   {lower: Tuple{int64(-1)}, higher: Tuple{int64(0)}},
+		"bool":                {lower: Tuple{false}, higher: Tuple{true}},
+		"string prefix":       {lower: Tuple{"a"}, higher: Tuple{"ab"}},
+		"string zero byte":    {lower: Tuple{"a"}, higher: Tuple{"a\x00"}},
+		"string zero vs one":  {lower: Tuple{"a\x00"}, higher: Tuple{"a\x01"}},
+		"bytes":               {lower: Tuple{[]byte{1, 2}}, higher: Tuple{[]byte{1, 3}}},
+		"time":                {lower: Tuple{time.Unix(1, 999)}, higher: Tuple{time.Unix(2, 0)}},
+		"time before epoch":   {lower: Tuple{time.Unix(-1, 0)}, higher: Tuple{time.Unix(0, 0)}},
+		"dec":                 {lower: Tuple{sdk.NewDecWithPrec(1, 2)}, higher: Tuple{sdk.NewDecWithPrec(1, 1)}},
+		"dec magnitude":       {lower: Tuple{sdk.NewDec(255)}, higher: Tuple{sdk.NewDec(256)}},
+		"negative dec":        {lower: Tuple{sdk.NewDec(-256)}, higher: Tuple{sdk.NewDec(-255)}},
+		"dec across zero":     {lower: Tuple{sdk.NewDecWithPrec(-1, 18)}, higher: Tuple{sdk.ZeroDec()}},
+		"first field decides": {lower: Tuple{"a", uint64(2)}, higher: Tuple{"ab", uint64(1)}},
+		"second field":        {lower: Tuple{sdk.AccAddress("a"), uint64(1)}, higher: Tuple{sdk.AccAddress("a"), uint64(2)}},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			lower, err := EncodeKey(spec.lower...)
+			require.NoError(t, err)
+			higher, err := EncodeKey(spec.higher...)
+			require.NoError(t, err)
+			assert.Equal(t, -1, bytes.Compare(lower, higher))
+		})
+	}
+}
+
+func TestEncodeKeyPrefix(t *testing.T) {
+	full, err := EncodeKey("a", uint64(1))
+	require.NoError(t, err)
+	prefix, err := EncodeKey("a")
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(full, prefix))
+
+	other, err := EncodeKey("ab", uint64(1))
+	require.NoError(t, err)
+	assert.False(t, bytes.HasPrefix(other, prefix))
+}
+
+func TestEncodeKeyUnsupportedType(t *testing.T) {
+	_, err := EncodeKey(uint64(1), 1)
+	assert.True(t, ErrArgument.Is(err))
+}
+
+func TestDecodeKeyErrors(t *testing.T) {
+	var (
+		u uint64
+		s string
+		b bool
+		n int
+	)
+	specs := map[string]struct {
+		src  []byte
+		dest []interface{}
+	}{
+		"key too short":           {src: []byte{1, 2}, dest: []interface{}{&u}},
+		"missing terminator":      {src: []byte("abc"), dest: []interface{}{&s}},
+		"invalid escape sequence": {src: []byte{'a', 0, 2}, dest: []interface{}{&s}},
+		"invalid bool":            {src: []byte{2}, dest: []interface{}{&b}},
+		"unsupported type":        {src: []byte{1}, dest: []interface{}{&n}},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			_, err := DecodeKey(spec.src, spec.dest...)
+			assert.True(t, ErrArgument.Is(err))
+		})
+	}
+}
@@ -77,7 +77,7 @@ func (a NaturalKeyTable) Has(ctx HasKVStore, naturalKey RowID) bool {
 
 // Contains returns true when an object with same type and natural key is persisted in this table.
 func (a NaturalKeyTable) Contains(ctx HasKVStore, obj NaturalKeyed) bool {
-	if err := assertCorrectType(a.table.model, obj); err != nil {
+	if err := a.table.assertType(obj); err != nil {
 		return false
 	}
 	return a.table.Has(ctx, obj.NaturalKey())
@@ -122,6 +122,13 @@ func (a NaturalKeyTable) ReversePrefixScan(ctx HasKVStore, start, end []byte) (I
 	return a.table.ReversePrefixScan(ctx, start, end)
 }
 
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. The scan is continued at the key of the page request which must be within the domain.
+// See `Paginate` for details.
+func (a NaturalKeyTable) PaginatedPrefixScan(ctx HasKVStore, start, end []byte, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	return a.table.PaginatedPrefixScan(ctx, start, end, pageRequest, dest)
+}
+
 // Table satisfies the TableExportable interface and must not be used otherwise.
 func (a NaturalKeyTable) Table() Table {
 	return a.table
@@ -22,6 +22,7 @@ var (
 	ErrUniqueConstraint  = errors.Register(ormCodespace, 111, "unique constraint violation")
 	ErrArgument          = errors.Register(ormCodespace, 112, "invalid argument")
 	ErrIndexKeyMaxLength = errors.Register(ormCodespace, 113, "index key exceeds max length")
+	ErrIndexInconsistent = errors.Register(ormCodespace, 114, "index inconsistent")
 )
 
 // HasKVStore is a subset of the cosmos-sdk context defined for loose coupling and simpler test setups.
@@ -96,6 +97,17 @@ type Index interface {
 	//
 	// CONTRACT: No writes may happen within a domain while an iterator exists over it.
 	ReversePrefixScan(ctx HasKVStore, start []byte, end []byte) (Iterator, error)
+
+	// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr.
+	// End is exclusive. The scan is continued at the key of the page request which must be within the domain.
+	// The returned next key is an opaque cursor for the following page. See `Paginate` for details.
+	PaginatedPrefixScan(ctx HasKVStore, start []byte, end []byte, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error)
+
+	// Verify checks that every index entry references an existing row in the given table and that every row of the
+	// table is indexed. An ErrIndexInconsistent error is returned for the first mismatch found.
+	//
+	// WARNING: Verify iterates over the whole index and table. It is intended for invariant checks and tests only.
+	Verify(ctx HasKVStore, table TableExportable) error
 }
 
 // Iterator allows iteration through a sequence of key value pairs
@@ -139,12 +151,19 @@ type RowGetter func(ctx HasKVStore, rowID RowID, dest Persistent) error
 
 // NewTypeSafeRowGetter returns a `RowGetter` with type check on the dest parameter.
 func NewTypeSafeRowGetter(storeKey sdk.StoreKey, prefixKey byte, model reflect.Type) RowGetter {
+	return newRowGetter(storeKey, prefixKey, model, true)
+}
+
+// newRowGetter returns a `RowGetter` that checks the type of the dest parameter when typeCheck is set.
+func newRowGetter(storeKey sdk.StoreKey, prefixKey byte, model reflect.Type, typeCheck bool) RowGetter {
 	return func(ctx HasKVStore, rowID RowID, dest Persistent) error {
 		if len(rowID) == 0 {
 			return errors.Wrap(ErrArgument, "key must not be nil")
 		}
-		if err := assertCorrectType(model, dest); err != nil {
-			return err
+		if typeCheck {
+			if err := assertCorrectType(model, dest); err != nil {
+				return err
+			}
 		}
 
 		store := prefix.NewStore(ctx.KVStore(storeKey), []byte{prefixKey})
@@ -0,0 +1,886 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: orm/orm.proto
+
+package orm
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TableDescriptor defines the typed table that protoc-gen-orm generates for a message.
+type TableDescriptor struct {
+	// name is the Go name of the table that is used for the generated types and prefix constants. Defaults to the
+	// message name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// prefix is the store prefix of the table data.
+	Prefix uint32 `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// primary_key lists the fields that make up the natural key of a NaturalKeyTable in order. When empty, the
+	// message must implement the NaturalKey method itself. Only the last field may be of variable length.
+	PrimaryKey []string `protobuf:"bytes,3,rep,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	// auto_uint64 creates an AutoUInt64Table with the sequence stored under sequence_prefix instead of a
+	// NaturalKeyTable.
+	AutoUint64     bool   `protobuf:"varint,4,opt,name=auto_uint64,json=autoUint64,proto3" json:"auto_uint64,omitempty"`
+	SequencePrefix uint32 `protobuf:"varint,5,opt,name=sequence_prefix,json=sequencePrefix,proto3" json:"sequence_prefix,omitempty"`
+	// index defines the secondary indexes of the table.
+	Index []*IndexDescriptor `protobuf:"bytes,6,rep,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
+func (m *TableDescriptor) String() string { return proto.CompactTextString(m) }
+func (*TableDescriptor) ProtoMessage()    {}
+func (*TableDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95db754c53fb1128, []int{0}
+}
+func (m *TableDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableDescriptor.Merge(m, src)
+}
+func (m *TableDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *TableDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableDescriptor proto.InternalMessageInfo
+
+func (m *TableDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TableDescriptor) GetPrefix() uint32 {
+	if m != nil {
+		return m.Prefix
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetPrimaryKey() []string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetAutoUint64() bool {
+	if m != nil {
+		return m.AutoUint64
+	}
+	return false
+}
+
+func (m *TableDescriptor) GetSequencePrefix() uint32 {
+	if m != nil {
+		return m.SequencePrefix
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetIndex() []*IndexDescriptor {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+// IndexDescriptor defines a secondary index on a message field.
+type IndexDescriptor struct {
+	// name is the Go name of the index, for example "ByAdmin".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// prefix is the store prefix of the index data.
+	Prefix uint32 `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// field is the indexed field. Fields of non nullable embedded messages are referenced by path, for example
+	// "base.admin". uint64 fields are indexed as UInt64Index, bytes and string fields as MultiKeyIndex. A repeated
+	// field adds an index key for each element.
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// unique creates a UniqueIndex. Not supported for repeated fields.
+	Unique bool `protobuf:"varint,4,opt,name=unique,proto3" json:"unique,omitempty"`
+}
+
+func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
+func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
+func (*IndexDescriptor) ProtoMessage()    {}
+func (*IndexDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95db754c53fb1128, []int{1}
+}
+func (m *IndexDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDescriptor.Merge(m, src)
+}
+func (m *IndexDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexDescriptor proto.InternalMessageInfo
+
+func (m *IndexDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IndexDescriptor) GetPrefix() uint32 {
+	if m != nil {
+		return m.Prefix
+	}
+	return 0
+}
+
+func (m *IndexDescriptor) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *IndexDescriptor) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
+var E_Table = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MessageOptions)(nil),
+	ExtensionType: (*TableDescriptor)(nil),
+	Field:         77001,
+	Name:          "cosmos_modules.incubator.orm.v1_alpha.table",
+	Tag:           "bytes,77001,opt,name=table",
+	Filename:      "orm/orm.proto",
+}
+
+func init() {
+	proto.RegisterType((*TableDescriptor)(nil), "cosmos_modules.incubator.orm.v1_alpha.TableDescriptor")
+	proto.RegisterType((*IndexDescriptor)(nil), "cosmos_modules.incubator.orm.v1_alpha.IndexDescriptor")
+	proto.RegisterExtension(E_Table)
+}
+
+func init() { proto.RegisterFile("orm/orm.proto", fileDescriptor_95db754c53fb1128) }
+
+var fileDescriptor_95db754c53fb1128 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x8e, 0x9b, 0x40,
+	0x10, 0xc6, 0xbd, 0xc1, 0x58, 0xf1, 0x5a, 0x8e, 0x25, 0x14, 0x45, 0x28, 0x05, 0x46, 0x96, 0x22,
+	0x53, 0x2d, 0xca, 0x1f, 0xb9, 0x48, 0x97, 0x28, 0x4d, 0x94, 0x44, 0x89, 0x50, 0xae, 0xb9, 0x06,
+	0x2d, 0x30, 0xc6, 0x2b, 0x03, 0x8b, 0x77, 0xd9, 0x93, 0xfd, 0x16, 0xf7, 0x58, 0x77, 0x9d, 0xcb,
+	0xbb, 0xee, 0x64, 0xbf, 0xc3, 0xd5, 0xa7, 0x05, 0xec, 0x93, 0x5c, 0xf9, 0x3a, 0xe6, 0x9b, 0x6f,
+	0xf8, 0xf1, 0xcd, 0x80, 0x87, 0x5c, 0xe4, 0x3e, 0x17, 0x39, 0x29, 0x05, 0xaf, 0xb8, 0xf5, 0x21,
+	0xe6, 0x32, 0xe7, 0x32, 0xcc, 0x79, 0xa2, 0x32, 0x90, 0x84, 0x15, 0xb1, 0x8a, 0x68, 0xc5, 0x05,
+	0xd1, 0x9e, 0xab, 0x8f, 0x21, 0xcd, 0xca, 0x05, 0x7d, 0xef, 0xa6, 0x9c, 0xa7, 0x19, 0xf8, 0xf5,
+	0x50, 0xa4, 0xe6, 0x7e, 0x02, 0x32, 0x16, 0xac, 0xd4, 0xc6, 0x5a, 0x9b, 0x3c, 0x22, 0x3c, 0xfa,
+	0x4f, 0xa3, 0x0c, 0x7e, 0x1c, 0x3b, 0x96, 0x85, 0xbb, 0x05, 0xcd, 0xc1, 0x46, 0x2e, 0xf2, 0xfa,
+	0x41, 0xfd, 0x6c, 0xbd, 0xc3, 0xbd, 0x52, 0xc0, 0x9c, 0xad, 0xed, 0x57, 0x2e, 0xf2, 0x86, 0x41,
+	0x5b, 0x59, 0x63, 0x3c, 0x28, 0x05, 0xcb, 0xa9, 0xd8, 0x84, 0x4b, 0xd8, 0xd8, 0x86, 0x6b, 0x78,
+	0xfd, 0x00, 0xb7, 0xd2, 0x2f, 0xd8, 0x68, 0x03, 0x55, 0x15, 0x0f, 0x15, 0x2b, 0xaa, 0xd9, 0x17,
+	0xbb, 0xeb, 0x22, 0xef, 0x75, 0x80, 0xb5, 0x74, 0x51, 0x2b, 0xd6, 0x14, 0x8f, 0x24, 0xac, 0x14,
+	0x14, 0x31, 0x84, 0x2d, 0xc2, 0xac, 0x11, 0x6f, 0x0e, 0xf2, 0xbf, 0x06, 0xf5, 0x1b, 0x9b, 0xac,
+	0x48, 0x60, 0x6d, 0xf7, 0x5c, 0xc3, 0x1b, 0x7c, 0x9a, 0x91, 0xb3, 0x76, 0x40, 0x7e, 0xea, 0x99,
+	0xe7, 0x74, 0x41, 0xf3, 0x92, 0xc9, 0x12, 0x8f, 0x4e, 0x3a, 0x2f, 0xca, 0xfd, 0x16, 0x9b, 0x73,
+	0x06, 0x59, 0x62, 0x1b, 0xb5, 0xb9, 0x29, 0xb4, 0x5b, 0x15, 0x6c, 0xa5, 0xa0, 0xcd, 0xd9, 0x56,
+	0x5f, 0x39, 0x36, 0x2b, 0xbd, 0x64, 0x6b, 0x4c, 0x9a, 0x8b, 0x90, 0xc3, 0x45, 0xc8, 0x1f, 0x90,
+	0x92, 0xa6, 0xf0, 0xb7, 0xac, 0x18, 0x2f, 0xa4, 0x7d, 0x7b, 0xaf, 0x27, 0xcf, 0x4f, 0x77, 0x72,
+	0xbb, 0xa0, 0xe1, 0x7c, 0xff, 0x76, 0xb3, 0x73, 0xd0, 0x76, 0xe7, 0xa0, 0x87, 0x9d, 0x83, 0xae,
+	0xf7, 0x4e, 0x67, 0xbb, 0x77, 0x3a, 0x77, 0x7b, 0xa7, 0x73, 0x39, 0x4d, 0x59, 0xb5, 0x50, 0x11,
+	0x89, 0x79, 0xee, 0x37, 0x08, 0xbf, 0x45, 0xf8, 0x47, 0x84, 0xfe, 0xd1, 0xa2, 0x5e, 0xfd, 0x89,
+	0x9f, 0x9f, 0x06, 0x00, 0xa3, 0xc0, 0xc8, 0x70, 0x7a, 0x02, 0x00, 0x00,
+}
+
+func (m *TableDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SequencePrefix != 0 {
+		i = encodeVarintOrm(dAtA, i, uint64(m.SequencePrefix))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoUint64 {
+		i--
+		if m.AutoUint64 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PrimaryKey) > 0 {
+		for iNdEx := len(m.PrimaryKey) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrimaryKey[iNdEx])
+			copy(dAtA[i:], m.PrimaryKey[iNdEx])
+			i = encodeVarintOrm(dAtA, i, uint64(len(m.PrimaryKey[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Prefix != 0 {
+		i = encodeVarintOrm(dAtA, i, uint64(m.Prefix))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOrm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unique {
+		i--
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintOrm(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Prefix != 0 {
+		i = encodeVarintOrm(dAtA, i, uint64(m.Prefix))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOrm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TableDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrm(uint64(l))
+	}
+	if m.Prefix != 0 {
+		n += 1 + sovOrm(uint64(m.Prefix))
+	}
+	if len(m.PrimaryKey) > 0 {
+		for _, s := range m.PrimaryKey {
+			l = len(s)
+			n += 1 + l + sovOrm(uint64(l))
+		}
+	}
+	if m.AutoUint64 {
+		n += 2
+	}
+	if m.SequencePrefix != 0 {
+		n += 1 + sovOrm(uint64(m.SequencePrefix))
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovOrm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrm(uint64(l))
+	}
+	if m.Prefix != 0 {
+		n += 1 + sovOrm(uint64(m.Prefix))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovOrm(uint64(l))
+	}
+	if m.Unique {
+		n += 2
+	}
+	return n
+}
+
+func sovOrm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrm(x uint64) (n int) {
+	return sovOrm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TableDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			m.Prefix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Prefix |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUint64", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoUint64 = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencePrefix", wireType)
+			}
+			m.SequencePrefix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequencePrefix |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index, &IndexDescriptor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			m.Prefix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Prefix |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOrm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrm = fmt.Errorf("proto: unexpected end of group")
+)
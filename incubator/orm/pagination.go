@@ -0,0 +1,100 @@
+package orm
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultPageLimit is the number of elements returned for a page request without limit.
+const DefaultPageLimit = 100
+
+// PageRequest defines a page of a paginated prefix scan.
+type PageRequest struct {
+	// Key is the opaque cursor returned as NextKey with the previous page. Empty for the first page.
+	Key []byte
+	// Limit is the max number of elements in the page. DefaultPageLimit is used when 0.
+	Limit uint64
+	// CountTotal requests the total number of elements in the scanned domain. It is only supported for the first page
+	// as the elements before the cursor are not visited.
+	CountTotal bool
+}
+
+// PageResponse is returned with a page of a paginated prefix scan.
+type PageResponse struct {
+	// NextKey is the cursor to request the next page with. It is the raw store key of the next element, including
+	// the IndexKeyCodec suffix for index scans. Nil when there are no more elements.
+	NextKey []byte
+	// Total is the number of elements in the scanned domain when requested with CountTotal.
+	Total uint64
+}
+
+// keyedIterator is an Iterator that exposes the raw store keys of its elements for pagination.
+type keyedIterator interface {
+	Iterator
+	// nextKey returns the raw store key of the next element or nil when there are no more elements.
+	nextKey() []byte
+	// skip moves to the next element without loading it.
+	skip()
+}
+
+// Paginate consumes up to the page limit of values from the iterator and stores them in a new slice at the passed
+// ModelSlicePtr. The iterator must be opened at the page cursor and is closed afterwards. Only iterators returned
+// by the PrefixScan methods of tables and indexes are supported.
+// Example:
+//			res, err := Paginate(it, &PageRequest{Limit: 10}, &loaded)
+//			require.NoError(t, err)
+//			// next page
+//			pageRequest := &PageRequest{Key: res.NextKey, Limit: 10}
+//
+func Paginate(it Iterator, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	if it == nil {
+		return nil, errors.Wrap(ErrArgument, "iterator must not be nil")
+	}
+	defer it.Close()
+	if pageRequest == nil {
+		pageRequest = &PageRequest{}
+	}
+	if pageRequest.CountTotal && len(pageRequest.Key) != 0 {
+		return nil, errors.Wrap(ErrArgument, "total count not supported with page key")
+	}
+	kit, ok := it.(keyedIterator)
+	if !ok {
+		return nil, errors.Wrap(ErrArgument, "iterator does not support pagination")
+	}
+	limit := pageRequest.Limit
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+	// IteratorFunc does not close the parent so that the iterator can be continued after the page is read
+	rowIDs, err := ReadAll(LimitIterator(IteratorFunc(kit.LoadNext), int(limit)), dest)
+	if err != nil {
+		return nil, err
+	}
+	var res PageResponse
+	if key := kit.nextKey(); key != nil {
+		res.NextKey = make([]byte, len(key))
+		copy(res.NextKey, key)
+	}
+	if !pageRequest.CountTotal {
+		return &res, nil
+	}
+	res.Total = uint64(len(rowIDs))
+	for ; kit.nextKey() != nil; kit.skip() {
+		res.Total++
+	}
+	return &res, nil
+}
+
+// pageStart returns the start key of a paginated scan. This is the page key when set. The page key must be within
+// the scanned domain.
+func pageStart(start, end []byte, pageRequest *PageRequest) ([]byte, error) {
+	if pageRequest == nil || len(pageRequest.Key) == 0 {
+		return start, nil
+	}
+	key := pageRequest.Key
+	if start != nil && bytes.Compare(key, start) < 0 || end != nil && bytes.Compare(key, end) >= 0 {
+		return nil, errors.Wrap(ErrArgument, "page key out of range")
+	}
+	return key, nil
+}
@@ -0,0 +1,167 @@
+package orm
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/modules/incubator/orm/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginatedPrefixScan(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	const (
+		testTablePrefix = iota
+		testTableSeqPrefix
+	)
+	tBuilder := NewAutoUInt64TableBuilder(testTablePrefix, testTableSeqPrefix, storeKey, &testdata.GroupMetadata{})
+	idx := NewIndex(tBuilder, GroupByAdminIndexPrefix, func(val interface{}) ([]RowID, error) {
+		return []RowID{[]byte(val.(*testdata.GroupMetadata).Admin)}, nil
+	})
+	uint64Idx := NewUInt64Index(tBuilder, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]uint64, error) {
+		// index admin b before admin a
+		if val.(*testdata.GroupMetadata).Admin.Equals(sdk.AccAddress("admin-address-b")) {
+			return []uint64{1}, nil
+		}
+		return []uint64{2}, nil
+	})
+	tb := tBuilder.Build()
+	ctx := NewMockContext()
+
+	g1 := testdata.GroupMetadata{Description: "my test 1", Admin: sdk.AccAddress("admin-address-a")}
+	g2 := testdata.GroupMetadata{Description: "my test 2", Admin: sdk.AccAddress("admin-address-b")}
+	g3 := testdata.GroupMetadata{Description: "my test 3", Admin: sdk.AccAddress("admin-address-b")}
+	for _, g := range []testdata.GroupMetadata{g1, g2, g3} {
+		_, err := tb.Create(ctx, &g)
+		require.NoError(t, err)
+	}
+
+	specs := map[string]struct {
+		scan         func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error)
+		limit        uint64
+		expPages     [][]testdata.GroupMetadata
+		expPageTotal uint64
+	}{
+		"table": {
+			scan: func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+				return tb.PaginatedPrefixScan(ctx, 1, 100, pageRequest, dest)
+			},
+			limit:        2,
+			expPages:     [][]testdata.GroupMetadata{{g1, g2}, {g3}},
+			expPageTotal: 3,
+		},
+		"table with limit matching size": {
+			scan: func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+				return tb.PaginatedPrefixScan(ctx, 1, 100, pageRequest, dest)
+			},
+			limit:        3,
+			expPages:     [][]testdata.GroupMetadata{{g1, g2, g3}},
+			expPageTotal: 3,
+		},
+		"table with default limit": {
+			scan: func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+				return tb.PaginatedPrefixScan(ctx, 1, 100, pageRequest, dest)
+			},
+			expPages:     [][]testdata.GroupMetadata{{g1, g2, g3}},
+			expPageTotal: 3,
+		},
+		"table empty domain": {
+			scan: func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+				return tb.PaginatedPrefixScan(ctx, 10, 100, pageRequest, dest)
+			},
+			limit:    2,
+			expPages: [][]testdata.GroupMetadata{{}},
+		},
+		"index with page break within same searchable key": {
+			scan: func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+				return idx.PaginatedPrefixScan(ctx, []byte("admin-address"), nil, pageRequest, dest)
+			},
+			limit:        1,
+			expPages:     [][]testdata.GroupMetadata{{g1}, {g2}, {g3}},
+			expPageTotal: 3,
+		},
+		"index by searchable key": {
+			scan: func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+				return idx.PaginatedPrefixScan(ctx, []byte("admin-address-b"), []byte("admin-address-c"), pageRequest, dest)
+			},
+			limit:        1,
+			expPages:     [][]testdata.GroupMetadata{{g2}, {g3}},
+			expPageTotal: 2,
+		},
+		"uint64 index": {
+			scan: func(pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+				return uint64Idx.PaginatedPrefixScan(ctx, 0, 10, pageRequest, dest)
+			},
+			limit:        2,
+			expPages:     [][]testdata.GroupMetadata{{g2, g3}, {g1}},
+			expPageTotal: 3,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			pageRequest := &PageRequest{Limit: spec.limit, CountTotal: true}
+			for i, expPage := range spec.expPages {
+				var loaded []testdata.GroupMetadata
+				res, err := spec.scan(pageRequest, &loaded)
+				require.NoError(t, err)
+				assert.Equal(t, expPage, loaded)
+				if i == 0 {
+					assert.Equal(t, spec.expPageTotal, res.Total)
+				}
+				if i == len(spec.expPages)-1 {
+					assert.Nil(t, res.NextKey)
+					return
+				}
+				require.NotNil(t, res.NextKey)
+				pageRequest = &PageRequest{Key: res.NextKey, Limit: spec.limit}
+			}
+		})
+	}
+
+	t.Run("page key before domain", func(t *testing.T) {
+		var loaded []testdata.GroupMetadata
+		_, err := tb.PaginatedPrefixScan(ctx, 2, 100, &PageRequest{Key: EncodeSequence(1)}, &loaded)
+		assert.True(t, ErrArgument.Is(err))
+	})
+	t.Run("page key after domain", func(t *testing.T) {
+		var loaded []testdata.GroupMetadata
+		_, err := tb.PaginatedPrefixScan(ctx, 1, 2, &PageRequest{Key: EncodeSequence(2)}, &loaded)
+		assert.True(t, ErrArgument.Is(err))
+	})
+	t.Run("count total with page key", func(t *testing.T) {
+		var loaded []testdata.GroupMetadata
+		_, err := tb.PaginatedPrefixScan(ctx, 1, 100, &PageRequest{Key: EncodeSequence(2), CountTotal: true}, &loaded)
+		assert.True(t, ErrArgument.Is(err))
+	})
+	t.Run("nil page request", func(t *testing.T) {
+		var loaded []testdata.GroupMetadata
+		res, err := tb.PaginatedPrefixScan(ctx, 1, 100, nil, &loaded)
+		require.NoError(t, err)
+		assert.Equal(t, []testdata.GroupMetadata{g1, g2, g3}, loaded)
+		assert.Nil(t, res.NextKey)
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	specs := map[string]struct {
+		srcIT  Iterator
+		expErr *errors.Error
+	}{
+		"iterator is nil": {
+			expErr: ErrArgument,
+		},
+		"iterator without keys": {
+			srcIT:  mockIter(EncodeSequence(1), &testdata.GroupMetadata{}),
+			expErr: ErrArgument,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var loaded []testdata.GroupMetadata
+			_, err := Paginate(spec.srcIT, &PageRequest{}, &loaded)
+			assert.True(t, spec.expErr.Is(err), err)
+		})
+	}
+}
@@ -19,6 +19,7 @@ type TableBuilder struct {
 	indexKeyCodec IndexKeyCodec
 	afterSave     []AfterSaveInterceptor
 	afterDelete   []AfterDeleteInterceptor
+	skipTypeCheck bool
 }
 
 // NewTableBuilder creates a builder to setup a Table object.
@@ -48,9 +49,9 @@ func (a TableBuilder) IndexKeyCodec() IndexKeyCodec {
 	return a.indexKeyCodec
 }
 
-// RowGetter returns a type safe RowGetter.
+// RowGetter returns a type safe RowGetter unless the type checks are disabled.
 func (a TableBuilder) RowGetter() RowGetter {
-	return NewTypeSafeRowGetter(a.storeKey, a.prefixData, a.model)
+	return newRowGetter(a.storeKey, a.prefixData, a.model, !a.skipTypeCheck)
 }
 
 func (a TableBuilder) StoreKey() sdk.StoreKey {
@@ -60,14 +61,22 @@ func (a TableBuilder) StoreKey() sdk.StoreKey {
 // Build creates a new Table object.
 func (a TableBuilder) Build() Table {
 	return Table{
-		model:       a.model,
-		prefix:      a.prefixData,
-		storeKey:    a.storeKey,
-		afterSave:   a.afterSave,
-		afterDelete: a.afterDelete,
+		model:         a.model,
+		prefix:        a.prefixData,
+		storeKey:      a.storeKey,
+		afterSave:     a.afterSave,
+		afterDelete:   a.afterDelete,
+		skipTypeCheck: a.skipTypeCheck,
 	}
 }
 
+// DisableTypeCheck turns off the runtime type checks of the model objects for the table and all indexes that are
+// added afterwards. It is intended for typed wrappers, like the ones generated by `protoc-gen-orm`, where the model
+// type is ensured by the compiler already.
+func (a *TableBuilder) DisableTypeCheck() {
+	a.skipTypeCheck = true
+}
+
 // AddAfterSaveInterceptor can be used to register a callback function that is executed after an object is created and/or updated.
 func (a *TableBuilder) AddAfterSaveInterceptor(interceptor AfterSaveInterceptor) {
 	a.afterSave = append(a.afterSave, interceptor)
@@ -85,11 +94,12 @@ var _ TableExportable = &Table{}
 // The Table struct does not enforce uniqueness of the `RowID` but expects this to be satisfied by the callers and conditions
 // to optimize Gas usage.
 type Table struct {
-	model       reflect.Type
-	prefix      byte
-	storeKey    sdk.StoreKey
-	afterSave   []AfterSaveInterceptor
-	afterDelete []AfterDeleteInterceptor
+	model         reflect.Type
+	prefix        byte
+	storeKey      sdk.StoreKey
+	afterSave     []AfterSaveInterceptor
+	afterDelete   []AfterDeleteInterceptor
+	skipTypeCheck bool
 }
 
 // Create persists the given object under the rowID key. It does not check if the
@@ -97,42 +107,45 @@ type Table struct {
 // by providing a universal unique ID or sequence that is guaranteed to not exist yet or
 // by checking the state via `Has` function before.
 //
-// Create iterates though the registered callbacks and may add secondary index keys by them.
+// Create iterates though the registered callbacks and may add secondary index keys by them. The row and index
+// changes are only written when all callbacks succeed.
 func (a Table) Create(ctx HasKVStore, rowID RowID, obj Persistent) error {
-	if err := assertCorrectType(a.model, obj); err != nil {
+	if err := a.assertType(obj); err != nil {
 		return err
 	}
 	if err := assertValid(obj); err != nil {
 		return err
 	}
-	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
 	v, err := obj.Marshal()
 	if err != nil {
 		return errors.Wrapf(err, "failed to serialize %T", obj)
 	}
-	store.Set(rowID, v)
-	for i, itc := range a.afterSave {
-		if err := itc(ctx, rowID, obj, nil); err != nil {
-			return errors.Wrapf(err, "interceptor %d failed", i)
+	return atomic(ctx, func(ctx HasKVStore) error {
+		store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
+		store.Set(rowID, v)
+		for i, itc := range a.afterSave {
+			if err := itc(ctx, rowID, obj, nil); err != nil {
+				return errors.Wrapf(err, "interceptor %d failed", i)
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 // Save updates the given object under the rowID key. It expects the key to exists already
 // and fails with an `ErrNotFound` otherwise. Any caller must therefore make sure that this contract
 // is fulfilled. Parameters must not be nil.
 //
-// Save iterates though the registered callbacks and may add or remove secondary index keys by them.
+// Save iterates though the registered callbacks and may add or remove secondary index keys by them. The row and
+// index changes are only written when all callbacks succeed.
 func (a Table) Save(ctx HasKVStore, rowID RowID, newValue Persistent) error {
-	if err := assertCorrectType(a.model, newValue); err != nil {
+	if err := a.assertType(newValue); err != nil {
 		return err
 	}
 	if err := assertValid(newValue); err != nil {
 		return err
 	}
 
-	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
 	var oldValue = reflect.New(a.model).Interface().(Persistent)
 
 	if err := a.GetOne(ctx, rowID, oldValue); err != nil {
@@ -143,13 +156,16 @@ func (a Table) Save(ctx HasKVStore, rowID RowID, newValue Persistent) error {
 		return errors.Wrapf(err, "failed to serialize %T", newValue)
 	}
 
-	store.Set(rowID, newValueEncoded)
-	for i, itc := range a.afterSave {
-		if err := itc(ctx, rowID, newValue, oldValue); err != nil {
-			return errors.Wrapf(err, "interceptor %d failed", i)
+	return atomic(ctx, func(ctx HasKVStore) error {
+		store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
+		store.Set(rowID, newValueEncoded)
+		for i, itc := range a.afterSave {
+			if err := itc(ctx, rowID, newValue, oldValue); err != nil {
+				return errors.Wrapf(err, "interceptor %d failed", i)
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 func assertValid(obj Persistent) error {
@@ -165,22 +181,24 @@ func assertValid(obj Persistent) error {
 // and fails with a `ErrNotFound` otherwise. Any caller must therefore make sure that this contract
 // is fulfilled.
 //
-// Delete iterates though the registered callbacks and removes secondary index keys by them.
+// Delete iterates though the registered callbacks and removes secondary index keys by them. The row and index
+// changes are only written when all callbacks succeed.
 func (a Table) Delete(ctx HasKVStore, rowID RowID) error {
-	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
-
 	var oldValue = reflect.New(a.model).Interface().(Persistent)
 	if err := a.GetOne(ctx, rowID, oldValue); err != nil {
 		return errors.Wrap(err, "load old value")
 	}
-	store.Delete(rowID)
 
-	for i, itc := range a.afterDelete {
-		if err := itc(ctx, rowID, oldValue); err != nil {
-			return errors.Wrapf(err, "delete interceptor %d failed", i)
+	return atomic(ctx, func(ctx HasKVStore) error {
+		store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
+		store.Delete(rowID)
+		for i, itc := range a.afterDelete {
+			if err := itc(ctx, rowID, oldValue); err != nil {
+				return errors.Wrapf(err, "delete interceptor %d failed", i)
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 // Has checks if a key exists. Panics on nil key.
@@ -194,7 +212,7 @@ func (a Table) Has(ctx HasKVStore, rowID RowID) bool {
 // GetOne load the object persisted for the given RowID into the dest parameter.
 // If none exists `ErrNotFound` is returned instead. Parameters must not be nil.
 func (a Table) GetOne(ctx HasKVStore, rowID RowID, dest Persistent) error {
-	x := NewTypeSafeRowGetter(a.storeKey, a.prefix, a.model)
+	x := a.rowGetter()
 	return x(ctx, rowID, dest)
 }
 
@@ -221,7 +239,7 @@ func (a Table) PrefixScan(ctx HasKVStore, start, end RowID) (Iterator, error) {
 	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
 	return &typeSafeIterator{
 		ctx:       ctx,
-		rowGetter: NewTypeSafeRowGetter(a.storeKey, a.prefix, a.model),
+		rowGetter: a.rowGetter(),
 		it:        store.Iterator(start, end),
 	}, nil
 }
@@ -242,11 +260,39 @@ func (a Table) ReversePrefixScan(ctx HasKVStore, start, end RowID) (Iterator, er
 	store := prefix.NewStore(ctx.KVStore(a.storeKey), []byte{a.prefix})
 	return &typeSafeIterator{
 		ctx:       ctx,
-		rowGetter: NewTypeSafeRowGetter(a.storeKey, a.prefix, a.model),
+		rowGetter: a.rowGetter(),
 		it:        store.ReverseIterator(start, end),
 	}, nil
 }
 
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. The scan is continued at the key of the page request which must be within the domain.
+// See `Paginate` for details.
+func (a Table) PaginatedPrefixScan(ctx HasKVStore, start, end RowID, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	start, err := pageStart(start, end, pageRequest)
+	if err != nil {
+		return nil, err
+	}
+	it, err := a.PrefixScan(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return Paginate(it, pageRequest, dest)
+}
+
+// assertType checks the type of the model object unless the type checks are disabled.
+func (a Table) assertType(obj Persistent) error {
+	if a.skipTypeCheck {
+		return nil
+	}
+	return assertCorrectType(a.model, obj)
+}
+
+// rowGetter returns a RowGetter that is type safe unless the type checks are disabled.
+func (a Table) rowGetter() RowGetter {
+	return newRowGetter(a.storeKey, a.prefix, a.model, !a.skipTypeCheck)
+}
+
 func (a Table) Table() Table {
 	return a
 }
@@ -271,3 +317,14 @@ func (i typeSafeIterator) Close() error {
 	i.it.Close()
 	return nil
 }
+
+func (i typeSafeIterator) nextKey() []byte {
+	if !i.it.Valid() {
+		return nil
+	}
+	return i.it.Key()
+}
+
+func (i typeSafeIterator) skip() {
+	i.it.Next()
+}
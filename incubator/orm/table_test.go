@@ -117,3 +117,22 @@ func TestUpdate(t *testing.T) {
 	}
 
 }
+
+func TestDisableTypeCheck(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+	const anyPrefix = 0x10
+	tableBuilder := NewTableBuilder(anyPrefix, storeKey, &testdata.GroupMetadata{}, Max255DynamicLengthIndexKeyCodec{})
+	tableBuilder.DisableTypeCheck()
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+	src := testdata.GroupMetadata{
+		Description: "my group",
+		Admin:       sdk.AccAddress([]byte("my-admin-address")),
+	}
+	require.NoError(t, myTable.Create(ctx, []byte("my-id"), &src))
+
+	var loaded testdata.GroupMetadata
+	require.NoError(t, myTable.GetOne(ctx, []byte("my-id"), &loaded))
+	assert.Equal(t, src, loaded)
+}
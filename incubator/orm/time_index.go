@@ -0,0 +1,115 @@
+package orm
+
+import "time"
+
+// TimeIndexerFunc creates one or multiple multiKeyIndex keys of type time.Time for the source object.
+type TimeIndexerFunc func(value interface{}) ([]time.Time, error)
+
+// TimeMultiKeyAdapter converts TimeIndexerFunc to IndexerFunc
+func TimeMultiKeyAdapter(indexer TimeIndexerFunc) IndexerFunc {
+	return func(value interface{}) ([]RowID, error) {
+		d, err := indexer(value)
+		if err != nil {
+			return nil, err
+		}
+		r := make([]RowID, len(d))
+		for i, v := range d {
+			if r[i], err = EncodeKey(v); err != nil {
+				return nil, err
+			}
+		}
+		return r, nil
+	}
+}
+
+// TimeIndex is a typed index. Keys are ordered by time with nanosecond precision, the location is not stored.
+type TimeIndex struct {
+	multiKeyIndex MultiKeyIndex
+}
+
+// NewTimeIndex creates a typed secondary index
+func NewTimeIndex(builder Indexable, prefix byte, indexer TimeIndexerFunc) TimeIndex {
+	return TimeIndex{
+		multiKeyIndex: NewIndex(builder, prefix, TimeMultiKeyAdapter(indexer)),
+	}
+}
+
+// Has checks if a key exists.
+func (i TimeIndex) Has(ctx HasKVStore, key time.Time) bool {
+	return i.multiKeyIndex.Has(ctx, mustEncodeKey(key))
+}
+
+// Get returns a result iterator for the searchKey.
+func (i TimeIndex) Get(ctx HasKVStore, searchKey time.Time) (Iterator, error) {
+	key, err := EncodeKey(searchKey)
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.Get(ctx, key)
+}
+
+// PrefixScan returns an Iterator over a domain of keys in ascending order. End is exclusive.
+// A nil start or end is an open bound.
+// Start is an MultiKeyIndex key or prefix. It must be less than end, or the Iterator is invalid and error is returned.
+// Iterator must be closed by caller.
+//
+// WARNING: The use of a PrefixScan can be very expensive in terms of Gas. Please make sure you do not expose
+// this as an endpoint to the public without further limits.
+// Example:
+//			it, err := idx.PrefixScan(ctx, start, end)
+//			if err !=nil {
+//				return err
+//			}
+//			const defaultLimit = 20
+//			it = LimitIterator(it, defaultLimit)
+//
+// CONTRACT: No writes may happen within a domain while an iterator exists over it.
+func (i TimeIndex) PrefixScan(ctx HasKVStore, start, end *time.Time) (Iterator, error) {
+	startKey, endKey, err := encodeRange(timeBound(start), timeBound(end))
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.PrefixScan(ctx, startKey, endKey)
+}
+
+// ReversePrefixScan returns an Iterator over a domain of keys in descending order. End is exclusive.
+// A nil start or end is an open bound.
+// Start is an MultiKeyIndex key or prefix. It must be less than end, or the Iterator is invalid  and error is returned.
+// Iterator must be closed by caller.
+//
+// WARNING: The use of a ReversePrefixScan can be very expensive in terms of Gas. Please make sure you do not expose
+// this as an endpoint to the public without further limits. See `LimitIterator`
+//
+// CONTRACT: No writes may happen within a domain while an iterator exists over it.
+func (i TimeIndex) ReversePrefixScan(ctx HasKVStore, start, end *time.Time) (Iterator, error) {
+	startKey, endKey, err := encodeRange(timeBound(start), timeBound(end))
+	if err != nil {
+		return NewInvalidIterator(), err
+	}
+	return i.multiKeyIndex.ReversePrefixScan(ctx, startKey, endKey)
+}
+
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. A nil start or end is an open bound. The scan is continued at the key of the page request which must
+// be within the domain.
+// See `MultiKeyIndex.PaginatedPrefixScan` for details.
+func (i TimeIndex) PaginatedPrefixScan(ctx HasKVStore, start, end *time.Time, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	startKey, endKey, err := encodeRange(timeBound(start), timeBound(end))
+	if err != nil {
+		return nil, err
+	}
+	return i.multiKeyIndex.PaginatedPrefixScan(ctx, startKey, endKey, pageRequest, dest)
+}
+
+// Verify checks that the index and the given table are consistent. See MultiKeyIndex.Verify for details.
+func (i TimeIndex) Verify(ctx HasKVStore, table TableExportable) error {
+	return i.multiKeyIndex.Verify(ctx, table)
+}
+
+// timeBound returns the range bound for the value or an open bound for nil.
+func timeBound(v *time.Time) Tuple {
+	if v == nil {
+		return nil
+	}
+	return Tuple{*v}
+}
@@ -0,0 +1,148 @@
+package orm
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/modules/incubator/orm/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeIndex(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("test")
+
+	const anyPrefix = 0x10
+	tableBuilder := NewNaturalKeyTableBuilder(anyPrefix, storeKey, &testdata.GroupMember{}, Max255DynamicLengthIndexKeyCodec{})
+	// weights are converted into seconds relative to the unix epoch so that times before 1970 are covered
+	myIndex := NewTimeIndex(tableBuilder, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]time.Time, error) {
+		return []time.Time{unixTime(int64(val.(*testdata.GroupMember).Weight) - 10)}, nil
+	})
+	myTable := tableBuilder.Build()
+
+	ctx := NewMockContext()
+	members := createGroupMembers(t, ctx, myTable, 20, 10, 1, 11, 9)
+
+	// Has
+	assert.True(t, myIndex.Has(ctx, unixTime(-9)))
+	assert.False(t, myIndex.Has(ctx, unixTime(-9).Add(time.Nanosecond)))
+
+	// Get
+	it, err := myIndex.Get(ctx, unixTime(-1))
+	require.NoError(t, err)
+	var loaded []testdata.GroupMember
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[4]}, loaded)
+
+	// range bounds are pointers, nil is an open bound
+	ptr := func(v time.Time) *time.Time { return &v }
+
+	// PrefixScan range across the epoch
+	it, err = myIndex.PrefixScan(ctx, ptr(unixTime(-5)), ptr(unixTime(5)))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[4], members[1], members[3]}, loaded)
+
+	// PrefixScan end exclusive with nanosecond precision
+	it, err = myIndex.PrefixScan(ctx, ptr(unixTime(-9)), ptr(unixTime(1).Add(time.Nanosecond)))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4], members[1], members[3]}, loaded)
+
+	// PrefixScan with start not less than end
+	_, err = myIndex.PrefixScan(ctx, ptr(unixTime(1)), ptr(unixTime(1)))
+	assert.True(t, ErrArgument.Is(err))
+
+	// ReversePrefixScan
+	it, err = myIndex.ReversePrefixScan(ctx, ptr(unixTime(-10)), ptr(unixTime(11)))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[0], members[3], members[1], members[4], members[2]}, loaded)
+
+	// PaginatedPrefixScan
+	res, err := myIndex.PaginatedPrefixScan(ctx, ptr(unixTime(-10)), ptr(unixTime(11)), &PageRequest{Limit: 3}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4], members[1]}, loaded)
+	_, err = myIndex.PaginatedPrefixScan(ctx, ptr(unixTime(-10)), ptr(unixTime(11)), &PageRequest{Key: res.NextKey, Limit: 3}, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[3], members[0]}, loaded)
+
+	// PrefixScan with open start
+	it, err = myIndex.PrefixScan(ctx, nil, ptr(unixTime(0)))
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[2], members[4]}, loaded)
+
+	// PrefixScan with open end
+	it, err = myIndex.PrefixScan(ctx, ptr(unixTime(1)), nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[3], members[0]}, loaded)
+
+	// ReversePrefixScan unbounded
+	it, err = myIndex.ReversePrefixScan(ctx, nil, nil)
+	require.NoError(t, err)
+	_, err = ReadAll(it, &loaded)
+	require.NoError(t, err)
+	assert.Equal(t, []testdata.GroupMember{members[0], members[3], members[1], members[4], members[2]}, loaded)
+
+	// Verify
+	require.NoError(t, myIndex.Verify(ctx, myTable))
+}
+
+func TestTimeMultiKeyAdapter(t *testing.T) {
+	specs := map[string]struct {
+		srcFunc TimeIndexerFunc
+		exp     []RowID
+		expErr  error
+	}{
+		"single key": {
+			srcFunc: func(value interface{}) ([]time.Time, error) {
+				return []time.Time{time.Unix(1, 2)}, nil
+			},
+			exp: []RowID{{0x80, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 2}},
+		},
+		"location is dropped": {
+			srcFunc: func(value interface{}) ([]time.Time, error) {
+				return []time.Time{time.Unix(1, 2).In(time.FixedZone("test", 3600))}, nil
+			},
+			exp: []RowID{{0x80, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 2}},
+		},
+		"nil key": {
+			srcFunc: func(value interface{}) ([]time.Time, error) {
+				return nil, nil
+			},
+			exp: []RowID{},
+		},
+		"error case": {
+			srcFunc: func(value interface{}) ([]time.Time, error) {
+				return nil, errors.New("test")
+			},
+			expErr: errors.New("test"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			fn := TimeMultiKeyAdapter(spec.srcFunc)
+			r, err := fn(nil)
+			if spec.expErr != nil {
+				require.Equal(t, spec.expErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, r)
+		})
+	}
+}
+
+func unixTime(sec int64) time.Time {
+	return time.Unix(sec, 0).UTC()
+}
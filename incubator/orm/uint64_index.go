@@ -72,3 +72,15 @@ func (i UInt64Index) PrefixScan(ctx HasKVStore, start, end uint64) (Iterator, er
 func (i UInt64Index) ReversePrefixScan(ctx HasKVStore, start, end uint64) (Iterator, error) {
 	return i.multiKeyIndex.ReversePrefixScan(ctx, EncodeSequence(start), EncodeSequence(end))
 }
+
+// PaginatedPrefixScan loads a page of the domain of keys in ascending order into the passed ModelSlicePtr. End is
+// exclusive. The scan is continued at the key of the page request which must be within the domain.
+// See `MultiKeyIndex.PaginatedPrefixScan` for details.
+func (i UInt64Index) PaginatedPrefixScan(ctx HasKVStore, start, end uint64, pageRequest *PageRequest, dest ModelSlicePtr) (*PageResponse, error) {
+	return i.multiKeyIndex.PaginatedPrefixScan(ctx, EncodeSequence(start), EncodeSequence(end), pageRequest, dest)
+}
+
+// Verify checks that the index and the given table are consistent. See MultiKeyIndex.Verify for details.
+func (i UInt64Index) Verify(ctx HasKVStore, table TableExportable) error {
+	return i.multiKeyIndex.Verify(ctx, table)
+}